@@ -132,6 +132,13 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
+
+	// app-side mempool, holding the transactions accepted by CheckTx
+	mempool Mempool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -154,6 +161,7 @@ func NewBaseApp(
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
+		mempool:          NoOpMempool{},
 	}
 
 	for _, option := range options {
@@ -192,6 +200,17 @@ func (app *BaseApp) Logger() log.Logger {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// SnapshotManager returns the snapshot manager, or nil if state sync snapshots are disabled.
+// It is used to register extension snapshotters.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager { return app.snapshotManager }
+
+// CommitMultiStore returns the root multi-store of the BaseApp. It should only
+// be used by offline commands, such as the rollback of the application state.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore { return app.cms }
+
+// Mempool returns the app-side mempool of the BaseApp.
+func (app *BaseApp) Mempool() Mempool { return app.mempool }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
@@ -566,7 +585,10 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+//
+// The returned priority is the priority of the transaction in the mempool, as
+// set by the AnteHandler.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
@@ -578,7 +600,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -614,12 +636,19 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	tx, err := app.txDecoder(txBytes)
 	if err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
+	}
+
+	// A tx included in a block leaves the mempool, whatever its outcome. The
+	// error is ignored, as the mempool is local to the node and must not
+	// affect the execution of the block.
+	if mode == runTxModeDeliver {
+		_ = app.mempool.Remove(tx)
 	}
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
 	}
 
 	var events sdk.Events
@@ -656,7 +685,22 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
-			return gInfo, nil, err
+			if mode == runTxModeReCheck {
+				_ = app.mempool.Remove(tx)
+			}
+
+			return gInfo, nil, 0, err
+		}
+
+		priority = ctx.Priority()
+
+		// The tx is (re)inserted in the mempool before its state changes are
+		// written, so that a tx rejected by the mempool leaves no trace in the
+		// check state.
+		if mode == runTxModeCheck || mode == runTxModeReCheck {
+			if err := app.mempool.Insert(ctx, tx); err != nil {
+				return gInfo, nil, 0, err
+			}
 		}
 
 		msCache.Write()
@@ -680,7 +724,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 	}
 
-	return gInfo, result, err
+	return gInfo, result, priority, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
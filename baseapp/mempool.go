@@ -0,0 +1,111 @@
+package baseapp
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Mempool types, as selected with the mempool.type option of app.toml.
+const (
+	MempoolTypeNone        = "none"
+	MempoolTypePriority    = "priority"
+	MempoolTypeSenderNonce = "sender-nonce"
+)
+
+// ErrTxNotFound is returned by Mempool.Remove when the transaction isn't in
+// the mempool.
+var ErrTxNotFound = errors.New("tx not found in mempool")
+
+// Mempool defines the app-side mempool, deciding which of the transactions
+// accepted by the AnteHandler enter and stay in the Tendermint mempool.
+//
+// Tendermint v0.34 builds blocks from its own first-in first-out mempool and
+// gives the application no say in the content or order of a block. The only
+// way for the application to act on the Tendermint mempool is to fail CheckTx,
+// which keeps a transaction out of it, or ReCheckTx, which drops it. The
+// app-side mempool is therefore an admission and eviction policy, not an
+// ordering one: it can keep low priority transactions out of a busy mempool,
+// but it can't move high priority transactions ahead in a block.
+//
+// BaseApp inserts the transactions which pass the AnteHandler in CheckTx, and
+// reinserts them in ReCheckTx, after each block, when Tendermint rechecks the
+// transactions of its mempool (the recheck option of config.toml, enabled by
+// default). A transaction which is rejected by the mempool, e.g. because it is
+// full or because it was evicted, fails (Re)CheckTx and is dropped from the
+// Tendermint mempool. Transactions are removed from the mempool once they are
+// included in a block, or fail ReCheckTx.
+//
+// Implementations must be safe for concurrent use, as CheckTx and DeliverTx
+// may run concurrently.
+type Mempool interface {
+	// Insert inserts a transaction in the mempool, or replaces the transaction
+	// of the same sender and sequence. The priority of the transaction, as set
+	// by the AnteHandler, is given by ctx.Priority(). In ReCheckTx, the
+	// transactions which are no longer in the mempool are rejected.
+	Insert(ctx sdk.Context, tx sdk.Tx) error
+
+	// CountTx returns the number of transactions in the mempool.
+	CountTx() int
+
+	// Remove removes a transaction from the mempool. It returns ErrTxNotFound
+	// if the transaction isn't in the mempool.
+	Remove(tx sdk.Tx) error
+}
+
+// NewMempool returns the Mempool of the given type, holding at most maxTxs
+// transactions. A non-positive maxTxs means no limit.
+func NewMempool(mempoolType string, maxTxs int) (Mempool, error) {
+	switch mempoolType {
+	case "", MempoolTypeNone:
+		return NoOpMempool{}, nil
+	case MempoolTypePriority:
+		return NewPriorityMempool(maxTxs), nil
+	case MempoolTypeSenderNonce:
+		return NewSenderNonceMempool(maxTxs), nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown mempool type %s", mempoolType)
+	}
+}
+
+var _ Mempool = NoOpMempool{}
+
+// NoOpMempool is a Mempool which doesn't keep any transaction, leaving the
+// admission of transactions to Tendermint. It is the default mempool of
+// BaseApp.
+type NoOpMempool struct{}
+
+func (NoOpMempool) Insert(sdk.Context, sdk.Tx) error { return nil }
+func (NoOpMempool) CountTx() int                     { return 0 }
+func (NoOpMempool) Remove(sdk.Tx) error              { return nil }
+
+// sigVerifiableTx defines the transaction methods used to identify a
+// transaction in a mempool, implemented by the x/auth transactions.
+type sigVerifiableTx interface {
+	sdk.Tx
+	GetSigners() []sdk.AccAddress
+	GetSignaturesV2() ([]signing.SignatureV2, error)
+}
+
+// txSenderNonce returns the first signer of a transaction and its sequence,
+// which identify the transaction in a mempool.
+func txSenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(sigVerifiableTx)
+	if !ok {
+		return "", 0, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "%T does not implement GetSigners and GetSignaturesV2", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, sdkerrors.ErrNoSignatures
+	}
+
+	return string(signers[0]), sigs[0].Sequence, nil
+}
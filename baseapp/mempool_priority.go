@@ -0,0 +1,224 @@
+package baseapp
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*priorityMempool)(nil)
+
+// priorityMempool is a Mempool admitting transactions by priority, while
+// keeping the transactions of each sender in sequence order.
+//
+// Once the mempool is full, a new transaction is only accepted if its priority
+// is greater than the lowest priority in the mempool. The transaction with the
+// lowest priority, the latest one among equals, is then evicted together with
+// the later transactions of its sender, which couldn't be executed anymore.
+// The evicted transactions are dropped from the Tendermint mempool when they
+// are rechecked after the next block.
+//
+// Tendermint may also drop a transaction accepted by CheckTx, e.g. when its
+// own mempool is full. Such a transaction isn't rechecked, so the transactions
+// which weren't reinserted by the ReCheckTx following a block are pruned once
+// the next block is committed.
+type priorityMempool struct {
+	mtx     sync.Mutex
+	maxTxs  int
+	counter uint64
+	height  int64                    // latest block height seen by Insert
+	senders map[string][]*priorityTx // sorted by nonce
+	lowest  evictionHeap
+}
+
+// priorityTx is a transaction of the priority mempool.
+type priorityTx struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	height   int64  // block height of the latest (re)insertion
+	order    uint64 // insertion order, breaking priority ties
+	index    int    // index in the eviction heap
+}
+
+// NewPriorityMempool returns a Mempool admitting transactions by priority,
+// holding at most maxTxs transactions. A non-positive maxTxs means no limit.
+func NewPriorityMempool(maxTxs int) Mempool {
+	return &priorityMempool{
+		maxTxs:  maxTxs,
+		senders: make(map[string][]*priorityTx),
+	}
+}
+
+// Insert implements Mempool.
+func (mp *priorityMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if height := ctx.BlockHeight(); height > mp.height {
+		mp.height = height
+		mp.prune(height - 1)
+	}
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+
+	// replace the transaction with the same sender and nonce
+	if i < len(txs) && txs[i].nonce == nonce {
+		txs[i].tx = tx
+		txs[i].priority = ctx.Priority()
+		txs[i].height = ctx.BlockHeight()
+		heap.Fix(&mp.lowest, txs[i].index)
+		return nil
+	}
+
+	// a rechecked transaction which isn't in the mempool was evicted, and must
+	// leave the Tendermint mempool as well
+	if ctx.IsReCheckTx() {
+		return sdkerrors.Wrap(sdkerrors.ErrMempoolIsFull, "transaction was evicted from the mempool")
+	}
+
+	if mp.maxTxs > 0 && mp.lowest.Len() >= mp.maxTxs {
+		evicted := mp.lowest[0]
+		if evicted.priority >= ctx.Priority() || (evicted.sender == sender && evicted.nonce < nonce) {
+			return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "priority %d is not greater than the lowest priority %d", ctx.Priority(), evicted.priority)
+		}
+
+		mp.evict(evicted)
+		txs = mp.senders[sender]
+		i = sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	}
+
+	mp.counter++
+	ptx := &priorityTx{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: ctx.Priority(),
+		height:   ctx.BlockHeight(),
+		order:    mp.counter,
+	}
+
+	txs = append(txs, nil)
+	copy(txs[i+1:], txs[i:])
+	txs[i] = ptx
+	mp.senders[sender] = txs
+	heap.Push(&mp.lowest, ptx)
+
+	return nil
+}
+
+// evict removes a transaction and the later transactions of its sender.
+func (mp *priorityMempool) evict(ptx *priorityTx) {
+	txs := mp.senders[ptx.sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= ptx.nonce })
+
+	for _, evicted := range txs[i:] {
+		heap.Remove(&mp.lowest, evicted.index)
+	}
+
+	if i == 0 {
+		delete(mp.senders, ptx.sender)
+	} else {
+		mp.senders[ptx.sender] = txs[:i]
+	}
+}
+
+// prune removes the transactions last (re)inserted before the given height,
+// which are no longer in the Tendermint mempool since they weren't rechecked.
+func (mp *priorityMempool) prune(height int64) {
+	for sender, txs := range mp.senders {
+		kept := txs[:0]
+		for _, ptx := range txs {
+			if ptx.height < height {
+				heap.Remove(&mp.lowest, ptx.index)
+			} else {
+				kept = append(kept, ptx)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(mp.senders, sender)
+		} else {
+			mp.senders[sender] = kept
+		}
+	}
+}
+
+// CountTx implements Mempool.
+func (mp *priorityMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.lowest.Len()
+}
+
+// Remove implements Mempool.
+func (mp *priorityMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	if i == len(txs) || txs[i].nonce != nonce {
+		return ErrTxNotFound
+	}
+
+	heap.Remove(&mp.lowest, txs[i].index)
+
+	if len(txs) == 1 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = append(txs[:i:i], txs[i+1:]...)
+	}
+
+	return nil
+}
+
+// evictionHeap is a min-heap of transactions, ordered by increasing priority
+// and decreasing insertion order.
+type evictionHeap []*priorityTx
+
+func (h evictionHeap) Len() int { return len(h) }
+
+func (h evictionHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority < h[j].priority
+	}
+	return h[i].order > h[j].order
+}
+
+func (h evictionHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *evictionHeap) Push(x interface{}) {
+	ptx := x.(*priorityTx)
+	ptx.index = len(*h)
+	*h = append(*h, ptx)
+}
+
+func (h *evictionHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	ptx := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return ptx
+}
@@ -0,0 +1,142 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*senderNonceMempool)(nil)
+
+// senderNonceMempool is a Mempool admitting transactions first-come
+// first-served, while keeping the transactions of each sender in nonce order.
+// Once the mempool is full, new transactions are rejected, whatever their
+// priority.
+//
+// As with the priority mempool, the transactions which weren't reinserted by
+// the ReCheckTx following a block were dropped by Tendermint, and are pruned
+// once the next block is committed.
+type senderNonceMempool struct {
+	mtx     sync.Mutex
+	maxTxs  int
+	count   int
+	height  int64                       // latest block height seen by Insert
+	senders map[string][]*senderNonceTx // sorted by nonce
+}
+
+// senderNonceTx is a transaction of the sender-nonce mempool.
+type senderNonceTx struct {
+	tx     sdk.Tx
+	nonce  uint64
+	height int64 // block height of the latest (re)insertion
+}
+
+// NewSenderNonceMempool returns a Mempool admitting transactions until it is
+// full, keeping the transactions of each sender in nonce order, and holding at
+// most maxTxs transactions. A non-positive maxTxs means no limit.
+func NewSenderNonceMempool(maxTxs int) Mempool {
+	return &senderNonceMempool{
+		maxTxs:  maxTxs,
+		senders: make(map[string][]*senderNonceTx),
+	}
+}
+
+// Insert implements Mempool.
+func (mp *senderNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if height := ctx.BlockHeight(); height > mp.height {
+		mp.height = height
+		mp.prune(height - 1)
+	}
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+
+	// replace the transaction with the same sender and nonce
+	if i < len(txs) && txs[i].nonce == nonce {
+		txs[i].tx = tx
+		txs[i].height = ctx.BlockHeight()
+		return nil
+	}
+
+	// a rechecked transaction which isn't in the mempool was pruned, and must
+	// leave the Tendermint mempool as well
+	if ctx.IsReCheckTx() {
+		return sdkerrors.Wrap(sdkerrors.ErrMempoolIsFull, "transaction was evicted from the mempool")
+	}
+
+	if mp.maxTxs > 0 && mp.count >= mp.maxTxs {
+		return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "%d transactions", mp.count)
+	}
+
+	txs = append(txs, nil)
+	copy(txs[i+1:], txs[i:])
+	txs[i] = &senderNonceTx{tx: tx, nonce: nonce, height: ctx.BlockHeight()}
+	mp.senders[sender] = txs
+	mp.count++
+
+	return nil
+}
+
+// prune removes the transactions last (re)inserted before the given height,
+// which are no longer in the Tendermint mempool since they weren't rechecked.
+func (mp *senderNonceMempool) prune(height int64) {
+	for sender, txs := range mp.senders {
+		kept := txs[:0]
+		for _, stx := range txs {
+			if stx.height >= height {
+				kept = append(kept, stx)
+			}
+		}
+		mp.count -= len(txs) - len(kept)
+
+		if len(kept) == 0 {
+			delete(mp.senders, sender)
+		} else {
+			mp.senders[sender] = kept
+		}
+	}
+}
+
+// CountTx implements Mempool.
+func (mp *senderNonceMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.count
+}
+
+// Remove implements Mempool.
+func (mp *senderNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	if i == len(txs) || txs[i].nonce != nonce {
+		return ErrTxNotFound
+	}
+
+	if len(txs) == 1 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = append(txs[:i:i], txs[i+1:]...)
+	}
+	mp.count--
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// mempoolTx is a tx identified by its sender and nonce, whose priority is set
+// by mempoolAnteHandler.
+type mempoolTx struct {
+	Sender   string
+	Nonce    uint64
+	Priority int64
+}
+
+var _ sigVerifiableTx = mempoolTx{}
+
+func (tx mempoolTx) GetMsgs() []sdk.Msg           { return []sdk.Msg{msgCounter{}} }
+func (tx mempoolTx) ValidateBasic() error         { return nil }
+func (tx mempoolTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{sdk.AccAddress(tx.Sender)} }
+
+func (tx mempoolTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{Sequence: tx.Nonce}}, nil
+}
+
+func (tx mempoolTx) bytes(t *testing.T) []byte {
+	bz, err := json.Marshal(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+func mempoolTxDecoder(txBytes []byte) (sdk.Tx, error) {
+	var tx mempoolTx
+	if err := json.Unmarshal(txBytes, &tx); err != nil {
+		return nil, sdkerrors.ErrTxDecode
+	}
+	return tx, nil
+}
+
+func mempoolAnteHandler(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx.WithPriority(tx.(mempoolTx).Priority), nil
+}
+
+func insertTxs(t *testing.T, mp Mempool, txs ...mempoolTx) {
+	for _, tx := range txs {
+		ctx := sdk.Context{}.WithPriority(tx.Priority)
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+}
+
+// mempoolTxs returns the txs of a priority or sender-nonce mempool, sorted
+// by sender and nonce.
+func mempoolTxs(mp Mempool) []mempoolTx {
+	txs := make(map[string][]sdk.Tx)
+	switch mp := mp.(type) {
+	case *priorityMempool:
+		for sender, ptxs := range mp.senders {
+			for _, ptx := range ptxs {
+				txs[sender] = append(txs[sender], ptx.tx)
+			}
+		}
+	case *senderNonceMempool:
+		for sender, stxs := range mp.senders {
+			for _, stx := range stxs {
+				txs[sender] = append(txs[sender], stx.tx)
+			}
+		}
+	}
+
+	senders := make([]string, 0, len(txs))
+	for sender := range txs {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	var res []mempoolTx
+	for _, sender := range senders {
+		for _, tx := range txs[sender] {
+			res = append(res, tx.(mempoolTx))
+		}
+	}
+	return res
+}
+
+func TestNewMempool(t *testing.T) {
+	mp, err := NewMempool("", 10)
+	require.NoError(t, err)
+	require.IsType(t, NoOpMempool{}, mp)
+
+	mp, err = NewMempool(MempoolTypePriority, 10)
+	require.NoError(t, err)
+	require.IsType(t, &priorityMempool{}, mp)
+
+	mp, err = NewMempool(MempoolTypeSenderNonce, 10)
+	require.NoError(t, err)
+	require.IsType(t, &senderNonceMempool{}, mp)
+
+	_, err = NewMempool("fifo", 10)
+	require.Error(t, err)
+}
+
+func TestPriorityMempool(t *testing.T) {
+	mp := NewPriorityMempool(5)
+
+	a0 := mempoolTx{"a", 0, 10}
+	a1 := mempoolTx{"a", 1, 30}
+	b0 := mempoolTx{"b", 0, 20}
+	c0 := mempoolTx{"c", 0, 20}
+	c1 := mempoolTx{"c", 1, 5}
+	insertTxs(t, mp, a1, b0, c0, c1, a0)
+	require.Equal(t, 5, mp.CountTx())
+	require.Equal(t, []mempoolTx{a0, a1, b0, c0, c1}, mempoolTxs(mp))
+
+	// a tx with the same sender and nonce is replaced, even when full
+	c1 = mempoolTx{"c", 1, 25}
+	insertTxs(t, mp, c1)
+	require.Equal(t, 5, mp.CountTx())
+	require.Equal(t, []mempoolTx{a0, a1, b0, c0, c1}, mempoolTxs(mp))
+
+	// once full, a tx is only accepted with a greater priority than the lowest
+	d0 := mempoolTx{"d", 0, 10}
+	err := mp.Insert(sdk.Context{}.WithPriority(d0.Priority), d0)
+	require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+
+	// the lowest priority tx is evicted with the later txs of its sender
+	d0 = mempoolTx{"d", 0, 15}
+	insertTxs(t, mp, d0)
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, []mempoolTx{b0, c0, c1, d0}, mempoolTxs(mp))
+
+	// on recheck, the txs of the mempool are replaced, and the evicted ones
+	// are rejected
+	recheckCtx := sdk.Context{}.WithIsReCheckTx(true)
+	require.NoError(t, mp.Insert(recheckCtx.WithPriority(b0.Priority), b0))
+	err = mp.Insert(recheckCtx.WithPriority(a0.Priority), a0)
+	require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+
+	require.NoError(t, mp.Remove(c0))
+	require.Equal(t, ErrTxNotFound, mp.Remove(c0))
+	require.Equal(t, ErrTxNotFound, mp.Remove(a0))
+	require.Equal(t, []mempoolTx{b0, c1, d0}, mempoolTxs(mp))
+	require.Equal(t, 3, mp.CountTx())
+
+	// txs must have a sender and nonce
+	err = mp.Insert(sdk.Context{}, txTest{})
+	require.True(t, sdkerrors.ErrTxDecode.Is(err))
+}
+
+func TestPriorityMempoolPrune(t *testing.T) {
+	mp := NewPriorityMempool(0)
+
+	a0 := mempoolTx{"a", 0, 10}
+	b0 := mempoolTx{"b", 0, 20}
+	c0 := mempoolTx{"c", 0, 30}
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	require.NoError(t, mp.Insert(ctx, a0))
+	require.NoError(t, mp.Insert(ctx, b0))
+
+	// the txs not rechecked after a block were dropped by Tendermint, and are
+	// pruned after the next block
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, mp.Insert(ctx.WithIsReCheckTx(true), a0))
+	require.Equal(t, []mempoolTx{a0, b0}, mempoolTxs(mp))
+
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, mp.Insert(ctx, c0))
+	require.Equal(t, []mempoolTx{a0, c0}, mempoolTxs(mp))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestSenderNonceMempool(t *testing.T) {
+	mp := NewSenderNonceMempool(3)
+
+	a0 := mempoolTx{"a", 0, 10}
+	a1 := mempoolTx{"a", 1, 30}
+	b0 := mempoolTx{"b", 0, 20}
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	for _, tx := range []mempoolTx{a1, b0, a0} {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.Priority), tx))
+	}
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []mempoolTx{a0, a1, b0}, mempoolTxs(mp))
+
+	// a tx with the same sender and nonce is replaced, even when full
+	a1 = mempoolTx{"a", 1, 5}
+	require.NoError(t, mp.Insert(ctx.WithPriority(a1.Priority), a1))
+	require.Equal(t, []mempoolTx{a0, a1, b0}, mempoolTxs(mp))
+
+	// once full, new txs are rejected whatever their priority
+	c0 := mempoolTx{"c", 0, 100}
+	err := mp.Insert(ctx.WithPriority(c0.Priority), c0)
+	require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+
+	// the txs included in a block leave the mempool, and the ones not
+	// rechecked after the block are evicted after the next one
+	require.NoError(t, mp.Remove(a0))
+	require.Equal(t, ErrTxNotFound, mp.Remove(a0))
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, mp.Insert(ctx.WithIsReCheckTx(true), a1))
+	require.Equal(t, []mempoolTx{a1, b0}, mempoolTxs(mp))
+
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, mp.Insert(ctx, c0))
+	require.Equal(t, []mempoolTx{a1, c0}, mempoolTxs(mp))
+	require.Equal(t, 2, mp.CountTx())
+
+	// the evicted txs are rejected on recheck
+	err = mp.Insert(ctx.WithIsReCheckTx(true), b0)
+	require.True(t, sdkerrors.ErrMempoolIsFull.Is(err))
+
+	// txs must have a sender and nonce
+	err = mp.Insert(sdk.Context{}, txTest{})
+	require.True(t, sdkerrors.ErrTxDecode.Is(err))
+}
+
+func TestCheckTxWithMempool(t *testing.T) {
+	mp := NewPriorityMempool(2)
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), mempoolTxDecoder, SetMempool(mp))
+	app.MountStores(capKey1)
+	app.SetAnteHandler(mempoolAnteHandler)
+	app.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{}, nil
+	}))
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	a0 := mempoolTx{"a", 0, 10}
+	b0 := mempoolTx{"b", 0, 20}
+	c0 := mempoolTx{"c", 0, 5}
+	d0 := mempoolTx{"d", 0, 15}
+
+	for _, tx := range []mempoolTx{a0, b0} {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: tx.bytes(t)})
+		require.True(t, res.IsOK(), res.Log)
+
+		// the priority is surfaced as an event attribute
+		require.Len(t, res.Events, 1)
+		require.Equal(t, sdk.EventTypeTx, res.Events[0].Type)
+		require.Equal(t, sdk.AttributeKeyPriority, string(res.Events[0].Attributes[0].Key))
+		require.Equal(t, strconv.FormatInt(tx.Priority, 10), string(res.Events[0].Attributes[0].Value))
+	}
+
+	// a full mempool rejects lower priority txs, and evicts them for higher
+	// priority ones
+	res := app.CheckTx(abci.RequestCheckTx{Tx: c0.bytes(t)})
+	require.Equal(t, sdkerrors.ErrMempoolIsFull.ABCICode(), res.Code)
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: d0.bytes(t)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []mempoolTx{b0, d0}, mempoolTxs(mp))
+
+	// txs included in a block leave the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res2 := app.DeliverTx(abci.RequestDeliverTx{Tx: b0.bytes(t)})
+	require.True(t, res2.IsOK(), res2.Log)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, []mempoolTx{d0}, mempoolTxs(mp))
+
+	// on ReCheckTx, the remaining txs are reinserted, and the evicted txs are
+	// rejected, so that Tendermint drops them
+	res = app.CheckTx(abci.RequestCheckTx{Tx: d0.bytes(t), Type: abci.CheckTxType_Recheck})
+	require.True(t, res.IsOK(), res.Log)
+	res = app.CheckTx(abci.RequestCheckTx{Tx: a0.bytes(t), Type: abci.CheckTxType_Recheck})
+	require.Equal(t, sdkerrors.ErrMempoolIsFull.ABCICode(), res.Code)
+	require.Equal(t, []mempoolTx{d0}, mempoolTxs(mp))
+
+	// the freed space admits new txs
+	res = app.CheckTx(abci.RequestCheckTx{Tx: c0.bytes(t)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []mempoolTx{c0, d0}, mempoolTxs(mp))
+}
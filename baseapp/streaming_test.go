@@ -0,0 +1,120 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+// mockStreamingService records the writes and ABCI messages it is notified of
+type mockStreamingService struct {
+	writes                  map[string][]byte
+	beginBlocks, deliverTxs int
+	endBlocks, commits      int
+	writesBeforeFirstCommit int
+}
+
+func (m *mockStreamingService) OnWrite(_ storetypes.StoreKey, key []byte, value []byte, _ bool) error {
+	m.writes[string(key)] = value
+	return nil
+}
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: {m}}
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	m.beginBlocks++
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	m.deliverTxs++
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	m.endBlocks++
+	if m.commits == 0 {
+		m.writesBeforeFirstCommit = len(m.writes)
+	}
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(sdk.Context, abci.ResponseCommit) error {
+	m.commits++
+	return nil
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	streamingService := &mockStreamingService{writes: make(map[string][]byte)}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(streamingService) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nBlocks := 3
+	txPerHeight := 5
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		header := tmproto.Header{Height: int64(blockN) + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		for i := 0; i < txPerHeight; i++ {
+			counter := int64(blockN*txPerHeight + i)
+			tx := newTxCounter(counter, counter)
+
+			txBytes, err := codec.MarshalBinaryBare(tx)
+			require.NoError(t, err)
+
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, res.IsOK())
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+
+		// the state changes are streamed once the block is committed
+		expected := int64((blockN + 1) * txPerHeight)
+		require.Equal(t, expected, decodeInt(t, streamingService.writes[string(anteKey)]))
+		require.Equal(t, expected, decodeInt(t, streamingService.writes[string(deliverKey)]))
+	}
+
+	require.Equal(t, nBlocks, streamingService.beginBlocks)
+	require.Equal(t, nBlocks*txPerHeight, streamingService.deliverTxs)
+	require.Equal(t, nBlocks, streamingService.endBlocks)
+	require.Equal(t, nBlocks, streamingService.commits)
+
+	// nothing is streamed before the block is committed
+	require.Equal(t, 0, streamingService.writesBeforeFirstCommit)
+}
+
+func decodeInt(t *testing.T, bz []byte) int64 {
+	i, err := binary.ReadVarint(bytes.NewBuffer(bz))
+	require.NoError(t, err)
+	return i
+}
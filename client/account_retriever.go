@@ -22,3 +22,9 @@ type AccountRetriever interface {
 	EnsureExists(clientCtx Context, addr sdk.AccAddress) error
 	GetAccountNumberSequence(clientCtx Context, addr sdk.AccAddress) (accNum uint64, accSeq uint64, err error)
 }
+
+// GasPricesRetriever defines the interface used by transactions setting neither
+// fees nor gas prices to query the gas prices the chain currently requires.
+type GasPricesRetriever interface {
+	GetGasPrices(clientCtx Context) (sdk.DecCoins, error)
+}
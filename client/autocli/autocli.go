@@ -0,0 +1,240 @@
+// Package autocli generates query and tx commands from the descriptors of the
+// gRPC services of a node, as an alternative to hand-written module commands.
+//
+// The services are discovered through the reflection services of the node,
+// and each field of the request of a method is mapped to a flag, or to a
+// positional argument. The request and response types must be registered in
+// the client, i.e. the modules must be linked into it, while their commands
+// are not required. Modules implementing HasAutoCLIConfig customize the
+// commands of their services.
+package autocli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// FlagGRPCAddr is the flag of the address of the gRPC server of the node whose
+// services are discovered.
+const FlagGRPCAddr = "grpc-addr"
+
+// ServiceCommandDescriptor customizes the command generated for a service.
+type ServiceCommandDescriptor struct {
+	// Service is the fully-qualified name of the service, e.g.
+	// cosmos.bank.v1beta1.Query.
+	Service string
+	// Use is the name of the command. It defaults to the last segment of the
+	// package of the service which is not a version, e.g. bank.
+	Use string
+	// Short is the short description of the command.
+	Short string
+	// RPCCommandOptions customize the commands of the methods of the service.
+	RPCCommandOptions []*RPCCommandOptions
+}
+
+// RPCCommandOptions customize the command generated for a method.
+type RPCCommandOptions struct {
+	// RPCMethod is the name of the method, e.g. AllBalances.
+	RPCMethod string
+	// Use is the one-line usage of the command. It defaults to the method name
+	// in kebab case, e.g. all-balances, followed by the positional arguments.
+	Use string
+	// Short is the short description of the command.
+	Short string
+	// Long is the long description of the command.
+	Long string
+	// Example is an example of the usage of the command.
+	Example string
+	// PositionalArgs are the names of the request fields read from positional
+	// arguments instead of flags, in order.
+	PositionalArgs []string
+	// Signer is the name of the request field set to the address of the --from
+	// key, for the methods of Msg services. It defaults to the field holding
+	// the first signer returned by the GetSigners method of the request, which
+	// is set as a validator operator address if GetSigners reads it as such.
+	Signer string
+	// Skip skips the generation of the command.
+	Skip bool
+}
+
+// HasAutoCLIConfig is implemented by the modules customizing the commands
+// generated for their services.
+type HasAutoCLIConfig interface {
+	AutoCLIOptions() []*ServiceCommandDescriptor
+}
+
+// Cmd returns the autocli command, generating at run time the query and tx
+// commands of the services of the node at --grpc-addr, customized by the
+// options of the given modules.
+func Cmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autocli [query|tx] [service] [method]",
+		Short: "Query and transactions subcommands generated from the services of a node",
+		Long: `Query and transactions subcommands generated from the services of a node, discovered through its
+reflection services, which requires its gRPC server to be enabled. Each field of a request is set by the
+flag of the same name in kebab case, or by a positional argument. Coins are given as in the other commands,
+e.g. 10stake,5atom, repeated scalars are comma-separated, and messages are given as JSON.`,
+		Example: fmt.Sprintf(`$ %[1]s autocli query bank balance [address] [denom] --grpc-addr localhost:9090
+$ %[1]s autocli query staking validator --validator-addr [validator-address]
+$ %[1]s autocli tx bank send [to_address] [amount] --from mykey`, version.AppName),
+		// the flags of the generated commands are only known at run time
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grpcAddr, args := extractFlag(args, FlagGRPCAddr, "localhost:9090")
+
+			conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			root, err := newRootCommand(cmd.Context(), NewReflectionClient(conn), moduleOptions(mbm))
+			if err != nil {
+				if hasHelpFlag(args) {
+					return cmd.Help()
+				}
+				return fmt.Errorf("failed to discover the services at %s: %w", grpcAddr, err)
+			}
+
+			root.SetArgs(args)
+			root.SetOut(cmd.OutOrStdout())
+			root.SetErr(cmd.ErrOrStderr())
+
+			return root.ExecuteContext(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+// newRootCommand returns the command with the query and tx commands of the
+// services discovered by the client.
+func newRootCommand(ctx context.Context, rc *ReflectionClient, opts map[string]*ServiceCommandDescriptor) (*cobra.Command, error) {
+	queryCmd := &cobra.Command{
+		Use:                        "query",
+		Aliases:                    []string{"q"},
+		Short:                      "Querying subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryServices, err := rc.QueryServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := addServiceCommands(ctx, queryCmd, rc, queryServices, opts, NewQueryCommand); err != nil {
+		return nil, err
+	}
+
+	txCmd := &cobra.Command{
+		Use:                        "tx",
+		Short:                      "Transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	msgServices, err := rc.MsgServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := addServiceCommands(ctx, txCmd, rc, msgServices, opts, NewTxCommand); err != nil {
+		return nil, err
+	}
+
+	root := &cobra.Command{
+		Use:           "autocli",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.PersistentFlags().String(FlagGRPCAddr, "localhost:9090", "The gRPC server address of the node")
+	root.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
+	root.AddCommand(queryCmd, txCmd)
+
+	return root, nil
+}
+
+// addServiceCommands adds to the parent the commands of the services which
+// have methods. A service whose default name is already taken is named after
+// its fully-qualified name.
+func addServiceCommands(
+	ctx context.Context, parent *cobra.Command, resolver FileResolver, services []string,
+	opts map[string]*ServiceCommandDescriptor,
+	newServiceCommand func(context.Context, FileResolver, *ServiceCommandDescriptor) (*cobra.Command, error),
+) error {
+	names := make(map[string]bool)
+	for _, service := range services {
+		desc, ok := opts[service]
+		if !ok {
+			desc = &ServiceCommandDescriptor{Service: service}
+		}
+
+		cmd, err := newServiceCommand(ctx, resolver, desc)
+		if err != nil {
+			return err
+		}
+		if !cmd.HasSubCommands() {
+			continue
+		}
+
+		if names[cmd.Name()] {
+			cmd.Use = service
+		}
+		names[cmd.Name()] = true
+		parent.AddCommand(cmd)
+	}
+
+	return nil
+}
+
+// moduleOptions returns the service command descriptors of the modules, by
+// service.
+func moduleOptions(mbm module.BasicManager) map[string]*ServiceCommandDescriptor {
+	opts := make(map[string]*ServiceCommandDescriptor)
+	for _, b := range mbm {
+		if m, ok := b.(HasAutoCLIConfig); ok {
+			for _, desc := range m.AutoCLIOptions() {
+				opts[desc.Service] = desc
+			}
+		}
+	}
+
+	return opts
+}
+
+// extractFlag removes a string flag from the arguments, and returns its value.
+func extractFlag(args []string, name, defaultValue string) (string, []string) {
+	value := defaultValue
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--"+name && i+1 < len(args):
+			value = args[i+1]
+			i++
+		case strings.HasPrefix(args[i], "--"+name+"="):
+			value = strings.TrimPrefix(args[i], "--"+name+"=")
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+
+	return value, rest
+}
+
+func hasHelpFlag(args []string) bool {
+	for _, arg := range args {
+		if arg == "-h" || arg == "--help" {
+			return true
+		}
+	}
+
+	return len(args) == 0
+}
@@ -0,0 +1,141 @@
+package autocli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/autocli"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) execAutoCLI(args ...string) ([]byte, error) {
+	val := s.network.Validators[0]
+	args = append(args, fmt.Sprintf("--%s=%s", autocli.FlagGRPCAddr, val.AppConfig.GRPC.Address))
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, autocli.Cmd(simapp.ModuleBasics), args)
+	return out.Bytes(), err
+}
+
+func (s *IntegrationTestSuite) TestQueryCommands() {
+	val := s.network.Validators[0]
+
+	// positional args set by the bank module options
+	out, err := s.execAutoCLI("query", "bank", "balance", val.Address.String(), s.cfg.BondDenom, "--output=json")
+	s.Require().NoError(err)
+	var balanceRes banktypes.QueryBalanceResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &balanceRes))
+	s.Require().Equal(s.cfg.BondDenom, balanceRes.Balance.Denom)
+	s.Require().True(balanceRes.Balance.IsPositive())
+
+	// pagination flags
+	out, err = s.execAutoCLI("query", "bank", "all-balances", val.Address.String(), fmt.Sprintf("--%s=1", flags.FlagLimit), "--output=json")
+	s.Require().NoError(err)
+	var allBalancesRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &allBalancesRes))
+	s.Require().Len(allBalancesRes.Balances, 1)
+	s.Require().NotNil(allBalancesRes.Pagination.NextKey)
+
+	// flags of the fields of services without options
+	out, err = s.execAutoCLI("query", "staking", "validator", "--validator-addr", val.ValAddress.String(), "--output=json")
+	s.Require().NoError(err)
+	var validatorRes stakingtypes.QueryValidatorResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &validatorRes))
+	s.Require().Equal(val.ValAddress.String(), validatorRes.Validator.OperatorAddress)
+
+	out, err = s.execAutoCLI("query", "auth", "params", "--output=json")
+	s.Require().NoError(err)
+	var paramsRes authtypes.QueryParamsResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &paramsRes))
+	s.Require().Equal(authtypes.DefaultParams(), paramsRes.Params)
+
+	_, err = s.execAutoCLI("query", "bank", "balance", val.Address.String())
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestTxCommands() {
+	val := s.network.Validators[0]
+	to := sdk.AccAddress("autocli_recipient___")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))
+
+	out, err := s.execAutoCLI(
+		"tx", "bank", "send", to.String(), amount.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+	)
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	out, err = s.execAutoCLI("query", "bank", "all-balances", to.String(), "--output=json")
+	s.Require().NoError(err)
+	var balancesRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &balancesRes))
+	s.Require().Equal(amount, balancesRes.Balances)
+
+	// the signer of MsgEditValidator is its validator operator address
+	out, err = s.execAutoCLI(
+		"tx", "staking", "edit-validator", `--description={"moniker":"autocli"}`,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	out, err = s.execAutoCLI("query", "staking", "validator", "--validator-addr", val.ValAddress.String(), "--output=json")
+	s.Require().NoError(err)
+	var validatorRes stakingtypes.QueryValidatorResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &validatorRes))
+	s.Require().Equal("autocli", validatorRes.Validator.Description.Moniker)
+
+	// the request is validated before being broadcast
+	_, err = s.execAutoCLI(
+		"tx", "bank", "send", to.String(), "0stake",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	)
+	s.Require().Error(err)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,220 @@
+package autocli
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// NewQueryCommand returns the command of a query service, with a subcommand
+// per unary method querying it and printing the response.
+func NewQueryCommand(ctx context.Context, resolver FileResolver, desc *ServiceCommandDescriptor) (*cobra.Command, error) {
+	return newServiceCommand(ctx, resolver, desc, "Querying commands for the %s service", newQueryMethodCommand)
+}
+
+// NewTxCommand returns the command of a Msg service, with a subcommand per
+// method generating or broadcasting a transaction with its request. The
+// signer of the request is the --from key.
+func NewTxCommand(ctx context.Context, resolver FileResolver, desc *ServiceCommandDescriptor) (*cobra.Command, error) {
+	return newServiceCommand(ctx, resolver, desc, "Transactions subcommands for the %s service", newTxMethodCommand)
+}
+
+type newMethodCommandFn func(method string, reqType, resType reflect.Type, opts *RPCCommandOptions) (*cobra.Command, error)
+
+func newServiceCommand(
+	ctx context.Context, resolver FileResolver, desc *ServiceCommandDescriptor, short string, newMethodCommand newMethodCommandFn,
+) (*cobra.Command, error) {
+	fd, err := resolver.FindFileContainingSymbol(ctx, desc.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	var sd *descriptor.ServiceDescriptorProto
+	for _, svc := range fd.Service {
+		if fmt.Sprintf("%s.%s", fd.GetPackage(), svc.GetName()) == desc.Service {
+			sd = svc
+		}
+	}
+	if sd == nil {
+		return nil, fmt.Errorf("service %s not found in %s", desc.Service, fd.GetName())
+	}
+
+	cmd := &cobra.Command{
+		Use:                        desc.Use,
+		Short:                      desc.Short,
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	if cmd.Use == "" {
+		cmd.Use = defaultServiceUse(fd.GetPackage())
+	}
+	if cmd.Short == "" {
+		cmd.Short = fmt.Sprintf(short, desc.Service)
+	}
+
+	rpcOpts := make(map[string]*RPCCommandOptions, len(desc.RPCCommandOptions))
+	for _, opts := range desc.RPCCommandOptions {
+		rpcOpts[opts.RPCMethod] = opts
+	}
+
+	for _, md := range sd.Method {
+		opts, ok := rpcOpts[md.GetName()]
+		if !ok {
+			opts = &RPCCommandOptions{RPCMethod: md.GetName()}
+		}
+		if opts.Skip || md.GetClientStreaming() || md.GetServerStreaming() {
+			continue
+		}
+
+		// methods whose types are not linked into the client cannot be called
+		reqType := proto.MessageType(strings.TrimPrefix(md.GetInputType(), "."))
+		resType := proto.MessageType(strings.TrimPrefix(md.GetOutputType(), "."))
+		if reqType == nil || resType == nil {
+			continue
+		}
+
+		methodCmd, err := newMethodCommand(fmt.Sprintf("/%s/%s", desc.Service, md.GetName()), reqType, resType, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the command of %s.%s: %w", desc.Service, md.GetName(), err)
+		}
+		cmd.AddCommand(methodCmd)
+	}
+
+	return cmd, nil
+}
+
+func newQueryMethodCommand(method string, reqType, resType reflect.Type, opts *RPCCommandOptions) (*cobra.Command, error) {
+	cmd := newMethodCommand(method, opts)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	binder, err := newRequestBinder(cmd, reqType, opts, false)
+	if err != nil {
+		return nil, err
+	}
+	setArgs(cmd, binder, opts)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		req, err := binder.buildRequest(cmd, args, clientCtx.JSONMarshaler, nil)
+		if err != nil {
+			return err
+		}
+
+		res := reflect.New(resType.Elem()).Interface().(proto.Message)
+		if err := clientCtx.Invoke(cmd.Context(), method, req, res); err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	return cmd, nil
+}
+
+func newTxMethodCommand(method string, reqType, _ reflect.Type, opts *RPCCommandOptions) (*cobra.Command, error) {
+	cmd := newMethodCommand(method, opts)
+	flags.AddTxFlagsToCmd(cmd)
+
+	binder, err := newRequestBinder(cmd, reqType, opts, true)
+	if err != nil {
+		return nil, err
+	}
+	setArgs(cmd, binder, opts)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		req, err := binder.buildRequest(cmd, args, clientCtx.JSONMarshaler, clientCtx.GetFromAddress())
+		if err != nil {
+			return err
+		}
+
+		svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+		if err := svcMsgClientConn.Invoke(cmd.Context(), method, req, nil); err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+	}
+
+	return cmd, nil
+}
+
+// newMethodCommand returns the command of a method, whose use is completed
+// with its positional arguments once the request is bound.
+func newMethodCommand(method string, opts *RPCCommandOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     opts.Use,
+		Short:   opts.Short,
+		Long:    opts.Long,
+		Example: opts.Example,
+	}
+	if cmd.Use == "" {
+		cmd.Use = toKebabCase(opts.RPCMethod)
+	}
+	if cmd.Short == "" {
+		cmd.Short = fmt.Sprintf("Execute the %s method", method)
+	}
+
+	return cmd
+}
+
+// setArgs sets the positional arguments of the command, and completes its
+// default use with them.
+func setArgs(cmd *cobra.Command, binder *requestBinder, opts *RPCCommandOptions) {
+	cmd.Args = cobra.ExactArgs(len(binder.args))
+	if opts.Use == "" && len(binder.args) > 0 {
+		cmd.Use = fmt.Sprintf("%s %s", cmd.Use, binder.argsUse())
+	}
+}
+
+var versionRegexp = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// defaultServiceUse returns the last segment of a package which is not a
+// version, e.g. bank for cosmos.bank.v1beta1.
+func defaultServiceUse(pkg string) string {
+	segments := strings.Split(pkg, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !versionRegexp.MatchString(segments[i]) {
+			return segments[i]
+		}
+	}
+
+	return pkg
+}
+
+// toKebabCase converts a method name to kebab case, e.g. AllBalances to
+// all-balances.
+func toKebabCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,133 @@
+package autocli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// FileResolver finds the file descriptor defining a protobuf symbol, such as a
+// service or a message.
+type FileResolver interface {
+	FindFileContainingSymbol(ctx context.Context, symbol string) (*descriptor.FileDescriptorProto, error)
+}
+
+var _ FileResolver = &ReflectionClient{}
+
+// ReflectionClient discovers the services of a node through its reflection
+// services: the query services and the Msg services are listed by the
+// cosmos.base.reflection.v2alpha1 service, and their file descriptors are
+// fetched from the gRPC server reflection service.
+type ReflectionClient struct {
+	conn *grpc.ClientConn
+
+	// msgServiceRequests maps the Msg services to one of their request types.
+	// The node only indexes the files of the services it serves, which do not
+	// include the Msg services, but it does resolve the files of the messages.
+	msgServiceRequests map[string]string
+}
+
+// NewReflectionClient returns a ReflectionClient querying the reflection
+// services of the node the given connection is established with.
+func NewReflectionClient(conn *grpc.ClientConn) *ReflectionClient {
+	return &ReflectionClient{
+		conn:               conn,
+		msgServiceRequests: make(map[string]string),
+	}
+}
+
+// QueryServices returns the sorted names of the query services of the node.
+func (c *ReflectionClient) QueryServices(ctx context.Context) ([]string, error) {
+	res, err := reflection.NewReflectionServiceClient(c.conn).
+		GetQueryServicesDescriptor(ctx, &reflection.GetQueryServicesDescriptorRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]string, 0, len(res.Queries.QueryServices))
+	for _, svc := range res.Queries.QueryServices {
+		services = append(services, svc.Fullname)
+	}
+	sort.Strings(services)
+
+	return services, nil
+}
+
+// MsgServices returns the sorted names of the Msg services of the node.
+func (c *ReflectionClient) MsgServices(ctx context.Context) ([]string, error) {
+	res, err := reflection.NewReflectionServiceClient(c.conn).
+		GetTxDescriptor(ctx, &reflection.GetTxDescriptorRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+	for _, msg := range res.Tx.Msgs {
+		// some modules register their legacy msgs as service msgs too
+		svcMsg := msg.GetServiceMsg()
+		if svcMsg == nil || !msgservice.IsServiceMsg(svcMsg.RequestRoute) {
+			continue
+		}
+
+		// the route of a service msg is /<service>/<method>
+		route := strings.Split(strings.TrimPrefix(svcMsg.RequestRoute, "/"), "/")
+		if _, ok := c.msgServiceRequests[route[0]]; !ok {
+			c.msgServiceRequests[route[0]] = svcMsg.RequestFullname
+			services = append(services, route[0])
+		}
+	}
+	sort.Strings(services)
+
+	return services, nil
+}
+
+// FindFileContainingSymbol implements FileResolver.
+func (c *ReflectionClient) FindFileContainingSymbol(ctx context.Context, symbol string) (*descriptor.FileDescriptorProto, error) {
+	name := symbol
+	if req, ok := c.msgServiceRequests[symbol]; ok {
+		name = req
+	}
+
+	stream, err := rpb.NewServerReflectionClient(c.conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend() //nolint: errcheck
+
+	err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if errRes := res.GetErrorResponse(); errRes != nil {
+		return nil, fmt.Errorf("failed to resolve symbol %s: %s", symbol, errRes.ErrorMessage)
+	}
+
+	// the file containing the symbol comes first, followed by its dependencies
+	files := res.GetFileDescriptorResponse().GetFileDescriptorProto()
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no file found for symbol %s", symbol)
+	}
+
+	fd := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(files[0], fd); err != nil {
+		return nil, fmt.Errorf("invalid file descriptor of symbol %s: %w", symbol, err)
+	}
+
+	return fd, nil
+}
@@ -0,0 +1,514 @@
+package autocli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	coinType        = ".cosmos.base.v1beta1.Coin"
+	decCoinType     = ".cosmos.base.v1beta1.DecCoin"
+	pageRequestType = ".cosmos.base.query.v1beta1.PageRequest"
+	timestampType   = ".google.protobuf.Timestamp"
+	durationType    = ".google.protobuf.Duration"
+)
+
+// fieldBinding binds a request field to a flag or a positional argument.
+type fieldBinding struct {
+	desc  *descriptor.FieldDescriptorProto
+	index []int
+	flag  string
+}
+
+// requestBinder builds the request of a method from the flags and positional
+// arguments of its command.
+type requestBinder struct {
+	typ        reflect.Type
+	flags      []*fieldBinding
+	args       []*fieldBinding
+	pagination *fieldBinding
+	signer     *fieldBinding
+	// signerValoper is whether the signer field holds a validator operator
+	// address rather than an account address
+	signerValoper bool
+}
+
+// newRequestBinder defines on the command the flags of the fields of the
+// request of the given type. Fields whose flag would conflict with the common
+// flags of the command are read from positional arguments, after the ones of
+// opts.PositionalArgs. If withSigner is true, the signer field, if any, is set
+// from the --from key instead.
+func newRequestBinder(cmd *cobra.Command, typ reflect.Type, opts *RPCCommandOptions, withSigner bool) (*requestBinder, error) {
+	msg, ok := reflect.Zero(typ).Interface().(descriptor.Message)
+	if !ok {
+		return nil, fmt.Errorf("%s has no descriptor", typ)
+	}
+	_, md := descriptor.ForMessage(msg)
+
+	goFields := structFieldsByProtoName(typ.Elem())
+	var bindings []*fieldBinding
+	for _, field := range md.Field {
+		goField, ok := goFields[field.GetName()]
+		// oneof fields are not supported
+		if !ok || field.OneofIndex != nil {
+			continue
+		}
+		bindings = append(bindings, &fieldBinding{desc: field, index: goField.Index})
+	}
+
+	b := &requestBinder{typ: typ}
+	if withSigner {
+		if err := b.bindSigner(bindings, opts.Signer); err != nil {
+			return nil, fmt.Errorf("%s of %s", err, md.GetName())
+		}
+	}
+
+	positional := make(map[string]*fieldBinding, len(opts.PositionalArgs))
+	for _, name := range opts.PositionalArgs {
+		positional[name] = nil
+	}
+
+	var conflicting []*fieldBinding
+	for _, binding := range bindings {
+		field := binding.desc
+		switch {
+		case binding == b.signer:
+			continue
+
+		case field.GetTypeName() == pageRequestType:
+			b.pagination = binding
+			flags.AddPaginationFlagsToCmd(cmd, cmd.Name())
+
+		default:
+			if _, ok := positional[field.GetName()]; ok {
+				positional[field.GetName()] = binding
+				continue
+			}
+
+			binding.flag = strings.ReplaceAll(field.GetName(), "_", "-")
+			if cmd.Flags().Lookup(binding.flag) != nil {
+				binding.flag = ""
+				conflicting = append(conflicting, binding)
+				continue
+			}
+
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_BOOL && !field.IsRepeated() {
+				cmd.Flags().Bool(binding.flag, false, fieldUsage(field))
+			} else {
+				cmd.Flags().String(binding.flag, "", fieldUsage(field))
+			}
+			b.flags = append(b.flags, binding)
+		}
+	}
+
+	for _, name := range opts.PositionalArgs {
+		binding := positional[name]
+		if binding == nil {
+			return nil, fmt.Errorf("positional arg %s is not a field of %s", name, md.GetName())
+		}
+		b.args = append(b.args, binding)
+	}
+	b.args = append(b.args, conflicting...)
+
+	return b, nil
+}
+
+// maxSignerCandidates caps the number of string fields of a Msg tried as its
+// signer, as each combination of their address encodings may be tried.
+const maxSignerCandidates = 8
+
+// bindSigner binds the signer field of a Msg: the field of the given name, or
+// by default the field holding the first signer returned by GetSigners, if
+// any. The signer field is found by setting the string fields of requests to
+// distinct addresses, encoded either as account or as validator operator
+// addresses, until GetSigners succeeds.
+func (b *requestBinder) bindSigner(bindings []*fieldBinding, name string) error {
+	var candidates []*fieldBinding
+	for _, binding := range bindings {
+		if binding.desc.IsRepeated() || b.typ.Elem().FieldByIndex(binding.index).Type.Kind() != reflect.String {
+			continue
+		}
+		if name == "" || binding.desc.GetName() == name {
+			candidates = append(candidates, binding)
+		}
+	}
+
+	if name != "" && len(candidates) == 0 {
+		return fmt.Errorf("signer %s is not a string field", name)
+	}
+	if len(candidates) == 0 || len(candidates) > maxSignerCandidates {
+		return nil
+	}
+
+	if _, ok := reflect.New(b.typ.Elem()).Interface().(sdk.MsgRequest); !ok {
+		return nil
+	}
+
+	for mask := 0; mask < 1<<len(candidates); mask++ {
+		req := reflect.New(b.typ.Elem())
+		for i, c := range candidates {
+			addr := probeAddress(i)
+			value := addr.String()
+			if mask&(1<<i) != 0 {
+				value = sdk.ValAddress(addr).String()
+			}
+			req.Elem().FieldByIndex(c.index).SetString(value)
+		}
+
+		signers, ok := getSigners(req.Interface().(sdk.MsgRequest))
+		if !ok || len(signers) == 0 {
+			continue
+		}
+
+		for i, c := range candidates {
+			if signers[0].Equals(probeAddress(i)) {
+				b.signer = c
+				b.signerValoper = mask&(1<<i) != 0
+				return nil
+			}
+		}
+		break
+	}
+
+	// a named signer is set as an account address if GetSigners did not tell
+	// its encoding
+	if name != "" {
+		b.signer = candidates[0]
+	}
+
+	return nil
+}
+
+// probeAddress returns the distinct address set to the i-th candidate signer
+// field.
+func probeAddress(i int) sdk.AccAddress {
+	return bytes.Repeat([]byte{byte(i + 1)}, 20)
+}
+
+// getSigners returns the signers of a Msg, and false if GetSigners panics
+// because a field it reads is not a valid address.
+func getSigners(msg sdk.MsgRequest) (signers []sdk.AccAddress, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			signers, ok = nil, false
+		}
+	}()
+
+	return msg.GetSigners(), true
+}
+
+// argsUse returns the usage of the positional arguments.
+func (b *requestBinder) argsUse() string {
+	use := make([]string, len(b.args))
+	for i, arg := range b.args {
+		use[i] = fmt.Sprintf("[%s]", arg.desc.GetName())
+	}
+
+	return strings.Join(use, " ")
+}
+
+// buildRequest returns the request built from the flags and positional
+// arguments of the command. The signer field, if any, is set to from.
+func (b *requestBinder) buildRequest(cmd *cobra.Command, args []string, cdc codec.JSONMarshaler, from sdk.AccAddress) (proto.Message, error) {
+	req := reflect.New(b.typ.Elem())
+
+	for i, arg := range b.args {
+		if err := setField(req.Elem().FieldByIndex(arg.index), arg.desc, args[i], cdc); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", arg.desc.GetName(), err)
+		}
+	}
+
+	for _, flag := range b.flags {
+		if !cmd.Flags().Changed(flag.flag) {
+			continue
+		}
+
+		value := cmd.Flags().Lookup(flag.flag).Value.String()
+		if err := setField(req.Elem().FieldByIndex(flag.index), flag.desc, value, cdc); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flag.flag, err)
+		}
+	}
+
+	if b.pagination != nil {
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return nil, err
+		}
+		req.Elem().FieldByIndex(b.pagination.index).Set(reflect.ValueOf(pageReq))
+	}
+
+	if b.signer != nil {
+		signer := from.String()
+		if b.signerValoper {
+			signer = sdk.ValAddress(from).String()
+		}
+		req.Elem().FieldByIndex(b.signer.index).SetString(signer)
+	}
+
+	return req.Interface().(proto.Message), nil
+}
+
+// setField sets a request field from its command-line representation: coins
+// are parsed as in the hand-written commands, repeated scalars are comma
+// separated, and messages are given as JSON.
+func setField(v reflect.Value, field *descriptor.FieldDescriptorProto, s string, cdc codec.JSONMarshaler) error {
+	switch field.GetTypeName() {
+	case coinType, decCoinType:
+		return setCoins(v, field, s)
+	}
+
+	if !field.IsRepeated() {
+		return setValue(v, field, s, cdc)
+	}
+
+	var elems []string
+	if field.IsMessage() {
+		var raws []json.RawMessage
+		if err := json.Unmarshal([]byte(s), &raws); err != nil {
+			return err
+		}
+		for _, raw := range raws {
+			elems = append(elems, string(raw))
+		}
+	} else {
+		elems = strings.Split(s, ",")
+	}
+
+	slice := reflect.MakeSlice(v.Type(), len(elems), len(elems))
+	for i, elem := range elems {
+		if err := setValue(slice.Index(i), field, elem, cdc); err != nil {
+			return err
+		}
+	}
+	v.Set(slice)
+
+	return nil
+}
+
+// setValue sets a single value of a field.
+func setValue(v reflect.Value, field *descriptor.FieldDescriptorProto, s string, cdc codec.JSONMarshaler) error {
+	// nullable messages and custom types
+	if v.Kind() == reflect.Ptr {
+		ptr := reflect.New(v.Type().Elem())
+		if err := setValue(ptr.Elem(), field, s, cdc); err != nil {
+			return err
+		}
+		v.Set(ptr)
+
+		return nil
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		if v.Kind() == reflect.String {
+			v.SetString(s)
+			return nil
+		}
+		// custom types, such as sdk.Int and sdk.Dec
+		return unmarshalJSON(v, strconv.Quote(s), cdc)
+
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		bz, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		if !reflect.TypeOf(bz).ConvertibleTo(v.Type()) {
+			return fmt.Errorf("unsupported bytes type %s", v.Type())
+		}
+		v.Set(reflect.ValueOf(bz).Convert(v.Type()))
+
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		n, ok := proto.EnumValueMap(strings.TrimPrefix(field.GetTypeName(), "."))[s]
+		if !ok {
+			i, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return fmt.Errorf("unknown value %s of enum %s", s, field.GetTypeName())
+			}
+			n = int32(i)
+		}
+		v.SetInt(int64(n))
+
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		// well-known types cast to standard types
+		switch {
+		case field.GetTypeName() == timestampType && v.Type() == reflect.TypeOf(time.Time{}):
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(t))
+
+			return nil
+
+		case field.GetTypeName() == durationType && v.Type() == reflect.TypeOf(time.Duration(0)):
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(d))
+
+			return nil
+		}
+
+		return unmarshalJSON(v, s, cdc)
+
+	default:
+		return fmt.Errorf("unsupported field type %s", field.GetType())
+	}
+
+	return nil
+}
+
+// setCoins sets a coin or coins field from its usual representation, e.g.
+// 10stake,5atom.
+func setCoins(v reflect.Value, field *descriptor.FieldDescriptorProto, s string) error {
+	var (
+		coins interface{}
+		err   error
+	)
+	switch {
+	case field.GetTypeName() == decCoinType && field.IsRepeated():
+		coins, err = sdk.ParseDecCoins(s)
+	case field.GetTypeName() == decCoinType:
+		coins, err = sdk.ParseDecCoin(s)
+	case field.IsRepeated():
+		coins, err = sdk.ParseCoinsNormalized(s)
+	default:
+		coins, err = sdk.ParseCoinNormalized(s)
+	}
+	if err != nil {
+		return err
+	}
+
+	cv := reflect.ValueOf(coins)
+	if v.Kind() == reflect.Ptr {
+		ptr := reflect.New(cv.Type())
+		ptr.Elem().Set(cv)
+		cv = ptr
+	}
+	if !cv.Type().ConvertibleTo(v.Type()) {
+		return fmt.Errorf("unsupported coins type %s", v.Type())
+	}
+	v.Set(cv.Convert(v.Type()))
+
+	return nil
+}
+
+// unmarshalJSON sets a message or custom type value from its JSON encoding.
+func unmarshalJSON(v reflect.Value, s string, cdc codec.JSONMarshaler) error {
+	switch ptr := v.Addr().Interface().(type) {
+	case proto.Message:
+		return cdc.UnmarshalJSON([]byte(s), ptr)
+	case json.Unmarshaler:
+		return ptr.UnmarshalJSON([]byte(s))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// fieldUsage returns the usage of the flag of a field, describing the
+// expected format.
+func fieldUsage(field *descriptor.FieldDescriptorProto) string {
+	typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+	switch {
+	case field.GetTypeName() == coinType && field.IsRepeated():
+		return "Coins, e.g. 10stake,5atom"
+	case field.GetTypeName() == coinType:
+		return "Coin, e.g. 10stake"
+	case field.GetTypeName() == decCoinType && field.IsRepeated():
+		return "Decimal coins, e.g. 0.5stake,1.5atom"
+	case field.GetTypeName() == decCoinType:
+		return "Decimal coin, e.g. 0.5stake"
+	case field.GetTypeName() == timestampType:
+		return "Timestamp in RFC3339 format"
+	case field.GetTypeName() == durationType:
+		return "Duration, e.g. 1h30m"
+	case field.IsMessage() && field.IsRepeated():
+		return fmt.Sprintf("JSON array of %s", typeName)
+	case field.IsMessage():
+		return fmt.Sprintf("JSON encoded %s", typeName)
+	case field.IsEnum():
+		values := proto.EnumValueMap(typeName)
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return fmt.Sprintf("%s (%s)", typeName, strings.Join(names, "|"))
+	case field.IsBytes():
+		return "Hex encoded bytes"
+	case field.IsRepeated():
+		return fmt.Sprintf("Comma-separated list of %s", scalarTypeName(field))
+	default:
+		return scalarTypeName(field)
+	}
+}
+
+// scalarTypeName returns the protobuf name of a scalar type, e.g. uint64.
+func scalarTypeName(field *descriptor.FieldDescriptorProto) string {
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
+
+// structFieldsByProtoName maps the protobuf names of the fields of a generated
+// struct to the struct fields.
+func structFieldsByProtoName(typ reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		for _, opt := range strings.Split(field.Tag.Get("protobuf"), ",") {
+			if strings.HasPrefix(opt, "name=") {
+				fields[strings.TrimPrefix(opt, "name=")] = field
+			}
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,55 @@
+package autocli
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRequestBinderSigner(t *testing.T) {
+	testCases := []struct {
+		name       string
+		msg        interface{}
+		signer     string
+		expSigner  string
+		expValoper bool
+		expErr     bool
+	}{
+		{"bank send", &banktypes.MsgSend{}, "", "from_address", false, false},
+		{"multi send has no signer field", &banktypes.MsgMultiSend{}, "", "", false, false},
+		{"signer not first string field", &nfttypes.MsgSend{}, "", "sender", false, false},
+		{"string field with a custom type skipped", &stakingtypes.MsgCreateValidator{}, "", "delegator_address", false, false},
+		{"validator operator signer", &stakingtypes.MsgEditValidator{}, "", "validator_address", true, false},
+		{"named signer", &banktypes.MsgSend{}, "to_address", "to_address", false, false},
+		{"named signer not a string field", &banktypes.MsgSend{}, "amount", "", false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			b, err := newRequestBinder(cmd, reflect.TypeOf(tc.msg), &RPCCommandOptions{Signer: tc.signer}, true)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expSigner == "" {
+				require.Nil(t, b.signer)
+				return
+			}
+			require.NotNil(t, b.signer)
+			require.Equal(t, tc.expSigner, b.signer.desc.GetName())
+			require.Equal(t, tc.expValoper, b.signerValoper)
+			require.Nil(t, cmd.Flags().Lookup(strings.ReplaceAll(tc.expSigner, "_", "-")))
+		})
+	}
+}
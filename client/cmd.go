@@ -190,6 +190,11 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithGenerateOnly(genOnly)
 	}
 
+	if !clientCtx.IsAux || flagSet.Changed(flags.FlagAux) {
+		isAux, _ := flagSet.GetBool(flags.FlagAux)
+		clientCtx = clientCtx.WithAux(isAux)
+	}
+
 	if !clientCtx.Offline || flagSet.Changed(flags.FlagOffline) {
 		offline, _ := flagSet.GetBool(flags.FlagOffline)
 		clientCtx = clientCtx.WithOffline(offline)
@@ -238,14 +243,28 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
 
 		// If the `from` signer account is a ledger key, we need to use
-		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
+		// SIGN_MODE_AMINO_JSON or SIGN_MODE_TEXTUAL, because ledger can't
+		// display protobuf bytes.
 		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON {
+		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON &&
+			clientCtx.SignModeStr != flags.SignModeTextual {
 			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
 			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
 		}
 	}
 
+	// The transaction is signed by the --from key on behalf of the abstract
+	// account, which is the signer of its messages.
+	if flagSet.Changed(flags.FlagAbstractAccount) {
+		abstractAcc, _ := flagSet.GetString(flags.FlagAbstractAccount)
+		abstractAddr, err := sdk.AccAddressFromBech32(abstractAcc)
+		if err != nil {
+			return clientCtx, err
+		}
+
+		clientCtx = clientCtx.WithFromAddress(abstractAddr)
+	}
+
 	return clientCtx, nil
 }
 
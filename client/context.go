@@ -22,33 +22,35 @@ import (
 // Context implements a typical context created in SDK modules for transaction
 // handling and queries.
 type Context struct {
-	FromAddress       sdk.AccAddress
-	Client            rpcclient.Client
-	ChainID           string
-	JSONMarshaler     codec.JSONMarshaler
-	InterfaceRegistry codectypes.InterfaceRegistry
-	Input             io.Reader
-	Keyring           keyring.Keyring
-	KeyringOptions    []keyring.Option
-	Output            io.Writer
-	OutputFormat      string
-	Height            int64
-	HomeDir           string
-	KeyringDir        string
-	From              string
-	BroadcastMode     string
-	FromName          string
-	SignModeStr       string
-	UseLedger         bool
-	Simulate          bool
-	GenerateOnly      bool
-	Offline           bool
-	SkipConfirm       bool
-	TxConfig          TxConfig
-	AccountRetriever  AccountRetriever
-	NodeURI           string
-	FeeGranter        sdk.AccAddress
-	Viper             *viper.Viper
+	FromAddress        sdk.AccAddress
+	Client             rpcclient.Client
+	ChainID            string
+	JSONMarshaler      codec.JSONMarshaler
+	InterfaceRegistry  codectypes.InterfaceRegistry
+	Input              io.Reader
+	Keyring            keyring.Keyring
+	KeyringOptions     []keyring.Option
+	Output             io.Writer
+	OutputFormat       string
+	Height             int64
+	HomeDir            string
+	KeyringDir         string
+	From               string
+	BroadcastMode      string
+	FromName           string
+	SignModeStr        string
+	UseLedger          bool
+	Simulate           bool
+	GenerateOnly       bool
+	IsAux              bool
+	Offline            bool
+	SkipConfirm        bool
+	TxConfig           TxConfig
+	AccountRetriever   AccountRetriever
+	GasPricesRetriever GasPricesRetriever
+	NodeURI            string
+	FeeGranter         sdk.AccAddress
+	Viper              *viper.Viper
 
 	// TODO: Deprecated (remove).
 	LegacyAmino *codec.LegacyAmino
@@ -148,6 +150,12 @@ func (ctx Context) WithKeyringDir(dir string) Context {
 	return ctx
 }
 
+// WithAux returns a copy of the context with an updated IsAux value.
+func (ctx Context) WithAux(isAux bool) Context {
+	ctx.IsAux = isAux
+	return ctx
+}
+
 // WithGenerateOnly returns a copy of the context with updated GenerateOnly value
 func (ctx Context) WithGenerateOnly(generateOnly bool) Context {
 	ctx.GenerateOnly = generateOnly
@@ -219,6 +227,12 @@ func (ctx Context) WithAccountRetriever(retriever AccountRetriever) Context {
 	return ctx
 }
 
+// WithGasPricesRetriever returns the context with an updated GasPricesRetriever
+func (ctx Context) WithGasPricesRetriever(retriever GasPricesRetriever) Context {
+	ctx.GasPricesRetriever = retriever
+	return ctx
+}
+
 // WithInterfaceRegistry returns the context with an updated InterfaceRegistry
 func (ctx Context) WithInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) Context {
 	ctx.InterfaceRegistry = interfaceRegistry
@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 )
 
 // ImportKeyCommand imports private keys from a keyfile.
@@ -38,3 +40,31 @@ func ImportKeyCommand() *cobra.Command {
 		},
 	}
 }
+
+// ImportKeyHexCommand imports private keys from a hex string.
+func ImportKeyHexCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-hex <name> <hex>",
+		Short: "Import private keys into the local keybase",
+		Long: `Import an unarmored hex-encoded private key into the local keybase, e.g. one
+exported with the --unarmored-hex and --unsafe flags of the export command.
+
+Passing the private key as an argument may leave it in your shell history. This
+feature is for advanced users only, to import keys managed by other tools.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			algo, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+
+			return clientCtx.Keyring.ImportPrivKeyHex(args[0], args[1], algo)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Signing algorithm of the private key")
+
+	return cmd
+}
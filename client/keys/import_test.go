@@ -2,6 +2,7 @@ package keys
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -54,3 +56,38 @@ HbP+c6JmeJy9JXe2rbbF1QtCX1gLqGcDQPBXiCtFvP7/8wTZtVOPj8vREzhZ9ElO
 	})
 	require.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func Test_runImportHexCmd(t *testing.T) {
+	cmd := ImportKeyHexCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	// Now add a temporary keybase
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	privKey := secp256k1.GenPrivKey()
+	cmd.SetArgs([]string{
+		"keyname1", hex.EncodeToString(privKey.Bytes()),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	info, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey(), info.GetPubKey())
+
+	// unsupported signing algorithm
+	cmd.SetArgs([]string{
+		"keyname2", hex.EncodeToString(privKey.Bytes()),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyAlgorithm, "fuzzy"),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
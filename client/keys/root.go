@@ -34,6 +34,10 @@ information:
     pass        https://www.passwordstore.org/
 
 The pass backend requires GnuPG: https://gnupg.org/
+
+Several backends can be combined in a comma-separated list, e.g. --keyring-backend os,test.
+Keys are then looked up in every backend in the given order, while new keys are always
+written to the first backend.
 `,
 	}
 
@@ -42,17 +46,19 @@ The pass backend requires GnuPG: https://gnupg.org/
 		AddKeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
+		ImportKeyHexCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
 		flags.LineBreak,
 		DeleteKeyCommand(),
+		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test), or a comma-separated list of backends")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
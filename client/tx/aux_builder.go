@@ -0,0 +1,175 @@
+package tx
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AuxTxBuilder is a client-side builder for creating an AuxSignerData. An
+// auxiliary signer only signs over the tx body and tip with
+// SIGN_MODE_DIRECT_AUX, and hands the resulting AuxSignerData to a fee payer
+// who adds the fee and broadcasts the tx.
+type AuxTxBuilder struct {
+	// body is kept separately from the sign doc, as the sign doc only holds
+	// the body bytes, which are computed in GetSignBytes.
+	body          *tx.TxBody
+	auxSignerData *tx.AuxSignerData
+}
+
+// NewAuxTxBuilder creates a new client-side builder for constructing an
+// AuxSignerData.
+func NewAuxTxBuilder() AuxTxBuilder {
+	return AuxTxBuilder{}
+}
+
+// SetAddress sets the aux signer's bech32 address.
+func (b *AuxTxBuilder) SetAddress(addr string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Address = addr
+}
+
+// SetMemo sets a memo in the tx.
+func (b *AuxTxBuilder) SetMemo(memo string) {
+	b.checkEmptyFields()
+
+	b.body.Memo = memo
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetTimeoutHeight sets a timeout height in the tx.
+func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
+	b.checkEmptyFields()
+
+	b.body.TimeoutHeight = height
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetMsgs sets an array of Msgs in the tx.
+func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		switch msg := msg.(type) {
+		case sdk.ServiceMsg:
+			anys[i], err = codectypes.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		default:
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	b.checkEmptyFields()
+
+	b.body.Messages = anys
+	b.auxSignerData.SignDoc.BodyBytes = nil
+
+	return nil
+}
+
+// SetAccountNumber sets the aux signer's account number in the AuxSignerData.
+func (b *AuxTxBuilder) SetAccountNumber(accNum uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.AccountNumber = accNum
+}
+
+// SetChainID sets the chain id in the AuxSignerData.
+func (b *AuxTxBuilder) SetChainID(chainID string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.ChainId = chainID
+}
+
+// SetSequence sets the aux signer's sequence in the AuxSignerData.
+func (b *AuxTxBuilder) SetSequence(accSeq uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Sequence = accSeq
+}
+
+// SetPubKey sets the aux signer's pubkey in the AuxSignerData.
+func (b *AuxTxBuilder) SetPubKey(pk cryptotypes.PubKey) error {
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return err
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.PublicKey = any
+
+	return nil
+}
+
+// SetTip sets an optional tip in the AuxSignerData.
+func (b *AuxTxBuilder) SetTip(tip *tx.Tip) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Tip = tip
+}
+
+// SetSignature sets the aux signer's signature in the AuxSignerData.
+func (b *AuxTxBuilder) SetSignature(sig []byte) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Sig = sig
+}
+
+// GetSignBytes returns the SIGN_MODE_DIRECT_AUX sign bytes the aux signer
+// signs over.
+func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
+	b.checkEmptyFields()
+
+	bodyBz, err := proto.Marshal(b.body)
+	if err != nil {
+		return nil, err
+	}
+	b.auxSignerData.SignDoc.BodyBytes = bodyBz
+
+	if err := b.auxSignerData.SignDoc.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(b.auxSignerData.SignDoc)
+}
+
+// GetAuxSignerData returns the AuxSignerData of the aux signer. GetSignBytes
+// must have been called first, and the signature set.
+func (b *AuxTxBuilder) GetAuxSignerData() (tx.AuxSignerData, error) {
+	b.checkEmptyFields()
+
+	if len(b.auxSignerData.SignDoc.BodyBytes) == 0 {
+		return tx.AuxSignerData{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign bytes must be generated before getting the aux signer data")
+	}
+
+	b.auxSignerData.Mode = signing.SignMode_SIGN_MODE_DIRECT_AUX
+	if err := b.auxSignerData.ValidateBasic(); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	return *b.auxSignerData, nil
+}
+
+// checkEmptyFields initializes the body and the AuxSignerData, so that the
+// setters never write to a nil pointer.
+func (b *AuxTxBuilder) checkEmptyFields() {
+	if b.body == nil {
+		b.body = &tx.TxBody{}
+	}
+
+	if b.auxSignerData == nil {
+		b.auxSignerData = &tx.AuxSignerData{}
+	}
+	if b.auxSignerData.SignDoc == nil {
+		b.auxSignerData.SignDoc = &tx.SignDocDirectAux{}
+	}
+}
@@ -0,0 +1,181 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestAuxTxBuilder(t *testing.T) {
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 5)), Tipper: addr.String()}
+
+	testCases := []struct {
+		name     string
+		malleate func(b *tx.AuxTxBuilder)
+		expErr   bool
+	}{
+		{
+			"cannot get sign bytes without a body",
+			func(b *tx.AuxTxBuilder) {
+				require.NoError(t, b.SetPubKey(pubKey))
+			},
+			true,
+		},
+		{
+			"cannot get sign bytes without a pubkey",
+			func(b *tx.AuxTxBuilder) {
+				require.NoError(t, b.SetMsgs(msg))
+			},
+			true,
+		},
+		{
+			"cannot get sign bytes with an invalid tip",
+			func(b *tx.AuxTxBuilder) {
+				require.NoError(t, b.SetMsgs(msg))
+				require.NoError(t, b.SetPubKey(pubKey))
+				b.SetTip(&txtypes.Tip{Tipper: addr.String()})
+			},
+			true,
+		},
+		{
+			"happy case",
+			func(b *tx.AuxTxBuilder) {
+				require.NoError(t, b.SetMsgs(msg))
+				require.NoError(t, b.SetPubKey(pubKey))
+				b.SetMemo("memo")
+				b.SetTimeoutHeight(10)
+				b.SetTip(tip)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			b := tx.NewAuxTxBuilder()
+			b.SetAddress(addr.String())
+			b.SetChainID("test-chain")
+			b.SetAccountNumber(1)
+			b.SetSequence(2)
+			tc.malleate(&b)
+
+			signBytes, err := b.GetSignBytes()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the sign bytes are the marshaled SignDocDirectAux
+			var signDoc txtypes.SignDocDirectAux
+			require.NoError(t, signDoc.Unmarshal(signBytes))
+			require.Equal(t, "test-chain", signDoc.ChainId)
+			require.Equal(t, uint64(1), signDoc.AccountNumber)
+			require.Equal(t, uint64(2), signDoc.Sequence)
+			require.Equal(t, tip, signDoc.Tip)
+
+			var body txtypes.TxBody
+			require.NoError(t, body.Unmarshal(signDoc.BodyBytes))
+			require.Equal(t, "memo", body.Memo)
+			require.Equal(t, uint64(10), body.TimeoutHeight)
+
+			// the aux signer data requires a signature
+			_, err = b.GetAuxSignerData()
+			require.Error(t, err)
+
+			b.SetSignature([]byte("signature"))
+			auxSignerData, err := b.GetAuxSignerData()
+			require.NoError(t, err)
+			require.Equal(t, addr.String(), auxSignerData.Address)
+			require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, auxSignerData.Mode)
+			signDocBz, err := auxSignerData.SignDoc.Marshal()
+			require.NoError(t, err)
+			require.Equal(t, signBytes, signDocBz)
+		})
+	}
+}
+
+func TestAuxTxBuilderWithFeePayer(t *testing.T) {
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	require.NoError(t, err)
+
+	auxInfo, _, err := kr.NewMnemonic("aux", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	feePayerInfo, _, err := kr.NewMnemonic("feepayer", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	txConfig := NewTestTxConfig()
+	msg := banktypes.NewMsgSend(auxInfo.GetAddress(), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 10)))
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("ibc/token", 5)), Tipper: auxInfo.GetAddress().String()}
+
+	// the aux signer signs over the body and the tip
+	b := tx.NewAuxTxBuilder()
+	b.SetAddress(auxInfo.GetAddress().String())
+	b.SetChainID("test-chain")
+	b.SetAccountNumber(1)
+	b.SetSequence(2)
+	b.SetTip(tip)
+	require.NoError(t, b.SetMsgs(msg))
+	require.NoError(t, b.SetPubKey(auxInfo.GetPubKey()))
+	signBytes, err := b.GetSignBytes()
+	require.NoError(t, err)
+	sig, _, err := kr.Sign("aux", signBytes)
+	require.NoError(t, err)
+	b.SetSignature(sig)
+	auxSignerData, err := b.GetAuxSignerData()
+	require.NoError(t, err)
+
+	// the fee payer adds the fee and signs over the whole tx
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.AddAuxSignerData(auxSignerData))
+	txBuilder.SetFeePayer(feePayerInfo.GetAddress())
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	txBuilder.SetGasLimit(200000)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithChainID("test-chain").
+		WithAccountNumber(3).
+		WithSequence(4).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, tx.Sign(txf, "feepayer", txBuilder, false))
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+
+	// both signatures are valid over the final tx
+	signersData := []signing.SignerData{
+		{Address: auxInfo.GetAddress().String(), ChainID: "test-chain", AccountNumber: 1, Sequence: 2, PubKey: auxInfo.GetPubKey()},
+		{Address: feePayerInfo.GetAddress().String(), ChainID: "test-chain", AccountNumber: 3, Sequence: 4, PubKey: feePayerInfo.GetPubKey()},
+	}
+	for i, sig := range sigs {
+		err := signing.VerifySignature(context.Background(), sig.PubKey, signersData[i], sig.Data, txConfig.SignModeHandler(), txBuilder.GetTx())
+		require.NoError(t, err)
+	}
+
+	// the tx round-trips through the encoder with the exact body the aux
+	// signer signed over
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	decoded, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	err = signing.VerifySignature(context.Background(), sigs[0].PubKey, signersData[0], sigs[0].Data, txConfig.SignModeHandler(), decoded.(signing.Tx))
+	require.NoError(t, err)
+}
@@ -24,6 +24,8 @@ type Factory struct {
 	chainID            string
 	memo               string
 	fees               sdk.Coins
+	tip                sdk.Coins
+	abstractAccount    sdk.AccAddress
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -39,6 +41,10 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
+	case flags.SignModeDirectAux:
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -71,6 +77,14 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	tipStr, _ := flagSet.GetString(flags.FlagTip)
+	f = f.WithTip(tipStr)
+
+	abstractAccStr, _ := flagSet.GetString(flags.FlagAbstractAccount)
+	if abstractAcc, err := sdk.AccAddressFromBech32(abstractAccStr); err == nil {
+		f = f.WithAbstractAccount(abstractAcc)
+	}
+
 	return f
 }
 
@@ -82,9 +96,11 @@ func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
+func (f Factory) Tip() sdk.Coins                            { return f.tip }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) AbstractAccount() sdk.AccAddress           { return f.abstractAccount }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -125,6 +141,17 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithTip returns a copy of the Factory with an updated tip.
+func (f Factory) WithTip(tip string) Factory {
+	parsedTip, err := sdk.ParseCoinsNormalized(tip)
+	if err != nil {
+		panic(err)
+	}
+
+	f.tip = parsedTip
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -189,3 +216,11 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
 	return f
 }
+
+// WithAbstractAccount returns a copy of the Factory signing on behalf of the
+// given abstract account, whose authenticator verifies the signatures of the
+// keys it signs with instead of the account pubkey.
+func (f Factory) WithAbstractAccount(addr sdk.AccAddress) Factory {
+	f.abstractAccount = addr
+	return f
+}
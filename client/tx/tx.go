@@ -34,6 +34,17 @@ func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet,
 // GenerateOrBroadcastTxWithFactory will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
+	// an aux signer only produces its AuxSignerData, which is sent to the fee
+	// payer out of band
+	if clientCtx.IsAux {
+		auxSignerData, err := makeAuxSignerData(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(&auxSignerData)
+	}
+
 	if clientCtx.GenerateOnly {
 		return GenerateTx(clientCtx, txf, msgs...)
 	}
@@ -73,6 +84,64 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
 }
 
+// makeAuxSignerData generates an AuxSignerData from the client inputs, signed
+// by the from key with SIGN_MODE_DIRECT_AUX.
+func makeAuxSignerData(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (tx.AuxSignerData, error) {
+	if txf.signMode != signing.SignMode_SIGN_MODE_UNSPECIFIED && txf.signMode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return tx.AuxSignerData{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "aux signers can only sign with %s", signing.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	if !clientCtx.Offline {
+		var err error
+		txf, err = prepareFactory(clientCtx, txf)
+		if err != nil {
+			return tx.AuxSignerData{}, err
+		}
+	}
+
+	fromAddr := clientCtx.GetFromAddress()
+	b := NewAuxTxBuilder()
+	b.SetAddress(fromAddr.String())
+	b.SetAccountNumber(txf.AccountNumber())
+	b.SetSequence(txf.Sequence())
+	b.SetChainID(txf.ChainID())
+	b.SetMemo(txf.Memo())
+	b.SetTimeoutHeight(txf.TimeoutHeight())
+
+	if err := b.SetMsgs(msgs...); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	if !txf.Tip().IsZero() {
+		b.SetTip(&tx.Tip{
+			Amount: txf.Tip(),
+			Tipper: fromAddr.String(),
+		})
+	}
+
+	key, err := txf.Keybase().Key(clientCtx.GetFromName())
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	if err := b.SetPubKey(key.GetPubKey()); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	signBz, err := b.GetSignBytes()
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	sig, _, err := txf.Keybase().Sign(clientCtx.GetFromName(), signBz)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	b.SetSignature(sig)
+
+	return b.GetAuxSignerData()
+}
+
 // BroadcastTx attempts to generate, sign and broadcast a transaction with the
 // given set of messages. It will also simulate gas requirements if necessary.
 // It will return an error upon failure.
@@ -296,8 +365,9 @@ func CalculateGas(
 
 // prepareFactory ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
+// they will be queried for and set on the provided Factory. If neither fees nor
+// gas prices are set, the gas prices are queried with the GasPricesRetriever of
+// the context, if any. A new Factory with the updated fields will be returned.
 func prepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
 	from := clientCtx.GetFromAddress()
 
@@ -305,6 +375,15 @@ func prepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
 		return txf, err
 	}
 
+	if clientCtx.GasPricesRetriever != nil && txf.fees.IsZero() && txf.gasPrices.IsZero() {
+		gasPrices, err := clientCtx.GasPricesRetriever.GetGasPrices(clientCtx)
+		if err != nil {
+			return txf, err
+		}
+
+		txf.gasPrices = gasPrices
+	}
+
 	initNum, initSeq := txf.accountNumber, txf.sequence
 	if initNum == 0 || initSeq == 0 {
 		num, seq, err := txf.accountRetriever.GetAccountNumberSequence(clientCtx, from)
@@ -361,9 +440,33 @@ func SignWithPrivKey(
 }
 
 func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
-	if mode == signing.SignMode_SIGN_MODE_DIRECT &&
-		len(tx.GetSigners()) > 1 {
+	numSigners := len(tx.GetSigners())
+	if numSigners <= 1 {
+		return nil
+	}
+
+	// auxiliary signers only sign over the tx body and tip, so a single signer
+	// may still sign over the whole tx in DIRECT or TEXTUAL mode
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	for _, sig := range sigs {
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok && data.SignMode == signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			numSigners--
+		}
+	}
+	if numSigners <= 1 {
+		return nil
+	}
+
+	switch mode {
+	case signing.SignMode_SIGN_MODE_DIRECT:
 		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
+	case signing.SignMode_SIGN_MODE_TEXTUAL:
+		// the signer infos of all signers are part of the hashed raw bytes, as
+		// in DIRECT mode
+		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in TEXTUAL mode is only supported for transactions with one signer only")
 	}
 	return nil
 }
@@ -371,8 +474,9 @@ func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
 // Sign signs a given tx with a named key. The bytes signed over are canconical.
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
-// Signing a transaction with mutltiple signers in the DIRECT mode is not supprted and will
-// return an error.
+// Signing a transaction with mutltiple signers in the DIRECT or TEXTUAL mode is not supprted and will
+// return an error, unless all the other signers are auxiliary signers using SIGN_MODE_DIRECT_AUX.
+// If the Factory has an abstract account, the key signs on its behalf.
 // An error is returned upon failure.
 func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
 	if txf.keybase == nil {
@@ -393,10 +497,17 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		return err
 	}
 	pubKey := key.GetPubKey()
+	signerAddr := sdk.AccAddress(pubKey.Address())
+	if txf.abstractAccount != nil {
+		// the key signs on behalf of the abstract account
+		signerAddr = txf.abstractAccount
+	}
 	signerData := authsigning.SignerData{
+		Address:       signerAddr.String(),
 		ChainID:       txf.chainID,
 		AccountNumber: txf.accountNumber,
 		Sequence:      txf.sequence,
+		PubKey:        pubKey,
 	}
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
@@ -423,7 +534,9 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 			return err
 		}
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+	// The previous signatures are kept in place while signing, as the signer
+	// infos of auxiliary signers are part of the DIRECT sign bytes.
+	if err := txBuilder.SetSignatures(append(prevSignatures, sig)...); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package client
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -42,6 +43,9 @@ type (
 		SetFeeAmount(amount sdk.Coins)
 		SetGasLimit(limit uint64)
 		SetTimeoutHeight(height uint64)
+		SetFeePayer(feePayer sdk.AccAddress)
 		SetFeeGranter(feeGranter sdk.AccAddress)
+		SetTip(tip *tx.Tip)
+		AddAuxSignerData(tx.AuxSignerData) error
 	}
 )
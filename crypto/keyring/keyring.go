@@ -64,6 +64,10 @@ type Keyring interface {
 	Delete(uid string) error
 	DeleteByAddress(address sdk.Address) error
 
+	// Rename renames an existing key from the Keyring. It fails if no key is
+	// stored under from, or if another key is already stored under to.
+	Rename(from string, to string) error
+
 	// NewMnemonic generates a new mnemonic, derives a hierarchical deterministic key from it, and
 	// persists the key to storage. Returns the generated mnemonic and the key Info.
 	// It returns an error if it fails to generate a key for the given algo type, or if
@@ -112,6 +116,10 @@ type Importer interface {
 	// ImportPrivKey imports ASCII armored passphrase-encrypted private keys.
 	ImportPrivKey(uid, armor, passphrase string) error
 
+	// ImportPrivKeyHex imports unarmored hex-encoded private keys of the given
+	// signing algorithm, as exported by UnsafeExporter.UnsafeExportPrivKeyHex.
+	ImportPrivKeyHex(uid, privKey, algoStr string) error
+
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
 }
@@ -163,36 +171,60 @@ func NewInMemory(opts ...Option) Keyring {
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
 // Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+//
+// Several backends can be combined in a comma-separated list, e.g. "os,test".
+// Keys are then looked up in every backend in the given order, while new keys
+// are always written to the first backend.
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
-	var (
-		db  keyring.Keyring
-		err error
-	)
+	backends := strings.Split(backend, ",")
+	if len(backends) == 1 {
+		db, err := openBackend(appName, backend, rootDir, userInput)
+		if err != nil {
+			return nil, err
+		}
+
+		return newKeystore(db, opts...), nil
+	}
+
+	dbs := make([]keyring.Keyring, len(backends))
+	seen := make(map[string]bool, len(backends))
+	for i, b := range backends {
+		b = strings.TrimSpace(b)
+		if seen[b] {
+			return nil, fmt.Errorf("duplicate keyring backend %v", b)
+		}
+		seen[b] = true
 
+		db, err := openBackend(appName, b, rootDir, userInput)
+		if err != nil {
+			return nil, err
+		}
+		dbs[i] = db
+	}
+
+	return newKeystore(newMultiBackend(dbs...), opts...), nil
+}
+
+// openBackend opens the storage of a single keyring backend.
+func openBackend(appName, backend, rootDir string, userInput io.Reader) (keyring.Keyring, error) {
 	switch backend {
 	case BackendMemory:
-		return NewInMemory(opts...), err
+		return keyring.NewArrayKeyring(nil), nil
 	case BackendTest:
-		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
+		return keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
-		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
+		return keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendOS:
-		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
+		return keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendKWallet:
-		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
+		return keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
-		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+		return keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
 	default:
 		return nil, fmt.Errorf("unknown keyring backend %v", backend)
 	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	return newKeystore(db, opts...), nil
 }
 
 type keystore struct {
@@ -305,6 +337,29 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	algo, err := NewSigningAlgoFromString(algoStr, ks.options.SupportedAlgos)
+	if err != nil {
+		return err
+	}
+
+	bz, err := hex.DecodeString(privKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode private key")
+	}
+
+	_, err = ks.writeLocalKey(uid, algo.Generate()(bz), algo.Name())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (ks keystore) ImportPubKey(uid string, armor string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -445,6 +500,66 @@ func (ks keystore) Delete(uid string) error {
 	return nil
 }
 
+func (ks keystore) Rename(from, to string) error {
+	info, err := ks.Key(from)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ks.Key(to); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", to)
+	}
+
+	// the private key of a local key is only reachable through the keystore,
+	// so it must be read before the old entry is removed
+	var priv types.PrivKey
+	if info.GetType() == TypeLocal {
+		if priv, err = ks.ExportPrivateKeyObject(from); err != nil {
+			return err
+		}
+	}
+
+	if err := ks.Delete(from); err != nil {
+		return err
+	}
+
+	if err := ks.writeRenamedKey(info, to, priv); err != nil {
+		// restore the key under its old name
+		if restoreErr := ks.writeInfo(info); restoreErr != nil {
+			return errors.Wrapf(err, "failed to restore key %s: %v", from, restoreErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// writeRenamedKey writes a copy of info stored under a new name. priv must be
+// set for local keys.
+func (ks keystore) writeRenamedKey(info Info, name string, priv types.PrivKey) error {
+	var err error
+
+	switch info.GetType() {
+	case TypeLocal:
+		_, err = ks.writeLocalKey(name, priv, info.GetAlgo())
+	case TypeLedger:
+		path, pathErr := info.GetPath()
+		if pathErr != nil {
+			return pathErr
+		}
+		_, err = ks.writeLedgerKey(name, info.GetPubKey(), *path, info.GetAlgo())
+	case TypeOffline:
+		_, err = ks.writeOfflineKey(name, info.GetPubKey(), info.GetAlgo())
+	case TypeMulti:
+		_, err = ks.writeMultisigKey(name, info.GetPubKey())
+	default:
+		err = fmt.Errorf("cannot rename key of type %s", info.GetType())
+	}
+
+	return err
+}
+
 func (ks keystore) KeyByAddress(address sdk.Address) (Info, error) {
 	ik, err := ks.db.Get(addrHexKeyAsString(address))
 	if err != nil {
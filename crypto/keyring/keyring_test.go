@@ -1116,6 +1116,127 @@ func TestAltKeyring_UnsafeExportPrivKeyHex(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestAltKeyring_ImportPrivKeyHex(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	uid := theID
+	mnemonic, _, err := keyring.NewMnemonic(uid, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	privKey, err := NewUnsafe(keyring).UnsafeExportPrivKeyHex(uid)
+	require.NoError(t, err)
+
+	// Should fail importing private key on existing key.
+	err = keyring.ImportPrivKeyHex(uid, privKey, string(hd.Secp256k1Type))
+	require.EqualError(t, err, fmt.Sprintf("cannot overwrite key: %s", uid))
+
+	require.NoError(t, keyring.Delete(uid))
+
+	// Should fail importing with an unsupported algo or an invalid hex string.
+	err = keyring.ImportPrivKeyHex(otherID, privKey, string(hd.Ed25519Type))
+	require.EqualError(t, err, `provided algorithm "ed25519" is not supported`)
+	err = keyring.ImportPrivKeyHex(otherID, "invalid", string(hd.Secp256k1Type))
+	require.Error(t, err)
+
+	err = keyring.ImportPrivKeyHex(otherID, privKey, string(hd.Secp256k1Type))
+	require.NoError(t, err)
+
+	key, err := keyring.Key(otherID)
+	require.NoError(t, err)
+	require.Equal(t, mnemonic.GetAddress(), key.GetAddress())
+	require.Equal(t, TypeLocal, key.GetType())
+}
+
+func TestAltKeyring_Rename(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	local, _, err := keyring.NewMnemonic(theID, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	offline, err := keyring.SavePubKey(someKey, secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	// Should fail renaming a missing key or onto an existing key.
+	require.Error(t, keyring.Rename("missing", "newKey"))
+	require.EqualError(t, keyring.Rename(theID, someKey), fmt.Sprintf("cannot overwrite key: %s", someKey))
+
+	require.NoError(t, keyring.Rename(theID, otherID))
+	_, err = keyring.Key(theID)
+	require.Error(t, err)
+	key, err := keyring.Key(otherID)
+	require.NoError(t, err)
+	require.Equal(t, otherID, key.GetName())
+	require.Equal(t, local.GetAddress(), key.GetAddress())
+	require.Equal(t, TypeLocal, key.GetType())
+
+	// the renamed key can still sign
+	msg := []byte("some message")
+	sig, pub, err := keyring.Sign(otherID, msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	key, err = keyring.KeyByAddress(local.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, otherID, key.GetName())
+
+	require.NoError(t, keyring.Rename(someKey, "offline"))
+	key, err = keyring.Key("offline")
+	require.NoError(t, err)
+	require.Equal(t, offline.GetAddress(), key.GetAddress())
+	require.Equal(t, TypeOffline, key.GetType())
+
+	list, err := keyring.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
+func TestNewMultiBackendKeyring(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := New(t.Name(), "test,fuzzy", dir, nil)
+	require.EqualError(t, err, "unknown keyring backend fuzzy")
+	_, err = New(t.Name(), "test,test", dir, nil)
+	require.EqualError(t, err, "duplicate keyring backend test")
+
+	// a key stored in the test backend only
+	testKr, err := New(t.Name(), BackendTest, dir, nil)
+	require.NoError(t, err)
+	testKey, _, err := testKr.NewMnemonic(theID, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr, err := New(t.Name(), "memory, test", dir, nil)
+	require.NoError(t, err)
+
+	// keys of every backend are visible
+	key, err := kr.Key(theID)
+	require.NoError(t, err)
+	requireEqualInfo(t, testKey, key)
+
+	// new keys are written to the first backend
+	_, _, err = kr.NewMnemonic(otherID, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = testKr.Key(otherID)
+	require.Error(t, err)
+
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	// keys can't be shadowed by another backend
+	_, _, err = kr.NewMnemonic(theID, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Error(t, err)
+
+	// keys are deleted from the backend that stores them
+	require.NoError(t, kr.Delete(theID))
+	_, err = testKr.Key(theID)
+	require.Error(t, err)
+
+	list, err = kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
 func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
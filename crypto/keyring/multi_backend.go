@@ -0,0 +1,103 @@
+package keyring
+
+import (
+	"github.com/99designs/keyring"
+)
+
+var _ keyring.Keyring = multiBackend{}
+
+// multiBackend combines the storage of several keyring backends. Items are
+// looked up in every backend in order, and written to the first backend only.
+type multiBackend struct {
+	dbs []keyring.Keyring
+}
+
+func newMultiBackend(dbs ...keyring.Keyring) multiBackend {
+	return multiBackend{dbs}
+}
+
+// Get implements keyring.Keyring. It returns the item of the first backend
+// that stores the given key.
+func (mb multiBackend) Get(key string) (keyring.Item, error) {
+	for _, db := range mb.dbs {
+		item, err := db.Get(key)
+		if err == nil {
+			return item, nil
+		}
+		if err != keyring.ErrKeyNotFound {
+			return keyring.Item{}, err
+		}
+	}
+
+	return keyring.Item{}, keyring.ErrKeyNotFound
+}
+
+// GetMetadata implements keyring.Keyring.
+func (mb multiBackend) GetMetadata(key string) (keyring.Metadata, error) {
+	for _, db := range mb.dbs {
+		md, err := db.GetMetadata(key)
+		if err == nil {
+			return md, nil
+		}
+		if err != keyring.ErrKeyNotFound {
+			return keyring.Metadata{}, err
+		}
+	}
+
+	return keyring.Metadata{}, keyring.ErrKeyNotFound
+}
+
+// Set implements keyring.Keyring. Items are always written to the first
+// backend.
+func (mb multiBackend) Set(item keyring.Item) error {
+	return mb.dbs[0].Set(item)
+}
+
+// Remove implements keyring.Keyring. The key is removed from every backend
+// that stores it.
+func (mb multiBackend) Remove(key string) error {
+	found := false
+	for _, db := range mb.dbs {
+		if _, err := db.Get(key); err == keyring.ErrKeyNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := db.Remove(key); err != nil {
+			return err
+		}
+		found = true
+	}
+
+	if !found {
+		return keyring.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// Keys implements keyring.Keyring. It returns the keys of all backends,
+// without duplicates.
+func (mb multiBackend) Keys() ([]string, error) {
+	var (
+		res  []string
+		seen = make(map[string]bool)
+	)
+
+	for _, db := range mb.dbs {
+		keys, err := db.Keys()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			if !seen[key] {
+				seen[key] = true
+				res = append(res, key)
+			}
+		}
+	}
+
+	return res, nil
+}
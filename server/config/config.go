@@ -68,6 +68,12 @@ type BaseConfig struct {
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// AppDBBackend defines the database backend of the application store
+	// (goleveldb, cleveldb, boltdb, rocksdb or badgerdb). If empty, the default
+	// backend of the binary is used, which is goleveldb unless it is built with
+	// another one.
+	AppDBBackend string `mapstructure:"app-db-backend"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -151,6 +157,47 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotDBBackend defines the database backend of the metadata of the
+	// snapshot store. If empty, the backend of the application store is used.
+	SnapshotDBBackend string `mapstructure:"snapshot-db-backend"`
+}
+
+// MempoolConfig defines the configuration of the app-side mempool.
+type MempoolConfig struct {
+	// Type defines the app-side mempool implementation: "none", "priority" or
+	// "sender-nonce".
+	Type string `mapstructure:"type"`
+
+	// MaxTxs defines the maximum number of transactions in the app-side
+	// mempool. A non-positive value means no limit.
+	MaxTxs int `mapstructure:"max-txs"`
+}
+
+// StoreConfig defines application configuration for state streaming and other
+// storage related operations.
+type StoreConfig struct {
+	// Streamers defines the names of the state streaming services to enable.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines concrete state streaming configuration options. These
+// fields are required to be set when state streaming is enabled via a non-empty
+// list defined by 'StoreConfig.Streamers'.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+}
+
+// FileStreamerConfig defines the file streaming service configuration.
+type FileStreamerConfig struct {
+	// Keys defines the store keys to stream, "*" streams all of them.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the block files are written to.
+	WriteDir string `mapstructure:"write_dir"`
+
+	// Prefix defines an optional prefix for the names of the block files.
+	Prefix string `mapstructure:"prefix"`
 }
 
 // Config defines the server's top level configuration
@@ -164,6 +211,9 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
+	Mempool   MempoolConfig    `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -205,6 +255,7 @@ func DefaultConfig() *Config {
 			PruningInterval:   "0",
 			MinRetainBlocks:   0,
 			IndexEvents:       make([]string, 0),
+			AppDBBackend:      "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -237,6 +288,21 @@ func DefaultConfig() *Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
+			SnapshotDBBackend:  "",
+		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "",
+				Prefix:   "",
+			},
+		},
+		Mempool: MempoolConfig{
+			Type:   "none",
+			MaxTxs: 5000,
 		},
 	}
 }
@@ -264,6 +330,7 @@ func GetConfig(v *viper.Viper) Config {
 			HaltTime:          v.GetUint64("halt-time"),
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			AppDBBackend:      v.GetString("app-db-backend"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -303,6 +370,21 @@ func GetConfig(v *viper.Viper) Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotDBBackend:  v.GetString("state-sync.snapshot-db-backend"),
+		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write_dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
+		},
+		Mempool: MempoolConfig{
+			Type:   v.GetString("mempool.type"),
+			MaxTxs: v.GetInt("mempool.max-txs"),
 		},
 	}
 }
@@ -70,6 +70,13 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = {{ .BaseConfig.IndexEvents }}
 
+# AppDBBackend defines the database backend of the application store:
+# goleveldb, cleveldb, boltdb, rocksdb or badgerdb. The backends other than
+# goleveldb must be enabled when building the binary. If empty, the default
+# backend of the binary is used. An existing application store can be copied
+# to another backend with the migrate-app-db command.
+app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -198,6 +205,64 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-db-backend specifies the database backend of the snapshot metadata
+# (empty to use the app-db-backend).
+snapshot-db-backend = "{{ .StateSync.SnapshotDBBackend }}"
+
+###############################################################################
+###                         State Streaming                                 ###
+###############################################################################
+
+# Streaming allows nodes to stream the state changes and ABCI messages of every
+# block to external systems.
+[store]
+
+# streamers defines the streaming services to enable, e.g. ["file"]. An empty
+# list disables state streaming.
+streamers = [{{ range .Store.Streamers }}"{{ . }}", {{ end }}]
+
+[streamers]
+
+# The file streaming service writes one file per block into write_dir. The file
+# contains the length-prefixed BlockMetadata (the ABCI requests and responses)
+# followed by the length-prefixed StoreKVPairs written to the streamed stores.
+[streamers.file]
+
+# keys defines the store keys to stream, "*" streams all of them.
+keys = [{{ range .Streamers.File.Keys }}"{{ . }}", {{ end }}]
+
+# write_dir defines the directory the block files are written to. It must exist.
+write_dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix defines an optional prefix for the names of the block files.
+prefix = "{{ .Streamers.File.Prefix }}"
+
+###############################################################################
+###                         App-side Mempool                                ###
+###############################################################################
+
+# The app-side mempool holds the transactions accepted by CheckTx. Once it is
+# full, new transactions are rejected, or evict lower priority ones, and the
+# transactions it drops are removed from the Tendermint mempool when they are
+# rechecked, which requires the recheck option of config.toml. It is distinct
+# from the [mempool] section of config.toml. It only decides which transactions
+# enter and stay in the Tendermint mempool: Tendermint still includes them in
+# blocks in first-in first-out order.
+[mempool]
+
+# type defines the app-side mempool implementation:
+# - "none": no app-side mempool, Tendermint alone admits transactions
+# - "priority": once the mempool is full, a transaction is only admitted if its
+#   priority, i.e. its gas price, is greater than the lowest one, which is
+#   evicted
+# - "sender-nonce": the transactions of each sender are kept in sequence order,
+#   and new transactions are rejected once the mempool is full
+type = "{{ .Mempool.Type }}"
+
+# max-txs defines the maximum number of transactions in the app-side mempool.
+# A non-positive value means no limit.
+max-txs = {{ .Mempool.MaxTxs }}
 `
 
 var configTemplate *template.Template
@@ -5,12 +5,19 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func Test_openDB(t *testing.T) {
 	t.Parallel()
-	_, err := openDB(t.TempDir())
+	_, err := openDB(t.TempDir(), "")
 	require.NoError(t, err)
+
+	_, err = openDB(t.TempDir(), dbm.MemDBBackend)
+	require.NoError(t, err)
+
+	_, err = openDB(t.TempDir(), "unknowndb")
+	require.Error(t, err)
 }
 
 func Test_openTraceWriter(t *testing.T) {
@@ -0,0 +1,113 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagTargetDir = "target-dir"
+
+	// migrateBatchSize is the number of keys written to the target database
+	// per batch by MigrateAppDBCmd.
+	migrateBatchSize = 10000
+)
+
+// MigrateAppDBCmd copies the application store of the node into a database of
+// another backend, offline.
+func MigrateAppDBCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-app-db <target-backend>",
+		Short: "Copy the application store into a database of another backend",
+		Long: `Copy the application store, opened with the configured app-db-backend, into a new database
+of the given backend (goleveldb, cleveldb, boltdb, rocksdb or badgerdb) in the target directory.
+The node must be stopped. To use the new database, replace the application.db of the data
+directory with the one of the target directory, and set the app-db-backend of app.toml.`,
+		Example: fmt.Sprintf("%s migrate-app-db rocksdb --target-dir /tmp/app-db", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			targetBackend := dbm.BackendType(args[0])
+			targetDir, _ := cmd.Flags().GetString(flagTargetDir)
+			if targetDir == "" {
+				targetDir = filepath.Join(home, "data", args[0])
+			}
+			if _, err := os.Stat(filepath.Join(targetDir, "application.db")); err == nil {
+				return fmt.Errorf("target database %s already exists", filepath.Join(targetDir, "application.db"))
+			}
+
+			src, err := openDB(home, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer src.Close()
+
+			dst, err := sdk.NewDB("application", targetBackend, targetDir)
+			if err != nil {
+				return err
+			}
+			defer dst.Close()
+
+			count, err := copyDB(src, dst)
+			if err != nil {
+				return fmt.Errorf("failed to copy the application store: %w", err)
+			}
+
+			cmd.Printf("Copied %d keys into %s\n", count, filepath.Join(targetDir, "application.db"))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagTargetDir, "", "Directory of the target database (defaults to the <target-backend> directory of the data directory)")
+
+	return cmd
+}
+
+// copyDB copies all the keys of the source database into the target one, in
+// batches, and returns the number of keys copied.
+func copyDB(src, dst dbm.DB) (int, error) {
+	itr, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	count := 0
+	batch := dst.NewBatch()
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Set(itr.Key(), itr.Value()); err != nil {
+			batch.Close()
+			return count, err
+		}
+		count++
+
+		if count%migrateBatchSize == 0 {
+			err := batch.Write()
+			batch.Close()
+			if err != nil {
+				return count, err
+			}
+			batch = dst.NewBatch()
+		}
+	}
+	defer batch.Close()
+
+	if err := itr.Error(); err != nil {
+		return count, err
+	}
+
+	return count, batch.WriteSync()
+}
@@ -0,0 +1,53 @@
+package server_test
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateAppDBCmd(t *testing.T) {
+	home := t.TempDir()
+
+	// fill the application store, and release its database
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	for i := 0; i < 25000; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%05d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, db.Close())
+
+	targetDir := filepath.Join(t.TempDir(), "migrated")
+	output := &bytes.Buffer{}
+	cmd, ctx := setupSnapshotCmd(home, server.MigrateAppDBCmd(home))
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{string(dbm.GoLevelDBBackend), fmt.Sprintf("--target-dir=%s", targetDir)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Equal(t, fmt.Sprintf("Copied 25000 keys into %s\n", filepath.Join(targetDir, "application.db")), output.String())
+
+	migrated, err := sdk.NewDB("application", dbm.GoLevelDBBackend, targetDir)
+	require.NoError(t, err)
+	for _, i := range []int{0, 9999, 10000, 24999} {
+		value, err := migrated.Get([]byte(fmt.Sprintf("key%05d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+	require.NoError(t, migrated.Close())
+
+	// an existing target database must not be overwritten
+	cmd, ctx = setupSnapshotCmd(home, server.MigrateAppDBCmd(home))
+	cmd.SetArgs([]string{string(dbm.GoLevelDBBackend), fmt.Sprintf("--target-dir=%s", targetDir)})
+	require.Error(t, cmd.ExecuteContext(ctx))
+
+	// the backends not built into the binary are rejected
+	cmd, ctx = setupSnapshotCmd(home, server.MigrateAppDBCmd(home))
+	cmd.SetArgs([]string{"unknowndb", fmt.Sprintf("--target-dir=%s", t.TempDir())})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
@@ -3,9 +3,14 @@ package server
 // DONTCOVER
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/spf13/cobra"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -15,12 +20,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 const (
 	FlagHeight           = "height"
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
+	FlagModulesToExport  = "modules-to-export"
+	FlagOutputDocument   = "output-document"
+	FlagOutputDir        = "output-dir"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -28,6 +37,11 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export state to JSON",
+		Long: `Export state to JSON, at the latest height or at a given one which is not pruned. The genesis of
+each module is written as soon as it is exported, if the application supports it, so that the state is
+never held in memory as a whole. It is written to stdout, to a single file with --output-document, or
+to a directory with --output-dir, where each module has its own file. A node reads such a directory
+instead of the app state of its genesis file when started with --genesis-dir.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
@@ -39,7 +53,7 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			db, err := openDB(config.RootDir)
+			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
 				return err
 			}
@@ -67,8 +81,15 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			height, _ := cmd.Flags().GetInt64(FlagHeight)
 			forZeroHeight, _ := cmd.Flags().GetBool(FlagForZeroHeight)
 			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(FlagJailAllowedAddrs)
+			modulesToExport, _ := cmd.Flags().GetStringSlice(FlagModulesToExport)
+			outputDocument, _ := cmd.Flags().GetString(FlagOutputDocument)
+			outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
 
-			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper)
+			if outputDocument != "" && outputDir != "" {
+				return fmt.Errorf("--%s and --%s cannot be used together", FlagOutputDocument, FlagOutputDir)
+			}
+
+			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper, modulesToExport)
 			if err != nil {
 				return fmt.Errorf("error exporting state: %v", err)
 			}
@@ -78,7 +99,6 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			doc.AppState = exported.AppState
 			doc.Validators = exported.Validators
 			doc.InitialHeight = exported.Height
 			doc.ConsensusParams = &tmproto.ConsensusParams{
@@ -97,6 +117,30 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				},
 			}
 
+			if outputDir != "" {
+				return writeGenesisDir(outputDir, doc, exported)
+			}
+
+			// the streamed genesis of the modules are staged next to the
+			// document, or in the home directory when writing to stdout, rather
+			// than in the system temporary directory, which is often in memory
+			out, stagingDir := cmd.OutOrStderr(), config.RootDir
+			if outputDocument != "" {
+				stagingDir = filepath.Dir(outputDocument)
+				f, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			if exported.StreamAppState != nil {
+				return writeStreamedGenesisDoc(out, stagingDir, doc, exported.StreamAppState)
+			}
+
+			doc.AppState = exported.AppState
+
 			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
 			// (except for stuff inside AppState). Inside AppState, we're free
 			// to encode as protobuf or amino.
@@ -105,8 +149,8 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			cmd.Println(string(sdk.MustSortJSON(encoded)))
-			return nil
+			_, err = fmt.Fprintln(out, string(sdk.MustSortJSON(encoded)))
+			return err
 		},
 	}
 
@@ -114,6 +158,120 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().StringSlice(FlagModulesToExport, []string{}, "Comma-separated list of modules to export (all of them if empty)")
+	cmd.Flags().String(FlagOutputDocument, "", "Write the exported genesis to the given file instead of stdout")
+	cmd.Flags().String(FlagOutputDir, "", "Write the exported genesis file and the genesis of each module to their own files in the given directory")
 
 	return cmd
 }
+
+// writeStreamedGenesisDoc writes the genesis document, whose app state is made
+// of the genesis of each module written in turn, so that the application state
+// is never held in memory as a whole. The genesis of the modules are first
+// written to a temporary directory created in stagingDir, so that they are
+// written to the document sorted by module name, as when the application state
+// isn't streamed.
+func writeStreamedGenesisDoc(w io.Writer, stagingDir string, doc *tmtypes.GenesisDoc, streamAppState func(func(string, json.RawMessage) error) error) error {
+	dir, err := ioutil.TempDir(stagingDir, "genesis")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	moduleNames, err := writeModuleGenesisFiles(dir, streamAppState)
+	if err != nil {
+		return err
+	}
+	sort.Strings(moduleNames)
+
+	doc.AppState = nil
+	encoded, err := tmjson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	// the app state is appended to the document, before its closing brace
+	bw := bufio.NewWriter(w)
+	encoded = sdk.MustSortJSON(encoded)
+	if _, err := bw.Write(encoded[:len(encoded)-1]); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString(`,"app_state":{`); err != nil {
+		return err
+	}
+
+	for i, moduleName := range moduleNames {
+		// modules without genesis state have no file, and are encoded as null
+		sorted := []byte("null")
+		genesis, err := ioutil.ReadFile(module.GenesisFilePath(dir, moduleName))
+		switch {
+		case err == nil:
+			if sorted, err = sdk.SortJSON(genesis); err != nil {
+				return err
+			}
+		case !os.IsNotExist(err):
+			return err
+		}
+
+		sep := ","
+		if i == 0 {
+			sep = ""
+		}
+		if _, err := fmt.Fprintf(bw, "%s%q:%s", sep, moduleName, sorted); err != nil {
+			return err
+		}
+	}
+
+	if _, err := bw.WriteString("}}\n"); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// writeGenesisDir writes the genesis document to genesis.json in dir, with an
+// empty app state, and the genesis of each module to its own file, to be read
+// by module.Manager.InitGenesisFromDir.
+func writeGenesisDir(dir string, doc *tmtypes.GenesisDoc, exported types.ExportedApp) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	doc.AppState = json.RawMessage("{}")
+	if err := doc.SaveAs(filepath.Join(dir, "genesis.json")); err != nil {
+		return err
+	}
+
+	streamAppState := exported.StreamAppState
+	if streamAppState == nil {
+		var appState map[string]json.RawMessage
+		if err := json.Unmarshal(exported.AppState, &appState); err != nil {
+			return err
+		}
+
+		streamAppState = func(write func(string, json.RawMessage) error) error {
+			for moduleName, genesis := range appState {
+				if err := write(moduleName, genesis); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	_, err := writeModuleGenesisFiles(dir, streamAppState)
+	return err
+}
+
+// writeModuleGenesisFiles writes the genesis of each exported module to its own
+// file in dir with module.WriteGenesisFile, and returns the names of the
+// exported modules.
+func writeModuleGenesisFiles(dir string, streamAppState func(func(string, json.RawMessage) error) error) ([]string, error) {
+	var moduleNames []string
+	err := streamAppState(func(moduleName string, genesis json.RawMessage) error {
+		moduleNames = append(moduleNames, moduleName)
+		return module.WriteGenesisFile(dir, moduleName, genesis)
+	})
+
+	return moduleNames, err
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
@@ -122,6 +124,111 @@ func TestExportCmd_Height(t *testing.T) {
 
 }
 
+func TestExportCmd_ModulesToExport(t *testing.T) {
+	tempDir := t.TempDir()
+	_, ctx, _, cmd := setupApp(t, tempDir)
+
+	outputDocument := path.Join(tempDir, "exported.json")
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+		fmt.Sprintf("--%s=%s", server.FlagModulesToExport, "bank,auth"),
+		fmt.Sprintf("--%s=%s", server.FlagOutputDocument, outputDocument),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	exportedGenDoc, err := tmtypes.GenesisDocFromFile(outputDocument)
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exportedGenDoc.AppState, &appState))
+	require.Len(t, appState, 2)
+	require.Contains(t, appState, "auth")
+	require.Contains(t, appState, "bank")
+}
+
+func TestExportCmd_SortedAppState(t *testing.T) {
+	tempDir := t.TempDir()
+	app, ctx, _, cmd := setupApp(t, tempDir)
+
+	outputDir := path.Join(tempDir, "exported")
+	require.NoError(t, os.Mkdir(outputDir, 0o755))
+	outputDocument := path.Join(outputDir, "genesis.json")
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+		fmt.Sprintf("--%s=%s", server.FlagOutputDocument, outputDocument),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// the genesis of the modules staged next to the document are removed
+	files, err := ioutil.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	bz, err := ioutil.ReadFile(outputDocument)
+	require.NoError(t, err)
+	var doc map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &doc))
+
+	// the streamed app state is encoded as the sorted app state
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, string(sdk.MustSortJSON(exported.AppState)), string(doc["app_state"]))
+}
+
+func TestExportCmd_UnknownModule(t *testing.T) {
+	tempDir := t.TempDir()
+	_, ctx, _, cmd := setupApp(t, tempDir)
+
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+		fmt.Sprintf("--%s=%s", server.FlagModulesToExport, "foo"),
+	})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestExportCmd_OutputDir(t *testing.T) {
+	tempDir := t.TempDir()
+	app, ctx, _, cmd := setupApp(t, tempDir)
+
+	outputDir := path.Join(tempDir, "exported")
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+		fmt.Sprintf("--%s=%s", server.FlagOutputDir, outputDir),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	exportedGenDoc, err := tmtypes.GenesisDocFromFile(path.Join(outputDir, "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), exportedGenDoc.InitialHeight)
+
+	// a new chain initialized from the exported directory has the same state
+	encCfg := simapp.MakeTestEncodingConfig()
+	newApp := simapp.NewSimApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encCfg,
+		mapAppOptions{server.FlagGenesisDir: outputDir},
+	)
+	newApp.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   exportedGenDoc.AppState,
+	})
+	newApp.Commit()
+
+	for _, moduleName := range []string{"auth", "bank", "staking"} {
+		exported, err := app.ExportAppStateAndValidators(false, nil, []string{moduleName})
+		require.NoError(t, err)
+		imported, err := newApp.ExportAppStateAndValidators(false, nil, []string{moduleName})
+		require.NoError(t, err)
+		require.JSONEq(t, string(exported.AppState), string(imported.AppState))
+	}
+}
+
 func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *tmtypes.GenesisDoc, *cobra.Command) {
 	if err := createConfigFolder(tempDir); err != nil {
 		t.Fatalf("error creating config folder: %s", err)
@@ -149,7 +256,7 @@ func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *t
 	app.Commit()
 
 	cmd := server.ExportCmd(
-		func(_ log.Logger, _ dbm.DB, _ io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string, appOptons types.AppOptions) (types.ExportedApp, error) {
+		func(_ log.Logger, _ dbm.DB, _ io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string, appOptons types.AppOptions, modulesToExport []string) (types.ExportedApp, error) {
 			encCfg := simapp.MakeTestEncodingConfig()
 
 			var simApp *simapp.SimApp
@@ -163,7 +270,7 @@ func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *t
 				simApp = simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, "", 0, encCfg, appOptons)
 			}
 
-			return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+			return simApp.StreamAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 		}, tempDir)
 
 	ctx := context.Background()
@@ -0,0 +1,132 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// FlagCompact is the flag of the prune command compacting the application
+// database once pruned.
+const FlagCompact = "compact"
+
+// PruneCmd prunes the application state offline, according to the given
+// pruning options.
+func PruneCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the application state according to the pruning options",
+		Long: `Prune the versions of the application state which the given pruning options do not keep,
+as if they had been in use since genesis. Changing the pruning options in app.toml only affects
+the heights committed afterwards, and this command deletes the history kept before. The node
+must be stopped.
+
+Pruning options can be provided via the '--pruning' flag or alternatively with '--pruning-keep-recent'
+and 'pruning-keep-every' together, and default to the ones of app.toml. Deleted versions only free
+disk space once the database is compacted, which '--compact' does for goleveldb databases.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			pruningOpts, err := getPruneOptions(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			compact, err := cmd.Flags().GetBool(FlagCompact)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the database must support compaction before it is pruned
+			var levelDB *dbm.GoLevelDB
+			if compact {
+				var ok bool
+				if levelDB, ok = db.(*dbm.GoLevelDB); !ok {
+					return fmt.Errorf("cannot compact a database of type %T", db)
+				}
+			}
+
+			app := appCreator(serverCtx.Logger, db, nil, pruneAppOptions{serverCtx.Viper})
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot prune a store of type %T", app.CommitMultiStore())
+			}
+
+			cmd.Printf("Pruning application state with keep-recent=%d, keep-every=%d\n",
+				pruningOpts.KeepRecent, pruningOpts.KeepEvery)
+			err = cms.PruneVersions(pruningOpts, func(pruned, total int) {
+				cmd.Printf("Pruned %d/%d heights\n", pruned, total)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to prune the application state: %w", err)
+			}
+
+			if levelDB == nil {
+				return nil
+			}
+
+			// compact the whole key range, to reclaim the disk space of the
+			// deleted keys
+			cmd.Println("Compacting application database")
+			return levelDB.DB().CompactRange(util.Range{})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(FlagCompact, false, "Compact the application database once pruned (goleveldb only)")
+
+	return cmd
+}
+
+// getPruneOptions returns the pruning options of the prune command. The
+// interval of custom options, at which a running node prunes its store, isn't
+// used since all the heights are pruned at once.
+func getPruneOptions(appOpts types.AppOptions) (storetypes.PruningOptions, error) {
+	if strings.ToLower(cast.ToString(appOpts.Get(FlagPruning))) != storetypes.PruningOptionCustom {
+		return GetPruningOptionsFromFlags(appOpts)
+	}
+
+	return storetypes.NewPruningOptions(
+		cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
+		cast.ToUint64(appOpts.Get(FlagPruningKeepEvery)),
+		0,
+	), nil
+}
+
+// pruneAppOptions are the options of the application created by the prune
+// command. The application itself doesn't prune, so that its pruning options,
+// which may lack the interval of custom options, are not validated.
+type pruneAppOptions struct {
+	types.AppOptions
+}
+
+// Get implements AppOptions.
+func (o pruneAppOptions) Get(key string) interface{} {
+	if key == FlagPruning {
+		return storetypes.PruningOptionNothing
+	}
+
+	return o.AppOptions.Get(key)
+}
@@ -0,0 +1,82 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPruneCmd_Custom(t *testing.T) {
+	home := t.TempDir()
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	// commit 6 heights without pruning, and release the database
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	stateBytes, err := json.Marshal(simapp.NewDefaultGenesisState(encCfg.Marshaler))
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	for i := int64(1); i <= 6; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		app.EndBlock(abci.RequestEndBlock{Height: i})
+		app.Commit()
+	}
+	require.NoError(t, db.Close())
+
+	// the application is created as simd does, which fails on invalid pruning
+	// options
+	appCreator := func(logger log.Logger, db dbm.DB, _ io.Writer, appOpts types.AppOptions) types.Application {
+		pruningOpts, err := server.GetPruningOptionsFromFlags(appOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, home, 0, encCfg, appOpts, baseapp.SetPruning(pruningOpts))
+	}
+
+	// custom options don't need the pruning interval of a running node, which
+	// is 0 in the default app.toml
+	output := &bytes.Buffer{}
+	cmd, ctx := setupSnapshotCmd(home, server.PruneCmd(appCreator, home))
+	serverCtx := ctx.Value(server.ServerContextKey).(*server.Context)
+	serverCtx.Viper.Set(server.FlagAppDBBackend, string(dbm.GoLevelDBBackend))
+	serverCtx.Viper.Set(server.FlagPruning, "custom")
+	serverCtx.Viper.Set(server.FlagPruningKeepRecent, 1)
+	serverCtx.Viper.Set(server.FlagPruningKeepEvery, 3)
+	serverCtx.Viper.Set(server.FlagPruningInterval, 0)
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{"--compact"})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, output.String(), "Pruned 3/3 heights\n")
+
+	// the heights which are neither recent nor a multiple of keep-every are
+	// pruned
+	db, err = sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+	for height, kept := range map[int64]bool{1: false, 2: false, 3: true, 4: false, 5: true, 6: true} {
+		app := simapp.NewSimApp(log.NewNopLogger(), db, nil, false, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+		err := app.LoadHeight(height)
+		require.Equal(t, kept, err == nil, fmt.Sprintf("height %d: %v", height, err))
+	}
+}
@@ -0,0 +1,181 @@
+package server
+
+// DONTCOVER
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RollbackCmd reverts the application and Tendermint states by one height.
+func RollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the application and Tendermint states by one height",
+		Long: `Rollback the application and Tendermint states by one height, to recover from an invalid
+state transition, such as an app hash mismatch or a bad upgrade. The state at height n is
+overwritten by the state at height n - 1, and the versions of the application store above
+n - 1 are deleted. No blocks are removed, so that once restarted with a fixed binary, the
+node re-executes the transactions of block n. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			db, err := openDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			height, appHash, err := rollbackTendermintState(serverCtx.Config, func(height int64) error {
+				if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+					return fmt.Errorf("failed to rollback the application state: %w", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to rollback state: %w", err)
+			}
+
+			cmd.Printf("Rolled back state to height %d and app hash %X\n", height, appHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// rollbackTendermintState overwrites the Tendermint state at the latest height
+// n with the state at height n - 1, and returns the height and app hash of the
+// new state. The state is left as is if block n was saved without its state,
+// in which case the node is already able to re-execute it. The application
+// state is rolled back to the new height by rollbackApp before the Tendermint
+// state is overwritten, so that a failed rollback can be run again without
+// reverting the Tendermint state by another height.
+func rollbackTendermintState(config *tmcfg.Config, rollbackApp func(height int64) error) (int64, []byte, error) {
+	blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(config.DBBackend), config.DBDir())
+	if err != nil {
+		return 0, nil, err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := dbm.NewDB("state", dbm.BackendType(config.DBBackend), config.DBDir())
+	if err != nil {
+		return 0, nil, err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+
+	invalidState, err := stateStore.Load()
+	if err != nil {
+		return 0, nil, err
+	}
+	if invalidState.IsEmpty() {
+		return 0, nil, errors.New("no state found")
+	}
+
+	height := blockStore.Height()
+
+	// the block and the state are not persisted atomically, and the block of
+	// the next height may have been saved without its state
+	if height == invalidState.LastBlockHeight+1 {
+		if err := rollbackApp(invalidState.LastBlockHeight); err != nil {
+			return 0, nil, err
+		}
+		return invalidState.LastBlockHeight, invalidState.AppHash, nil
+	}
+	if height != invalidState.LastBlockHeight {
+		return 0, nil, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			invalidState.LastBlockHeight, height)
+	}
+
+	rollbackHeight := invalidState.LastBlockHeight - 1
+	rollbackBlock := blockStore.LoadBlockMeta(rollbackHeight)
+	if rollbackBlock == nil {
+		return 0, nil, fmt.Errorf("block at height %d not found", rollbackHeight)
+	}
+
+	// the app hash and results hash of a block are only agreed upon in the
+	// next one
+	latestBlock := blockStore.LoadBlockMeta(invalidState.LastBlockHeight)
+	if latestBlock == nil {
+		return 0, nil, fmt.Errorf("block at height %d not found", invalidState.LastBlockHeight)
+	}
+
+	previousLastValidatorSet, err := stateStore.LoadValidators(rollbackHeight)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	previousParams, err := stateStore.LoadConsensusParams(rollbackHeight + 1)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	// the validator set changed in the last block
+	if valChangeHeight > rollbackHeight {
+		valChangeHeight = rollbackHeight + 1
+	}
+
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	// the consensus params changed in the last block
+	if paramsChangeHeight > rollbackHeight {
+		paramsChangeHeight = rollbackHeight + 1
+	}
+
+	rolledBackState := sm.State{
+		Version: tmstate.Version{
+			Consensus: tmversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   previousParams.Version.AppVersion,
+			},
+			Software: version.TMCoreSemVer,
+		},
+		ChainID:       invalidState.ChainID,
+		InitialHeight: invalidState.InitialHeight,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		NextValidators:              invalidState.Validators,
+		Validators:                  invalidState.LastValidators,
+		LastValidators:              previousLastValidatorSet,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  previousParams,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: latestBlock.Header.LastResultsHash,
+		AppHash:         latestBlock.Header.AppHash,
+	}
+
+	if err := rollbackApp(rolledBackState.LastBlockHeight); err != nil {
+		return 0, nil, err
+	}
+
+	// the validator sets and consensus params saved along with the state are
+	// the ones already stored
+	if err := stateStore.Save(rolledBackState); err != nil {
+		return 0, nil, fmt.Errorf("failed to save the rolled back state: %w", err)
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
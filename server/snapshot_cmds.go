@@ -0,0 +1,370 @@
+package server
+
+// DONTCOVER
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const (
+	// SnapshotFileName is the name of the snapshot metadata entry in a snapshot archive.
+	SnapshotFileName = "_snapshot"
+
+	flagOutput = "output"
+)
+
+// SnapshotCmd returns the snapshots command group, managing the local state sync snapshots
+// of the node offline.
+func SnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(defaultNodeHome),
+		ExportSnapshotCmd(appCreator, defaultNodeHome),
+		ImportSnapshotCmd(defaultNodeHome),
+		DumpSnapshotCmd(defaultNodeHome),
+		RestoreSnapshotCmd(appCreator, defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// ListSnapshotsCmd lists the snapshots of the local snapshot store.
+func ListSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			snapshotStore, err := GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// ExportSnapshotCmd takes a snapshot of the application state into the local snapshot store.
+func ExportSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Take a snapshot of the application state into the local snapshot store",
+		Long: `Take a snapshot of the application state at the given height (the latest height by default),
+and save it into the local snapshot store. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			db, err := openDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = app.Info(abci.RequestInfo{}).LastBlockHeight
+			}
+			if height <= 0 {
+				return errors.New("no application state to snapshot")
+			}
+
+			cmd.Printf("Exporting snapshot for height %d\n", height)
+
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height to export (0 means latest height)")
+
+	return cmd
+}
+
+// RestoreSnapshotCmd restores the application state from a snapshot of the local snapshot store.
+func RestoreSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a snapshot of the local snapshot store. The application
+database must be empty, and the node must be stopped. The Tendermint state is not restored.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			return app.SnapshotManager().RestoreLocalSnapshot(height, format)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// DumpSnapshotCmd dumps a snapshot of the local snapshot store into a portable gzipped tar archive.
+func DumpSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot into a portable archive",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			snapshotStore, err := GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			snapshot, chunks, err := snapshotStore.Load(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot for height %d format %d doesn't exist", height, format)
+			}
+			defer snapshots.DrainChunks(chunks)
+
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := writeSnapshotArchive(file, snapshot, chunks); err != nil {
+				return err
+			}
+
+			return file.Close()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringP(flagOutput, "o", "", "Output file (defaults to <height>-<format>.tar.gz)")
+
+	return cmd
+}
+
+// ImportSnapshotCmd imports a snapshot archive created by the dump command into the local
+// snapshot store.
+func ImportSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <archive-file>",
+		Short: "Import a snapshot archive into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			snapshotStore, err := GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := importSnapshotArchive(snapshotStore, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot imported at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// writeSnapshotArchive writes the snapshot metadata followed by its chunks into a gzipped tar archive.
+func writeSnapshotArchive(w io.Writer, snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := writeArchiveEntry(tarWriter, SnapshotFileName, metadata); err != nil {
+		return err
+	}
+
+	index := 0
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot chunk %d: %w", index, err)
+		}
+		if err := writeArchiveEntry(tarWriter, strconv.Itoa(index), body); err != nil {
+			return err
+		}
+		index++
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, body []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(body)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write archive header %s: %w", name, err)
+	}
+	if _, err := tarWriter.Write(body); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+	return nil
+}
+
+// importSnapshotArchive reads a snapshot archive written by writeSnapshotArchive, and saves it into
+// the snapshot store. The saved snapshot must match the metadata of the archive.
+func importSnapshotArchive(snapshotStore *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	if header.Name != SnapshotFileName {
+		return nil, fmt.Errorf("invalid snapshot archive, expected %s entry first, got %s", SnapshotFileName, header.Name)
+	}
+	metadata, err := ioutil.ReadAll(tarReader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	var snapshot snapshottypes.Snapshot
+	if err := snapshot.Unmarshal(metadata); err != nil {
+		return nil, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		defer close(errCh)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			header, err := tarReader.Next()
+			if err != nil {
+				errCh <- fmt.Errorf("invalid snapshot archive: %w", err)
+				return
+			}
+			if header.Name != strconv.FormatUint(uint64(i), 10) {
+				errCh <- fmt.Errorf("invalid snapshot archive, expected chunk %d, got %s", i, header.Name)
+				return
+			}
+			body, err := ioutil.ReadAll(tarReader)
+			if err != nil {
+				errCh <- fmt.Errorf("invalid snapshot archive: %w", err)
+				return
+			}
+			chunks <- ioutil.NopCloser(bytes.NewReader(body))
+		}
+	}()
+
+	saved, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+	if archiveErr := <-errCh; archiveErr != nil {
+		if saved != nil {
+			_ = snapshotStore.Delete(saved.Height, saved.Format)
+		}
+		return nil, archiveErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(&snapshot, saved) {
+		_ = snapshotStore.Delete(saved.Height, saved.Format)
+		return nil, errors.New("invalid snapshot archive, the saved snapshot doesn't match its metadata")
+	}
+
+	return saved, nil
+}
+
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %s: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %s: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
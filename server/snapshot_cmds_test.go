@@ -0,0 +1,74 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSnapshotCmds_DumpImport(t *testing.T) {
+	srcHome := t.TempDir()
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+	// save a snapshot into the source store, and release its database
+	snapshotDir := filepath.Join(srcHome, "data", "snapshots")
+	db, err := sdk.NewLevelDB("metadata", snapshotDir)
+	require.NoError(t, err)
+	store, err := snapshots.NewStore(db, snapshotDir)
+	require.NoError(t, err)
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- ioutil.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	_, err = store.Save(3, 1, ch)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	cmd, ctx := setupSnapshotCmd(srcHome, server.DumpSnapshotCmd(srcHome))
+	cmd.SetArgs([]string{"3", "1", fmt.Sprintf("--output=%s", archive)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	dstHome := t.TempDir()
+	output := &bytes.Buffer{}
+	cmd, ctx = setupSnapshotCmd(dstHome, server.ImportSnapshotCmd(dstHome))
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{archive})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Equal(t, "Snapshot imported at height 3, format 1, chunks 3\n", output.String())
+
+	for i, chunk := range chunks {
+		body, err := ioutil.ReadFile(filepath.Join(dstHome, "data", "snapshots", "3", "1", fmt.Sprint(i)))
+		require.NoError(t, err)
+		require.Equal(t, chunk, body)
+	}
+
+	// importing a corrupted archive must fail
+	corrupted := filepath.Join(t.TempDir(), "corrupted.tar.gz")
+	require.NoError(t, ioutil.WriteFile(corrupted, []byte("not an archive"), 0600))
+	cmd, ctx = setupSnapshotCmd(t.TempDir(), server.ImportSnapshotCmd(dstHome))
+	cmd.SetArgs([]string{corrupted})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
+
+func setupSnapshotCmd(home string, cmd *cobra.Command) (*cobra.Command, context.Context) {
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = home
+	serverCtx.Viper.Set(flags.FlagHome, home)
+
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+	return cmd, ctx
+}
@@ -56,6 +56,8 @@ const (
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
 	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagAppDBBackend      = "app-db-backend"
+	FlagGenesisDir        = "genesis-dir"
 )
 
 // GRPC-related flags.
@@ -70,6 +72,13 @@ const (
 const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotDBBackend  = "state-sync.snapshot-db-backend"
+)
+
+// App-side mempool flags.
+const (
+	FlagMempoolType   = "mempool.type"
+	FlagMempoolMaxTxs = "mempool.max-txs"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -155,6 +164,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().String(FlagAppDBBackend, "", "Database backend of the application store (goleveldb|cleveldb|boltdb|rocksdb|badgerdb)")
+	cmd.Flags().String(FlagGenesisDir, "", "Directory of the per-module genesis files written by export --output-dir, read at InitChain instead of the app state of the genesis file")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -164,6 +175,10 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().String(FlagStateSyncSnapshotDBBackend, "", "Database backend of the state sync snapshot metadata (defaults to the app-db-backend)")
+
+	cmd.Flags().String(FlagMempoolType, "none", "App-side mempool type (none|priority|sender-nonce)")
+	cmd.Flags().Int(FlagMempoolMaxTxs, 5000, "Maximum number of transactions in the app-side mempool (non-positive for no limit)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -175,7 +190,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home)
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -235,7 +250,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	db, err := openDB(home)
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type (
@@ -46,6 +48,14 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for tendermint queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// SnapshotManager returns the state sync snapshot manager of the app, or nil
+		// if snapshots are disabled.
+		SnapshotManager() *snapshots.Manager
+
+		// CommitMultiStore returns the root multi-store of the app, used by the
+		// offline commands managing the application state.
+		CommitMultiStore() sdk.CommitMultiStore
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -60,6 +70,10 @@ type (
 	ExportedApp struct {
 		// AppState is the application state as JSON.
 		AppState json.RawMessage
+		// StreamAppState, if set, passes the genesis of each exported module in
+		// turn to write, and AppState is nil. It lets the application state be
+		// exported without being held in memory as a whole.
+		StreamAppState func(write func(moduleName string, genesis json.RawMessage) error) error
 		// Validators is the exported validator set.
 		Validators []tmtypes.GenesisValidator
 		// Height is the app's latest block height.
@@ -69,6 +83,7 @@ type (
 	}
 
 	// AppExporter is a function that dumps all app state to
-	// JSON-serializable structure and returns the current validator set.
-	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+	// JSON-serializable structure and returns the current validator set. The
+	// last argument lists the modules to export, all of them if empty.
+	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions, []string) (ExportedApp, error)
 )
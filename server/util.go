@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -23,9 +24,11 @@ import (
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -267,6 +270,10 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		SnapshotCmd(appCreator, defaultNodeHome),
+		MigrateAppDBCmd(defaultNodeHome),
+		RollbackCmd(appCreator, defaultNodeHome),
+		PruneCmd(appCreator, defaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -360,9 +367,47 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
-func openDB(rootDir string) (dbm.DB, error) {
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return sdk.NewLevelDB("application", dataDir)
+	return sdk.NewDB("application", backendType, dataDir)
+}
+
+// GetAppDBBackend returns the database backend of the application store set
+// in the app options, which is empty for the default backend.
+func GetAppDBBackend(appOpts types.AppOptions) dbm.BackendType {
+	return dbm.BackendType(cast.ToString(appOpts.Get(FlagAppDBBackend)))
+}
+
+// GetSnapshotDBBackend returns the database backend of the snapshot metadata
+// set in the app options, defaulting to the backend of the application store.
+func GetSnapshotDBBackend(appOpts types.AppOptions) dbm.BackendType {
+	if backendType := cast.ToString(appOpts.Get(FlagStateSyncSnapshotDBBackend)); backendType != "" {
+		return dbm.BackendType(backendType)
+	}
+
+	return GetAppDBBackend(appOpts)
+}
+
+// GetSnapshotStore opens the state sync snapshot store kept in the data directory of
+// the application home given in the app options.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewDB("metadata", GetSnapshotDBBackend(appOpts), snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+// GetMempool returns the app-side mempool selected by the mempool options of
+// app.toml, given in the app options.
+func GetMempool(appOpts types.AppOptions) (baseapp.Mempool, error) {
+	return baseapp.NewMempool(
+		cast.ToString(appOpts.Get(FlagMempoolType)),
+		cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)),
+	)
 }
 
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
@@ -16,7 +16,32 @@ import (
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *SimApp) ExportAppStateAndValidators(
-	forZeroHeight bool, jailAllowedAddrs []string,
+	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	exported, err := app.StreamAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	genState := make(map[string]json.RawMessage)
+	err = exported.StreamAppState(func(moduleName string, genesis json.RawMessage) error {
+		genState[moduleName] = genesis
+		return nil
+	})
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	exported.StreamAppState = nil
+	exported.AppState, err = json.MarshalIndent(genState, "", "  ")
+	return exported, err
+}
+
+// StreamAppStateAndValidators exports the state of the application for a
+// genesis file, the genesis of each module being exported in turn by
+// StreamAppState.
+func (app *SimApp) StreamAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
@@ -29,15 +54,11 @@ func (app *SimApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
-	}
-
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
 	return servertypes.ExportedApp{
-		AppState:        appState,
+		StreamAppState: func(write func(moduleName string, genesis json.RawMessage) error) error {
+			return app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport, write)
+		},
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
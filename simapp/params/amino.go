@@ -3,9 +3,11 @@
 package params
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // MakeTestEncodingConfig creates an EncodingConfig for an amino based test configuration.
@@ -24,3 +26,9 @@ func MakeTestEncodingConfig() EncodingConfig {
 		Amino:             cdc,
 	}
 }
+
+// MakeTextualTxConfig returns the TxConfig of the provided EncodingConfig, amino
+// transactions don't support SIGN_MODE_TEXTUAL.
+func MakeTextualTxConfig(encodingConfig EncodingConfig, _ textual.CoinMetadataQueryFn) client.TxConfig {
+	return encodingConfig.TxConfig
+}
@@ -3,9 +3,12 @@
 package params
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // MakeTestEncodingConfig creates an EncodingConfig for a non-amino based test configuration.
@@ -24,3 +27,17 @@ func MakeTestEncodingConfig() EncodingConfig {
 		Amino:             cdc,
 	}
 }
+
+// MakeTextualTxConfig returns a TxConfig enabling SIGN_MODE_TEXTUAL on top of the
+// default sign modes of the provided EncodingConfig. Coins are rendered with the
+// metadata returned by coinMetadataQuerier.
+func MakeTextualTxConfig(encodingConfig EncodingConfig, coinMetadataQuerier textual.CoinMetadataQueryFn) client.TxConfig {
+	signModes := append([]signing.SignMode{}, tx.DefaultSignModes...)
+	signModes = append(signModes, signing.SignMode_SIGN_MODE_TEXTUAL)
+
+	return tx.NewTxConfigWithTextual(
+		codec.NewProtoCodec(encodingConfig.InterfaceRegistry),
+		signModes,
+		textual.NewTextual(coinMetadataQuerier),
+	)
+}
@@ -17,6 +17,9 @@ const (
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgCancelUnbondingDelegation   int = 100
+	DefaultWeightMsgTokenizeShares              int = 10
+	DefaultWeightMsgRedeemTokensForShares       int = 25
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
@@ -25,4 +28,7 @@ const (
 	// feegrant
 	DefaultWeightGrantFeeAllowance  int = 100
 	DefaultWeightRevokeFeeAllowance int = 100
+
+	// nft
+	DefaultWeightSendNFT int = 100
 )
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -26,6 +27,7 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -132,7 +134,7 @@ func TestAppImportExport(t *testing.T) {
 
 	fmt.Printf("exporting genesis...\n")
 
-	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	exported, err := app.ExportAppStateAndValidators(false, []string{}, nil)
 	require.NoError(t, err)
 
 	fmt.Printf("importing genesis...\n")
@@ -175,11 +177,19 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authztypes.StoreKey], newApp.keys[authztypes.StoreKey], [][]byte{}},
+		{app.keys[nfttypes.StoreKey], newApp.keys[nfttypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
 		storeA := ctxA.KVStore(skp.A)
 		storeB := ctxB.KVStore(skp.B)
+		if skp.A == app.keys[stakingtypes.StoreKey] {
+			// the historical info is not exported, so it is left out of the
+			// comparison for the staking keys stored after it to be compared
+			// pairwise
+			storeA = withoutPrefix(storeA, stakingtypes.HistoricalInfoKey)
+			storeB = withoutPrefix(storeB, stakingtypes.HistoricalInfoKey)
+		}
 
 		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.Prefixes)
 		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
@@ -189,6 +199,21 @@ func TestAppImportExport(t *testing.T) {
 	}
 }
 
+// withoutPrefix returns an in-memory copy of the store without the keys having
+// the given prefix, leaving the store itself unchanged.
+func withoutPrefix(store sdk.KVStore, prefix []byte) sdk.KVStore {
+	copied := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, bounds := range [][2][]byte{{nil, prefix}, {sdk.PrefixEndBytes(prefix), nil}} {
+		iter := store.Iterator(bounds[0], bounds[1])
+		for ; iter.Valid(); iter.Next() {
+			copied.Set(iter.Key(), iter.Value())
+		}
+		iter.Close()
+	}
+
+	return copied
+}
+
 func TestAppSimulationAfterImport(t *testing.T) {
 	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
 	if skip {
@@ -233,7 +258,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	fmt.Printf("exporting genesis...\n")
 
-	exported, err := app.ExportAppStateAndValidators(true, []string{})
+	exported, err := app.ExportAppStateAndValidators(true, []string{}, nil)
 	require.NoError(t, err)
 
 	fmt.Printf("importing genesis...\n")
@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"io"
 	"io/ioutil"
+	"math"
+	"sort"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -43,8 +45,9 @@ type restoreDone struct {
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
+	store      *Store
+	multistore types.Snapshotter
+	extensions map[string]types.ExtensionSnapshotter
 
 	mtx                sync.Mutex
 	operation          operation
@@ -55,13 +58,34 @@ type Manager struct {
 }
 
 // NewManager creates a new manager.
-func NewManager(store *Store, target types.Snapshotter) *Manager {
+func NewManager(store *Store, multistore types.Snapshotter) *Manager {
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		multistore: multistore,
+		extensions: make(map[string]types.ExtensionSnapshotter),
 	}
 }
 
+// RegisterExtensions registers extension snapshotters to the manager. Their items are
+// streamed after the multi-store items, in the order of their names.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "duplicated snapshotter name: %s", name)
+		}
+		if !IsFormatSupported(extension, extension.SnapshotFormat()) {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshotter %s doesn't support its own snapshot format %v",
+				name, extension.SnapshotFormat())
+		}
+		m.extensions[name] = extension
+	}
+	return nil
+}
+
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
 func (m *Manager) begin(op operation) error {
 	m.mtx.Lock()
@@ -120,11 +144,51 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
-	if err != nil {
-		return nil, err
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, ch)
+
+	return m.store.Save(height, types.CurrentFormat, ch)
+}
+
+// createSnapshot does the heavy work of snapshotting after the validations of the request are done.
+// The multi-store items are streamed first, followed by the items of each extension snapshotter.
+// Errors are passed on to the consumer of the chunks.
+func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer streamWriter.Close()
+
+	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+
+	for _, name := range m.sortedExtensionNames() {
+		extension := m.extensions[name]
+		// write the extension metadata
+		err := streamWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Extension{
+				Extension: &types.SnapshotExtensionMeta{
+					Name:   name,
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		})
+		if err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+
+		payloadWriter := func(payload []byte) error {
+			return types.WriteExtensionItem(streamWriter, payload)
+		}
+		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -163,6 +227,16 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
 	}
+	if snapshot.Format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if snapshot.Height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if snapshot.Height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
+	}
 	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
 		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
 			uint32(len(snapshot.Metadata.ChunkHashes)),
@@ -177,10 +251,9 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 
 	// Start an asynchronous snapshot restoration, passing chunks and completion status via channels.
 	chChunks := make(chan io.ReadCloser, chunkBufferSize)
-	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.restoreSnapshot(snapshot, chChunks)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -188,17 +261,6 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		close(chDone)
 	}()
 
-	// Check for any initial errors from the restore, before any chunks are fed.
-	select {
-	case done := <-chDone:
-		m.endLocked()
-		if done.err != nil {
-			return done.err
-		}
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended unexpectedly")
-	case <-chReady:
-	}
-
 	m.chRestore = chChunks
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
@@ -206,6 +268,102 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores app state from a snapshot kept in the local snapshot store,
+// synchronously. It is used to bootstrap a node offline, without going through state sync.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+
+	if snapshot.Format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if snapshot.Height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
+	}
+
+	err = m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	return m.restoreSnapshot(*snapshot, chChunks)
+}
+
+// restoreSnapshot does the heavy work of snapshot restoration after the preliminary checks on
+// the request have passed. The multi-store items are restored first, and the items following them
+// are dispatched to the extension snapshotters named in the extension metadata items.
+func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+
+	for {
+		if nextItem.Item == nil {
+			// end of stream
+			break
+		}
+		metadata := nextItem.GetExtension()
+		if metadata == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", nextItem.Item)
+		}
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "extension %s is not registered", metadata.Name)
+		}
+		if !IsFormatSupported(extension, metadata.Format) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
+		}
+
+		// the payload reader leaves the first item following the payloads of the extension in nextItem
+		exhausted := false
+		payloadReader := func() ([]byte, error) {
+			if exhausted {
+				return nil, io.EOF
+			}
+			nextItem.Reset()
+			err := streamReader.ReadMsg(&nextItem)
+			if err == io.EOF {
+				exhausted = true
+				return nil, io.EOF
+			} else if err != nil {
+				return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+			}
+			payload := nextItem.GetExtensionPayload()
+			if payload == nil {
+				exhausted = true
+				return nil, io.EOF
+			}
+			return payload.Payload, nil
+		}
+		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
+		}
+		if !exhausted {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "extension %s didn't exhaust its payload stream", metadata.Name)
+		}
+	}
+
+	return nil
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -257,3 +415,24 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	}
 	return false, nil
 }
+
+// sortedExtensionNames returns the names of the registered extensions in sorted order.
+func (m *Manager) sortedExtensionNames() []string {
+	names := make([]string, 0, len(m.extensions))
+	for name := range m.extensions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// IsFormatSupported returns if the snapshotter supports restoration from the given format.
+func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bool {
+	for _, i := range snapshotter.SupportedFormats() {
+		if i == format {
+			return true
+		}
+	}
+	return false
+}
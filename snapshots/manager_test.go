@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -53,12 +54,13 @@ func TestManager_LoadChunk(t *testing.T) {
 
 func TestManager_Take(t *testing.T) {
 	store := setupStore(t)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
 	snapshotter := &mockSnapshotter{
-		chunks: [][]byte{
-			{1, 2, 3},
-			{4, 5, 6},
-			{7, 8, 9},
-		},
+		items: items,
 	}
 	manager := snapshots.NewManager(store, snapshotter)
 
@@ -73,21 +75,31 @@ func TestManager_Take(t *testing.T) {
 	// creating a snapshot at a higher height should be fine, and should return it
 	snapshot, err := manager.Create(5)
 	require.NoError(t, err)
+
+	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, storeSnapshot)
+	expectChunks := readChunks(chunks)
 	assert.Equal(t, &types.Snapshot{
 		Height: 5,
 		Format: types.CurrentFormat,
-		Chunks: 3,
-		Hash:   []uint8{0x47, 0xe4, 0xee, 0x7f, 0x21, 0x1f, 0x73, 0x26, 0x5d, 0xd1, 0x76, 0x58, 0xf6, 0xe2, 0x1c, 0x13, 0x18, 0xbd, 0x6c, 0x81, 0xf3, 0x75, 0x98, 0xe2, 0xa, 0x27, 0x56, 0x29, 0x95, 0x42, 0xef, 0xcf},
+		Chunks: uint32(len(expectChunks)),
+		Hash:   hash(expectChunks),
 		Metadata: types.Metadata{
-			ChunkHashes: checksums([][]byte{
-				{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}),
+			ChunkHashes: checksums(expectChunks),
 		},
 	}, snapshot)
 
-	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	// the snapshot should restore the original items
+	target := &mockSnapshotter{}
+	restorer := snapshots.NewManager(setupStore(t), target)
+	err = restorer.Restore(*snapshot)
 	require.NoError(t, err)
-	assert.Equal(t, snapshot, storeSnapshot)
-	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, readChunks(chunks))
+	for _, chunk := range expectChunks {
+		_, err = restorer.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, items, target.items)
 
 	// creating a snapshot while a different snapshot is being created should error
 	manager = setupBusyManager(t)
@@ -118,11 +130,12 @@ func TestManager_Restore(t *testing.T) {
 	target := &mockSnapshotter{}
 	manager := snapshots.NewManager(store, target)
 
-	chunks := [][]byte{
+	expectItems := [][]byte{
 		{1, 2, 3},
 		{4, 5, 6},
 		{7, 8, 9},
 	}
+	chunks := snapshotItems(expectItems)
 
 	// Restore errors on invalid format
 	err := manager.Restore(types.Snapshot{
@@ -133,16 +146,16 @@ func TestManager_Restore(t *testing.T) {
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.Error(t, err)
-	require.Equal(t, types.ErrUnknownFormat, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
 
 	// Restore errors on no chunks
-	err = manager.Restore(types.Snapshot{Height: 3, Format: 1, Hash: []byte{1, 2, 3}})
+	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
 
 	// Restore errors on chunk and chunkhashes mismatch
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   4,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -152,9 +165,9 @@ func TestManager_Restore(t *testing.T) {
 	// Starting a restore works
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
@@ -182,28 +195,117 @@ func TestManager_Restore(t *testing.T) {
 		}
 	}
 
-	assert.Equal(t, chunks, target.chunks)
+	assert.Equal(t, expectItems, target.items)
 
 	// Starting a new restore should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
-	require.Error(t, err)
+	require.NoError(t, err)
+	var restoreErr error
+	for _, chunk := range chunks {
+		if _, restoreErr = manager.RestoreChunk(chunk); restoreErr != nil {
+			break
+		}
+	}
+	require.Error(t, restoreErr)
 
 	// But if we clear out the target we should be able to start a new restore. This time we'll
 	// fail it with a checksum error. That error should stop the operation, so that we can do
 	// a prune operation right after.
-	target.chunks = nil
+	target.items = nil
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RegisterExtensions(t *testing.T) {
+	manager := snapshots.NewManager(setupStore(t), &mockSnapshotter{})
+
+	err := manager.RegisterExtensions(newExtSnapshotter(1))
+	require.NoError(t, err)
+
+	// registering the same extension name twice should error
+	err = manager.RegisterExtensions(newExtSnapshotter(1))
+	require.Error(t, err)
+}
+
+func TestManager_Extensions(t *testing.T) {
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	source := &mockSnapshotter{items: items}
+	extSource := newExtSnapshotter(10)
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, source)
+	require.NoError(t, manager.RegisterExtensions(extSource))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	payloads := readChunks(chunks)
+
+	target := &mockSnapshotter{}
+	extTarget := newExtSnapshotter(0)
+	restorer := snapshots.NewManager(setupStore(t), target)
+	require.NoError(t, restorer.RegisterExtensions(extTarget))
+
+	require.NoError(t, restorer.Restore(*snapshot))
+	for i, chunk := range payloads {
+		done, err := restorer.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(payloads)-1, done)
+	}
+
+	assert.Equal(t, items, target.items)
+	assert.Equal(t, extSource.state, extTarget.state)
+
+	// restoring a snapshot with an unregistered extension should fail
+	unknown := snapshots.NewManager(setupStore(t), &mockSnapshotter{})
+	require.NoError(t, unknown.Restore(*snapshot))
+	var restoreErr error
+	for _, chunk := range payloads {
+		if _, restoreErr = unknown.RestoreChunk(chunk); restoreErr != nil {
+			break
+		}
+	}
+	require.Error(t, restoreErr)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, &mockSnapshotter{items: items})
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{}
+	restorer := snapshots.NewManager(store, target)
+
+	// restoring a missing snapshot should error
+	err = restorer.RestoreLocalSnapshot(6, types.CurrentFormat)
+	require.True(t, errors.Is(err, sdkerrors.ErrNotFound))
+
+	err = restorer.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// the manager should be available for other operations afterwards
+	_, err = restorer.Prune(10)
+	require.NoError(t, err)
+}
@@ -0,0 +1,125 @@
+package snapshots
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize  = uint64(10e6)
+	snapshotBufferSize = int(snapshotChunkSize)
+	// Do not change compression level without new snapshot format (must be uniform across nodes)
+	snapshotCompressionLevel = 7
+	// SDK has no key/value size limit, so we set an arbitrary limit
+	snapshotMaxItemSize = int(64e6)
+)
+
+// StreamWriter sets up a stream pipeline to serialize snapshot items:
+// items -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+type StreamWriter struct {
+	chunkWriter *ChunkWriter
+	bufWriter   *bufio.Writer
+	zWriter     *zlib.Writer
+	protoWriter protoio.WriteCloser
+}
+
+var _ protoio.WriteCloser = (*StreamWriter)(nil)
+
+// NewStreamWriter sets up a stream pipeline writing snapshot chunks to ch. It returns nil if
+// the pipeline can't be set up, in which case the error is passed on to the chunk readers.
+func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
+	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
+	if err != nil {
+		chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+		return nil
+	}
+	protoWriter := protoio.NewDelimitedWriter(zWriter)
+	return &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufWriter,
+		zWriter:     zWriter,
+		protoWriter: protoWriter,
+	}
+}
+
+// WriteMsg implements protoio.Writer.
+func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
+	return sw.protoWriter.WriteMsg(msg)
+}
+
+// Close implements io.Closer. It flushes the pipeline and closes the chunk stream.
+func (sw *StreamWriter) Close() error {
+	if err := sw.protoWriter.Close(); err != nil {
+		sw.chunkWriter.CloseWithError(err)
+		return err
+	}
+	if err := sw.zWriter.Close(); err != nil {
+		sw.chunkWriter.CloseWithError(err)
+		return err
+	}
+	if err := sw.bufWriter.Flush(); err != nil {
+		sw.chunkWriter.CloseWithError(err)
+		return err
+	}
+	return sw.chunkWriter.Close()
+}
+
+// CloseWithError closes the chunk stream and passes err on to the chunk readers.
+func (sw *StreamWriter) CloseWithError(err error) {
+	sw.chunkWriter.CloseWithError(err)
+}
+
+// StreamReader sets up a restore stream pipeline:
+// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> items
+type StreamReader struct {
+	chunkReader *ChunkReader
+	zReader     io.ReadCloser
+	protoReader protoio.ReadCloser
+}
+
+var _ protoio.ReadCloser = (*StreamReader)(nil)
+
+// NewStreamReader sets up a restore stream pipeline reading snapshot chunks from chunks.
+func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
+	chunkReader := NewChunkReader(chunks)
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		chunkReader.Close()
+		return nil, sdkerrors.Wrap(err, "zlib failure")
+	}
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	return &StreamReader{
+		chunkReader: chunkReader,
+		zReader:     zReader,
+		protoReader: protoReader,
+	}, nil
+}
+
+// ReadMsg implements protoio.Reader.
+func (sr *StreamReader) ReadMsg(msg proto.Message) error {
+	return sr.protoReader.ReadMsg(msg)
+}
+
+// Close implements io.Closer. It closes the pipeline and drains the remaining chunks.
+func (sr *StreamReader) Close() error {
+	var err error
+	if err1 := sr.protoReader.Close(); err1 != nil {
+		err = err1
+	}
+	if err2 := sr.zReader.Close(); err2 != nil && err == nil {
+		err = err2
+	}
+	if err3 := sr.chunkReader.Close(); err3 != nil && err == nil {
+		err = err3
+	}
+	return err
+}
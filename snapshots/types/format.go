@@ -3,4 +3,6 @@ package types
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+//
+// Format 2 streams the items of the registered extension snapshotters after the multi-store items.
+const CurrentFormat uint32 = 2
@@ -1,16 +1,47 @@
 package types
 
-import "io"
+import (
+	protoio "github.com/gogo/protobuf/io"
+)
 
 // Snapshotter is something that can create and restore snapshots, consisting of streamed binary
 // chunks - all of which must be read from the channel and closed. If an unsupported format is
 // given, it must return ErrUnknownFormat (possibly wrapped with fmt.Errorf).
 type Snapshotter interface {
-	// Snapshot creates a state snapshot, returning a channel of snapshot chunk readers.
-	Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error)
+	// Snapshot writes snapshot items into the protobuf writer.
+	Snapshot(height uint64, protoWriter protoio.Writer) error
 
-	// Restore restores a state snapshot, taking snapshot chunk readers as input.
-	// If the ready channel is non-nil, it returns a ready signal (by being closed) once the
-	// restorer is ready to accept chunks.
-	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
+	// Restore restores a state snapshot from the protobuf items read from the reader.
+	// It returns the first item it doesn't handle itself (e.g. the metadata of the first
+	// extension), or an empty item once the end of the stream has been reached.
+	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
+// ExtensionPayloadReader reads extension payloads. It returns io.EOF once it has reached
+// either the end of the stream or the boundaries of the extension.
+type ExtensionPayloadReader = func() ([]byte, error)
+
+// ExtensionPayloadWriter is a helper to write extension payloads to the underlying stream.
+type ExtensionPayloadWriter = func([]byte) error
+
+// ExtensionSnapshotter is an extension Snapshotter whose items are appended to the snapshot
+// stream after the multi-store items. It has a unique name and manages its own payload formats,
+// which are independent of the format of the snapshot as a whole.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the snapshotter, it must be unique in the manager.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format the snapshotter uses to encode its payloads when
+	// taking a snapshot.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the formats the snapshotter can restore payloads from.
+	SupportedFormats() []uint32
+
+	// SnapshotExtension writes the extension payloads for the given height.
+	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// RestoreExtension restores the extension state from the payloads read from payloadReader,
+	// which returns io.EOF once the extension boundaries have been reached.
+	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
 }
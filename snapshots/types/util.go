@@ -0,0 +1,16 @@
+package types
+
+import (
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// WriteExtensionItem writes an item payload for the current extension snapshotter.
+func WriteExtensionItem(protoWriter protoio.Writer, item []byte) error {
+	return protoWriter.WriteMsg(&SnapshotItem{
+		Item: &SnapshotItem_ExtensionPayload{
+			ExtensionPayload: &SnapshotExtensionPayload{
+				Payload: item,
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"testing"
 
@@ -19,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
@@ -564,7 +566,7 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 		format      uint32
 		chunkHashes []string
 	}{
-		{1, []string{
+		{2, []string{
 			"503e5b51b657055b77e88169fadae543619368744ad15f1de0736c0a20482f24",
 			"e1a0daaa738eeb43e778aefd2805e3dd720798288a410b06da4b8459c4d8f72e",
 			"aa048b4ee0f484965d7b3b06822cf0772cdcaad02f3b1b9055e69f2cb365ef3c",
@@ -576,11 +578,17 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	for _, tc := range testcases {
 		tc := tc
 		t.Run(fmt.Sprintf("Format %v", tc.format), func(t *testing.T) {
-			chunks, err := store.Snapshot(version, tc.format)
-			require.NoError(t, err)
+			ch := make(chan io.ReadCloser)
+			go func() {
+				streamWriter := snapshots.NewStreamWriter(ch)
+				require.NotNil(t, streamWriter)
+				defer streamWriter.Close()
+				err := store.Snapshot(version, streamWriter)
+				require.NoError(t, err)
+			}()
 			hashes := []string{}
 			hasher := sha256.New()
-			for chunk := range chunks {
+			for chunk := range ch {
 				hasher.Reset()
 				_, err := io.Copy(hasher, chunk)
 				require.NoError(t, err)
@@ -597,18 +605,15 @@ func TestMultistoreSnapshot_Errors(t *testing.T) {
 
 	testcases := map[string]struct {
 		height     uint64
-		format     uint32
 		expectType error
 	}{
-		"0 height":       {0, snapshottypes.CurrentFormat, nil},
-		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
-		"unknown height": {9, snapshottypes.CurrentFormat, nil},
-		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+		"0 height":       {0, nil},
+		"unknown height": {9, nil},
 	}
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			_, err := store.Snapshot(tc.height, tc.format)
+			err := store.Snapshot(tc.height, nil)
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -625,14 +630,13 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 		format     uint32
 		expectType error
 	}{
-		"0 height":       {0, snapshottypes.CurrentFormat, nil},
-		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
-		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+		"0 height":   {0, snapshottypes.CurrentFormat, nil},
+		"max height": {math.MaxInt64 + 1, snapshottypes.CurrentFormat, snapshottypes.ErrInvalidMetadata},
 	}
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			err := store.Restore(tc.height, tc.format, nil, nil)
+			_, err := store.Restore(tc.height, tc.format, nil)
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -646,13 +650,32 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
 	version := uint64(source.LastCommitID().Version)
 	require.EqualValues(t, 3, version)
+	dummyExtensionItem := snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{
+				Name:   "test",
+				Format: 1,
+			},
+		},
+	}
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(t, streamWriter)
+		defer streamWriter.Close()
+		err := source.Snapshot(version, streamWriter)
+		require.NoError(t, err)
+		// write an extension metadata
+		err = streamWriter.WriteMsg(&dummyExtensionItem)
+		require.NoError(t, err)
+	}()
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	nextItem, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
 	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
+	require.Equal(t, *dummyExtensionItem.GetExtension(), *nextItem.GetExtension())
 
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 	for key, sourceStore := range source.stores {
@@ -685,6 +708,70 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestRollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	k, v := []byte("key"), []byte("value")
+	s1 := store.getStoreByName("store1").(types.KVStore)
+	var commitIDs []types.CommitID
+	for i := 1; i <= 3; i++ {
+		s1.Set(k, append(v, byte(i)))
+		commitIDs = append(commitIDs, store.Commit())
+	}
+
+	require.Error(t, store.RollbackToVersion(0))
+	require.Error(t, store.RollbackToVersion(4))
+
+	require.NoError(t, store.RollbackToVersion(2))
+	require.Equal(t, commitIDs[1], store.LastCommitID())
+	require.Equal(t, append(v, byte(2)), store.getStoreByName("store1").(types.KVStore).Get(k))
+	_, err := getCommitInfo(db, 3)
+	require.Error(t, err)
+
+	// the rollback is persisted, and the next commit rewrites the version
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, commitIDs[1], store.LastCommitID())
+
+	store.getStoreByName("store1").(types.KVStore).Set(k, append(v, byte(3)))
+	require.Equal(t, commitIDs[2], store.Commit())
+}
+
+func TestPruneVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	for i := 1; i <= 10; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte{byte(i)})
+		store.Commit()
+	}
+
+	var pruned, total int
+	err := store.PruneVersions(types.NewPruningOptions(2, 5, 10), func(p, t int) {
+		pruned, total = p, t
+	})
+	require.NoError(t, err)
+	require.Equal(t, 6, pruned)
+	require.Equal(t, 6, total)
+
+	kept := map[int64]bool{5: true, 8: true, 9: true, 10: true}
+	for v := int64(1); v <= 10; v++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			s := store.GetCommitKVStore(store.keysByName[name]).(*iavl.Store)
+			require.Equal(t, kept[v], s.VersionExists(v), "store %s, version %d", name, v)
+		}
+	}
+
+	// the pruned state is persisted
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, int64(10), store.LastCommitID().Version)
+	require.Empty(t, store.pruneHeights)
+}
+
 func TestAddListenersAndListeningEnabled(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -790,6 +877,34 @@ func TestGetListenWrappedKVStore(t *testing.T) {
 	require.Equal(t, []byte{}, kvPairDelete3Bytes)
 }
 
+func TestCacheMultiStoreWithListeners(t *testing.T) {
+	buf := new(bytes.Buffer)
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.AddListeners(testStoreKey1, []types.WriteListener{types.NewStoreKVPairWriteListener(buf, testMarshaller)})
+
+	cacheMulti := ms.CacheMultiStore()
+	nestedCacheMulti := cacheMulti.CacheMultiStore()
+
+	// writes flushed from a nested branch are not emitted
+	nestedCacheMulti.GetKVStore(testStoreKey1).Set(testKey1, testValue1)
+	nestedCacheMulti.Write()
+	require.Empty(t, buf.Bytes())
+
+	// writes flushed from the root branch are emitted and persisted
+	cacheMulti.Write()
+	expectedOutputKVPairSet1, err := testMarshaller.MarshalBinaryLengthPrefixed(&types.StoreKVPair{
+		Key:      testKey1,
+		Value:    testValue1,
+		StoreKey: testStoreKey1.Name(),
+		Delete:   false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedOutputKVPairSet1, buf.Bytes())
+	require.Equal(t, testValue1, ms.GetStore(testStoreKey1).(types.KVStore).Get(testKey1))
+}
+
 func TestCacheWraps(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -839,8 +954,14 @@ func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(uint64(version), snapshottypes.CurrentFormat)
-		require.NoError(b, err)
+		chunks := make(chan io.ReadCloser)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(b, streamWriter)
+			err := source.Snapshot(uint64(version), streamWriter)
+			require.NoError(b, err)
+			require.NoError(b, streamWriter.Close())
+		}()
 		for reader := range chunks {
 			_, err := io.Copy(ioutil.Discard, reader)
 			require.NoError(b, err)
@@ -869,9 +990,17 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+		chunks := make(chan io.ReadCloser)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(b, streamWriter)
+			err := source.Snapshot(version, streamWriter)
+			require.NoError(b, err)
+			require.NoError(b, streamWriter.Close())
+		}()
+		streamReader, err := snapshots.NewStreamReader(chunks)
 		require.NoError(b, err)
-		err = target.Restore(version, snapshottypes.CurrentFormat, chunks, nil)
+		_, err = target.Restore(version, snapshottypes.CurrentFormat, streamReader)
 		require.NoError(b, err)
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
@@ -0,0 +1,99 @@
+package streaming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+type fakeOptions map[string]interface{}
+
+func (f fakeOptions) Get(key string) interface{} {
+	return f[key]
+}
+
+var (
+	mockKeys = []types.StoreKey{types.NewKVStoreKey("mockKey1"), types.NewKVStoreKey("mockKey2")}
+
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+)
+
+func TestStreamingServiceConstructor(t *testing.T) {
+	_, err := NewServiceConstructor("unexpectedName")
+	require.Error(t, err)
+
+	constructor, err := NewServiceConstructor("file")
+	require.NoError(t, err)
+
+	writeDir := t.TempDir()
+	opts := fakeOptions{
+		"streamers.file.write_dir": writeDir,
+		"streamers.file.prefix":    "prefix-",
+	}
+	service, err := constructor(opts, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.IsType(t, &file.StreamingService{}, service)
+
+	listeners := service.Listeners()
+	for _, key := range mockKeys {
+		require.Len(t, listeners[key], 1)
+	}
+}
+
+func TestLoadStreamingServices(t *testing.T) {
+	keys := map[string]*types.KVStoreKey{
+		"mockKey1": types.NewKVStoreKey("mockKey1"),
+		"mockKey2": types.NewKVStoreKey("mockKey2"),
+	}
+
+	testCases := []struct {
+		name       string
+		opts       fakeOptions
+		numStreams int
+		expErr     bool
+	}{
+		{"no streamers", fakeOptions{}, 0, false},
+		{"all keys", fakeOptions{
+			"store.streamers":          []string{"file"},
+			"streamers.file.keys":      []string{"*"},
+			"streamers.file.write_dir": t.TempDir(),
+		}, 1, false},
+		{"unknown keys are skipped", fakeOptions{
+			"store.streamers":          []string{"file"},
+			"streamers.file.keys":      []string{"unknownKey"},
+			"streamers.file.write_dir": t.TempDir(),
+		}, 0, false},
+		{"unknown streamer", fakeOptions{
+			"store.streamers":        []string{"unknown"},
+			"streamers.unknown.keys": []string{"*"},
+		}, 0, true},
+		{"missing write dir", fakeOptions{
+			"store.streamers":          []string{"file"},
+			"streamers.file.keys":      []string{"mockKey1"},
+			"streamers.file.write_dir": "/non/existent/dir",
+		}, 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bApp := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), nil)
+			services, err := LoadStreamingServices(bApp, tc.opts, testMarshaller, keys)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, services, tc.numStreams)
+		})
+	}
+}
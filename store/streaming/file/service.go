@@ -0,0 +1,192 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a concrete implementation of baseapp.StreamingService that writes
+// the state changes and ABCI messages of every block out to a file.
+//
+// Each block is written to a file named "{prefix}block-{height}" in the write directory.
+// The file contains the length-prefixed, protobuf encoded BlockMetadata of the block,
+// followed by the length-prefixed, protobuf encoded StoreKVPairs written to the exposed
+// stores during that block, in the order they were flushed to the CommitMultiStore.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix string                                   // optional prefix for each of the generated files
+	writeDir   string                                   // directory to write files into
+	codec      codec.BinaryMarshaler                    // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
+
+	mtx           sync.Mutex
+	stateCache    *bytes.Buffer        // the length-prefixed StoreKVPairs written during the current block
+	currentBlock  *types.BlockMetadata // the ABCI messages of the current block
+	currentHeight int64                // the height of the current block
+	closed        bool
+}
+
+// NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryMarshaler) (*StreamingService, error) {
+	// sanity check that the write directory exists and is writable
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	fss := &StreamingService{
+		listeners:    make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		filePrefix:   filePrefix,
+		writeDir:     writeDir,
+		codec:        c,
+		stateCache:   new(bytes.Buffer),
+		currentBlock: new(types.BlockMetadata),
+	}
+
+	// all the listeners write their length-prefixed StoreKVPairs into the state cache of the service
+	listener := types.NewStoreKVPairWriteListener(cacheWriter{fss}, c)
+	for _, key := range storeKeys {
+		fss.listeners[key] = append(fss.listeners[key], listener)
+	}
+
+	return fss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts recording the ABCI messages of a new block
+func (fss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.mtx.Lock()
+	defer fss.mtx.Unlock()
+
+	fss.currentHeight = req.Header.Height
+	fss.currentBlock = &types.BlockMetadata{
+		RequestBeginBlock:  &req,
+		ResponseBeginBlock: &res,
+	}
+
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It records the DeliverTx request and response for the current block
+func (fss *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	fss.mtx.Lock()
+	defer fss.mtx.Unlock()
+
+	fss.currentBlock.DeliverTxs = append(fss.currentBlock.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It records the EndBlock request and response for the current block
+func (fss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	fss.mtx.Lock()
+	defer fss.mtx.Unlock()
+
+	fss.currentBlock.RequestEndBlock = &req
+	fss.currentBlock.ResponseEndBlock = &res
+
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the ABCI messages and the state changes of the current block out to the block's file
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	fss.mtx.Lock()
+	defer fss.mtx.Unlock()
+
+	if fss.closed {
+		return errors.New("streaming service is closed")
+	}
+
+	height := fss.currentHeight
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+
+	fss.currentBlock.ResponseCommit = &res
+
+	// reset the block state regardless of the outcome of the write, so that one
+	// failed write doesn't leak into the file of the next block
+	defer func() {
+		fss.stateCache.Reset()
+		fss.currentBlock = new(types.BlockMetadata)
+		fss.currentHeight = 0
+	}()
+
+	bz, err := fss.codec.MarshalBinaryLengthPrefixed(fss.currentBlock)
+	if err != nil {
+		return err
+	}
+
+	bz = append(bz, fss.stateCache.Bytes()...)
+
+	return ioutil.WriteFile(fss.blockFilePath(height), bz, 0600)
+}
+
+// Close satisfies the io.Closer interface
+// Blocks committed after the service is closed are not written out anymore
+func (fss *StreamingService) Close() error {
+	fss.mtx.Lock()
+	defer fss.mtx.Unlock()
+
+	fss.closed = true
+
+	return nil
+}
+
+// BlockFileName returns the name of the file the given block is written to
+func (fss *StreamingService) BlockFileName(height int64) string {
+	return fmt.Sprintf("%sblock-%d", fss.filePrefix, height)
+}
+
+func (fss *StreamingService) blockFilePath(height int64) string {
+	return filepath.Join(fss.writeDir, fss.BlockFileName(height))
+}
+
+// cacheWriter is the io.Writer the StoreKVPairWriteListeners of the service write to
+type cacheWriter struct {
+	fss *StreamingService
+}
+
+// Write appends the length-prefixed StoreKVPair to the state cache of the current block
+func (cw cacheWriter) Write(p []byte) (int, error) {
+	cw.fss.mtx.Lock()
+	defer cw.fss.mtx.Unlock()
+
+	return cw.fss.stateCache.Write(p)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
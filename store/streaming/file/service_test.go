@@ -0,0 +1,131 @@
+package file
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+	mockStoreKey3 = types.NewKVStoreKey("mockStore3")
+	testPrefix    = "testPrefix-"
+
+	testBeginBlockReq = abci.RequestBeginBlock{
+		Header: tmproto.Header{Height: 1},
+	}
+	testBeginBlockRes = abci.ResponseBeginBlock{
+		Events: []abci.Event{{Type: "testEventType1"}},
+	}
+	testDeliverTxReq = abci.RequestDeliverTx{
+		Tx: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+	}
+	testDeliverTxRes = abci.ResponseDeliverTx{
+		Code:      1,
+		Log:       "mockLog",
+		GasWanted: 22,
+		GasUsed:   21,
+	}
+	testEndBlockReq = abci.RequestEndBlock{
+		Height: 1,
+	}
+	testEndBlockRes = abci.ResponseEndBlock{
+		Events: []abci.Event{{Type: "testEventType2"}},
+	}
+	testCommitRes = abci.ResponseCommit{
+		Data: []byte{1, 2, 3},
+	}
+)
+
+func TestFileStreamingService(t *testing.T) {
+	writeDir := t.TempDir()
+	keys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+
+	fss, err := NewStreamingService(writeDir, testPrefix, keys, testMarshaller)
+	require.NoError(t, err)
+	require.Equal(t, testPrefix, fss.filePrefix)
+	require.Equal(t, writeDir, fss.writeDir)
+
+	listeners := fss.Listeners()
+	require.Len(t, listeners, 2)
+	require.Len(t, listeners[mockStoreKey1], 1)
+	require.Len(t, listeners[mockStoreKey2], 1)
+	require.Empty(t, listeners[mockStoreKey3])
+
+	ctx := sdk.Context{}
+	require.NoError(t, fss.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte{1}, []byte{2}, false))
+	require.NoError(t, fss.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte{3}, nil, true))
+	require.NoError(t, fss.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+	require.NoError(t, fss.ListenCommit(ctx, testCommitRes))
+
+	bz, err := ioutil.ReadFile(filepath.Join(writeDir, "testPrefix-block-1"))
+	require.NoError(t, err)
+
+	msgs := splitLengthPrefixed(t, bz)
+	require.Len(t, msgs, 3)
+
+	var metadata types.BlockMetadata
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(msgs[0], &metadata))
+	require.Equal(t, testBeginBlockReq, *metadata.RequestBeginBlock)
+	require.Equal(t, testBeginBlockRes, *metadata.ResponseBeginBlock)
+	require.Len(t, metadata.DeliverTxs, 1)
+	require.Equal(t, testDeliverTxReq, *metadata.DeliverTxs[0].Request)
+	require.Equal(t, testDeliverTxRes, *metadata.DeliverTxs[0].Response)
+	require.Equal(t, testEndBlockReq, *metadata.RequestEndBlock)
+	require.Equal(t, testEndBlockRes, *metadata.ResponseEndBlock)
+	require.Equal(t, testCommitRes, *metadata.ResponseCommit)
+
+	var kvPair types.StoreKVPair
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(msgs[1], &kvPair))
+	require.Equal(t, types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte{1}, Value: []byte{2}}, kvPair)
+	kvPair = types.StoreKVPair{}
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(msgs[2], &kvPair))
+	require.Equal(t, types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: []byte{3}, Delete: true}, kvPair)
+
+	// the next block starts with an empty state cache
+	nextBeginBlockReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}
+	require.NoError(t, fss.ListenBeginBlock(ctx, nextBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, fss.ListenCommit(ctx, testCommitRes))
+
+	bz, err = ioutil.ReadFile(filepath.Join(writeDir, "testPrefix-block-2"))
+	require.NoError(t, err)
+	require.Len(t, splitLengthPrefixed(t, bz), 1)
+
+	// closed services don't write anything anymore
+	require.NoError(t, fss.Close())
+	require.Error(t, fss.ListenCommit(ctx, testCommitRes))
+}
+
+func TestNewStreamingServiceInvalidDir(t *testing.T) {
+	_, err := NewStreamingService(filepath.Join(t.TempDir(), "missing"), "", nil, testMarshaller)
+	require.Error(t, err)
+}
+
+func splitLengthPrefixed(t *testing.T, bz []byte) [][]byte {
+	var msgs [][]byte
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		require.Greater(t, n, 0)
+		bz = bz[n:]
+		require.GreaterOrEqual(t, uint64(len(bz)), size)
+		msgs = append(msgs, bz[:size])
+		bz = bz[size:]
+	}
+	return msgs
+}
@@ -188,6 +188,11 @@ type CommitMultiStore interface {
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
+
+	// RollbackToVersion deletes the versions above the given one, which becomes
+	// the latest version. It is used to recover from an invalid state
+	// transition.
+	RollbackToVersion(version int64) error
 }
 
 //---------subsp-------------------------------
@@ -43,6 +43,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -74,23 +75,24 @@ type Config struct {
 	LegacyAmino       *codec.LegacyAmino // TODO: Remove!
 	InterfaceRegistry codectypes.InterfaceRegistry
 
-	TxConfig         client.TxConfig
-	AccountRetriever client.AccountRetriever
-	AppConstructor   AppConstructor             // the ABCI application constructor
-	GenesisState     map[string]json.RawMessage // custom gensis state to provide
-	TimeoutCommit    time.Duration              // the consensus commitment timeout
-	ChainID          string                     // the network chain-id
-	NumValidators    int                        // the total number of validators to create and bond
-	BondDenom        string                     // the staking bond denomination
-	MinGasPrices     string                     // the minimum gas prices each validator will accept
-	AccountTokens    sdk.Int                    // the amount of unique validator tokens (e.g. 1000node0)
-	StakingTokens    sdk.Int                    // the amount of tokens each validator has available to stake
-	BondedTokens     sdk.Int                    // the amount of tokens each validator stakes
-	PruningStrategy  string                     // the pruning strategy each validator will have
-	EnableLogging    bool                       // enable Tendermint logging to STDOUT
-	CleanupDir       bool                       // remove base temporary directory during cleanup
-	SigningAlgo      string                     // signing algorithm for keys
-	KeyringOptions   []keyring.Option
+	TxConfig           client.TxConfig
+	AccountRetriever   client.AccountRetriever
+	GasPricesRetriever client.GasPricesRetriever
+	AppConstructor     AppConstructor             // the ABCI application constructor
+	GenesisState       map[string]json.RawMessage // custom gensis state to provide
+	TimeoutCommit      time.Duration              // the consensus commitment timeout
+	ChainID            string                     // the network chain-id
+	NumValidators      int                        // the total number of validators to create and bond
+	BondDenom          string                     // the staking bond denomination
+	MinGasPrices       string                     // the minimum gas prices each validator will accept
+	AccountTokens      sdk.Int                    // the amount of unique validator tokens (e.g. 1000node0)
+	StakingTokens      sdk.Int                    // the amount of tokens each validator has available to stake
+	BondedTokens       sdk.Int                    // the amount of tokens each validator stakes
+	PruningStrategy    string                     // the pruning strategy each validator will have
+	EnableLogging      bool                       // enable Tendermint logging to STDOUT
+	CleanupDir         bool                       // remove base temporary directory during cleanup
+	SigningAlgo        string                     // signing algorithm for keys
+	KeyringOptions     []keyring.Option
 }
 
 // DefaultConfig returns a sane default configuration suitable for nearly all
@@ -99,25 +101,26 @@ func DefaultConfig() Config {
 	encCfg := simapp.MakeTestEncodingConfig()
 
 	return Config{
-		Codec:             encCfg.Marshaler,
-		TxConfig:          encCfg.TxConfig,
-		LegacyAmino:       encCfg.Amino,
-		InterfaceRegistry: encCfg.InterfaceRegistry,
-		AccountRetriever:  authtypes.AccountRetriever{},
-		AppConstructor:    NewAppConstructor(encCfg),
-		GenesisState:      simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler),
-		TimeoutCommit:     2 * time.Second,
-		ChainID:           "chain-" + tmrand.NewRand().Str(6),
-		NumValidators:     4,
-		BondDenom:         sdk.DefaultBondDenom,
-		MinGasPrices:      fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
-		AccountTokens:     sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction),
-		StakingTokens:     sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction),
-		BondedTokens:      sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
-		PruningStrategy:   storetypes.PruningOptionNothing,
-		CleanupDir:        true,
-		SigningAlgo:       string(hd.Secp256k1Type),
-		KeyringOptions:    []keyring.Option{},
+		Codec:              encCfg.Marshaler,
+		TxConfig:           encCfg.TxConfig,
+		LegacyAmino:        encCfg.Amino,
+		InterfaceRegistry:  encCfg.InterfaceRegistry,
+		AccountRetriever:   authtypes.AccountRetriever{},
+		GasPricesRetriever: feemarkettypes.GasPricesRetriever{},
+		AppConstructor:     NewAppConstructor(encCfg),
+		GenesisState:       simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler),
+		TimeoutCommit:      2 * time.Second,
+		ChainID:            "chain-" + tmrand.NewRand().Str(6),
+		NumValidators:      4,
+		BondDenom:          sdk.DefaultBondDenom,
+		MinGasPrices:       fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
+		AccountTokens:      sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction),
+		StakingTokens:      sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction),
+		BondedTokens:       sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
+		PruningStrategy:    storetypes.PruningOptionNothing,
+		CleanupDir:         true,
+		SigningAlgo:        string(hd.Secp256k1Type),
+		KeyringOptions:     []keyring.Option{},
 	}
 }
 
@@ -349,7 +352,8 @@ func New(t *testing.T, cfg Config) *Network {
 			WithJSONMarshaler(cfg.Codec).
 			WithLegacyAmino(cfg.LegacyAmino).
 			WithTxConfig(cfg.TxConfig).
-			WithAccountRetriever(cfg.AccountRetriever)
+			WithAccountRetriever(cfg.AccountRetriever).
+			WithGasPricesRetriever(cfg.GasPricesRetriever)
 
 		network.Validators[i] = &Validator{
 			AppConfig:  appCfg,
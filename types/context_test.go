@@ -103,7 +103,8 @@ func (s *contextTestSuite) TestContextWithCustom() {
 		WithVoteInfos(voteinfos).
 		WithGasMeter(meter).
 		WithMinGasPrices(minGasPrices).
-		WithBlockGasMeter(blockGasMeter)
+		WithBlockGasMeter(blockGasMeter).
+		WithPriority(10)
 	s.Require().Equal(height, ctx.BlockHeight())
 	s.Require().Equal(chainid, ctx.ChainID())
 	s.Require().Equal(ischeck, ctx.IsCheckTx())
@@ -113,6 +114,7 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().Equal(meter, ctx.GasMeter())
 	s.Require().Equal(minGasPrices, ctx.MinGasPrices())
 	s.Require().Equal(blockGasMeter, ctx.BlockGasMeter())
+	s.Require().Equal(int64(10), ctx.Priority())
 	s.Require().False(ctx.WithIsCheckTx(false).IsCheckTx())
 
 	// test IsReCheckTx
@@ -30,6 +30,9 @@ package module
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -297,13 +300,39 @@ func (m *Manager) RegisterServices(cfg Configurator) {
 
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
+	return m.initGenesis(ctx, cdc, func(moduleName string) json.RawMessage {
+		return genesisData[moduleName]
+	})
+}
+
+// InitGenesisFromDir performs init genesis functionality for modules, reading
+// the genesis of each module from its file in dir, as written by
+// WriteGenesisFile. The files are read one at a time, so that the genesis
+// state is never held in memory as a whole. Modules without a file are
+// skipped.
+func (m *Manager) InitGenesisFromDir(ctx sdk.Context, cdc codec.JSONMarshaler, dir string) abci.ResponseInitChain {
+	return m.initGenesis(ctx, cdc, func(moduleName string) json.RawMessage {
+		bz, err := ioutil.ReadFile(GenesisFilePath(dir, moduleName))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			panic(err)
+		}
+
+		return bz
+	})
+}
+
+func (m *Manager) initGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, getGenesis func(moduleName string) json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
 	for _, moduleName := range m.OrderInitGenesis {
-		if genesisData[moduleName] == nil {
+		genesis := getGenesis(moduleName)
+		if genesis == nil {
 			continue
 		}
 
-		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, genesisData[moduleName])
+		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, genesis)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -323,11 +352,57 @@ func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisD
 // ExportGenesis performs export genesis functionality for modules
 func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) map[string]json.RawMessage {
 	genesisData := make(map[string]json.RawMessage)
+	// exporting all the modules cannot fail
+	_ = m.ExportGenesisForModules(ctx, cdc, nil, func(moduleName string, genesis json.RawMessage) error {
+		genesisData[moduleName] = genesis
+		return nil
+	})
+
+	return genesisData
+}
+
+// ExportGenesisForModules performs export genesis functionality for the given
+// modules, or for all the modules if none is given. The genesis of each module
+// is passed to write as soon as it is exported, so that the genesis state is
+// never held in memory as a whole.
+func (m *Manager) ExportGenesisForModules(
+	ctx sdk.Context, cdc codec.JSONMarshaler, modulesToExport []string, write func(moduleName string, genesis json.RawMessage) error,
+) error {
+	export := make(map[string]bool, len(modulesToExport))
+	for _, moduleName := range modulesToExport {
+		if _, ok := m.Modules[moduleName]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown module %s", moduleName)
+		}
+		export[moduleName] = true
+	}
+
 	for _, moduleName := range m.OrderExportGenesis {
-		genesisData[moduleName] = m.Modules[moduleName].ExportGenesis(ctx, cdc)
+		if len(export) > 0 && !export[moduleName] {
+			continue
+		}
+
+		if err := write(moduleName, m.Modules[moduleName].ExportGenesis(ctx, cdc)); err != nil {
+			return err
+		}
 	}
 
-	return genesisData
+	return nil
+}
+
+// WriteGenesisFile writes the genesis of a module to its own file in dir, to
+// be read by InitGenesisFromDir. Modules without genesis state, exported as
+// empty or null, have no file.
+func WriteGenesisFile(dir, moduleName string, genesis json.RawMessage) error {
+	if len(genesis) == 0 || string(genesis) == "null" {
+		return nil
+	}
+
+	return ioutil.WriteFile(GenesisFilePath(dir, moduleName), genesis, 0o600)
+}
+
+// GenesisFilePath returns the path of the genesis file of a module in dir.
+func GenesisFilePath(dir, moduleName string) string {
+	return filepath.Join(dir, moduleName+".json")
 }
 
 // MigrationHandler is the migration function that each module registers.
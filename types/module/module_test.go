@@ -241,6 +241,52 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_ExportGenesisForModules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	ctx := sdk.Context{}
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	// only the given modules are exported
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2": "value2"}`))
+	var exported []string
+	err := mm.ExportGenesisForModules(ctx, cdc, []string{"module2"}, func(moduleName string, genesis json.RawMessage) error {
+		exported = append(exported, moduleName)
+		require.Equal(t, json.RawMessage(`{"key2": "value2"}`), genesis)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"module2"}, exported)
+
+	err = mm.ExportGenesisForModules(ctx, cdc, []string{"module3"}, func(string, json.RawMessage) error { return nil })
+	require.Error(t, err)
+
+	// the genesis written to a directory is read back by InitGenesisFromDir
+	dir := t.TempDir()
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1": "value1"}`))
+	err = mm.ExportGenesisForModules(ctx, cdc, []string{"module1"}, func(moduleName string, genesis json.RawMessage) error {
+		return module.WriteGenesisFile(dir, moduleName, genesis)
+	})
+	require.NoError(t, err)
+	require.FileExists(t, module.GenesisFilePath(dir, "module1"))
+	require.NoFileExists(t, module.GenesisFilePath(dir, "module2"))
+
+	// modules without genesis state have no file
+	require.NoError(t, module.WriteGenesisFile(dir, "module2", json.RawMessage("null")))
+	require.NoFileExists(t, module.GenesisFilePath(dir, "module2"))
+
+	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{"key1": "value1"}`))).Times(1).Return(nil)
+	require.Equal(t, abci.ResponseInitChain{Validators: []abci.ValidatorUpdate(nil)}, mm.InitGenesisFromDir(ctx, cdc, dir))
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -0,0 +1,29 @@
+package tx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TipTx defines the interface to be implemented by Txs that handle Tips.
+type TipTx interface {
+	sdk.FeeTx
+	GetTip() *Tip
+}
+
+// ValidateBasic performs stateless validation of a Tip.
+func (t *Tip) ValidateBasic() error {
+	if t.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tip amount cannot be empty")
+	}
+
+	if !t.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount %s", t.Amount)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.Tipper); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address (%s)", err)
+	}
+
+	return nil
+}
@@ -86,16 +86,36 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	signers := t.GetSigners()
+
+	// the tip is transferred from the tipper, who must therefore sign the tx
+	if tip := authInfo.Tip; tip != nil {
+		if err := tip.ValidateBasic(); err != nil {
+			return err
+		}
+
+		isSigner := false
+		for _, signer := range signers {
+			if signer.String() == tip.Tipper {
+				isSigner = true
+				break
+			}
+		}
+		if !isSigner {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must be a signer of the tx", tip.Tipper)
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
 		return sdkerrors.ErrNoSignatures
 	}
 
-	if len(sigs) != len(t.GetSigners()) {
+	if len(sigs) != len(signers) {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrUnauthorized,
-			"wrong number of signers; expected %d, got %d", len(t.GetSigners()), len(sigs),
+			"wrong number of signers; expected %d, got %d", len(signers), len(sigs),
 		)
 	}
 
@@ -95,6 +95,22 @@ func NewLevelDB(name, dir string) (db dbm.DB, err error) {
 	return dbm.NewDB(name, backend, dir)
 }
 
+// NewDB instantiate a new DB instance of the given backend, or of the backend
+// of NewLevelDB if the backend is empty.
+func NewDB(name string, backendType dbm.BackendType, dir string) (db dbm.DB, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("couldn't create db: %v", r)
+		}
+	}()
+
+	if backendType == "" {
+		backendType = backend
+	}
+
+	return dbm.NewDB(name, backendType, dir)
+}
+
 // copy bytes
 func CopyBytes(bz []byte) (ret []byte) {
 	if bz == nil {
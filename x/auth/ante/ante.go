@@ -13,8 +13,14 @@ type HandlerOptions struct {
 	AccountKeeper   AccountKeeper
 	BankKeeper      types.BankKeeper
 	FeegrantKeeper  FeegrantKeeper
+	GlobalFeeKeeper GlobalFeeKeeper
+	FeeMarketKeeper FeeMarketKeeper
+	FeeAbsKeeper    FeeAbsKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+	// Authenticators authenticate the abstract accounts, by the name returned
+	// by their GetAuthenticator method.
+	Authenticators map[string]Authenticator
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -41,17 +47,21 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
-		NewMempoolFeeDecorator(),
+		NewMempoolFeeDecoratorWithFeeAbs(options.FeeAbsKeeper),
+		NewGlobalMinGasPriceDecoratorWithFeeAbs(options.GlobalFeeKeeper, options.FeeAbsKeeper),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
-		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		NewFeeAbsDecorator(options.FeeAbsKeeper, options.FeeMarketKeeper),    // FeeAbsDecorator must be called after DeductFeeDecorator
+		NewFeeMarketDecorator(options.FeeMarketKeeper, options.FeeAbsKeeper), // FeeMarketDecorator must be called after DeductFeeDecorator
+		NewSetPubKeyDecorator(options.AccountKeeper),                         // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		NewSigVerificationDecoratorWithAuthenticators(options.AccountKeeper, options.SignModeHandler, options.Authenticators),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewTipDecorator(options.BankKeeper), // TipDecorator must be called after all signature verification decorators
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -0,0 +1,33 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Authenticator authenticates the signatures of abstract accounts, in place of
+// the verification against the public key of the account done by the
+// SigVerificationDecorator. It is registered in the HandlerOptions under the
+// name returned by the GetAuthenticator method of the accounts it
+// authenticates, and is responsible for consuming the gas of the verification.
+type Authenticator interface {
+	Authenticate(ctx sdk.Context, req AuthenticationRequest) error
+}
+
+// AuthenticationRequest holds a signature of an abstract account, along with
+// the data needed to verify it.
+type AuthenticationRequest struct {
+	Account types.AbstractAccountI
+	// SignerData holds the public key of the signer info of the signature,
+	// which is not the public key of the account, and can be nil.
+	SignerData      authsigning.SignerData
+	Signature       signing.SignatureV2
+	SignModeHandler authsigning.SignModeHandler
+	Tx              sdk.Tx
+	// Simulate is set when the transaction is simulated, in which case the
+	// signature is empty and should not be verified, but the gas of its
+	// verification should still be consumed.
+	Simulate bool
+}
@@ -18,3 +18,25 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// GlobalFeeKeeper defines the expected keeper of the global minimum gas prices.
+type GlobalFeeKeeper interface {
+	GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins
+	ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins
+}
+
+// FeeMarketKeeper defines the expected keeper of the fee market base fee.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) sdk.DecCoins
+	CollectBaseFee(ctx sdk.Context, fees sdk.Coins) error
+}
+
+// FeeAbsKeeper defines the expected keeper of the fee denoms transaction fees
+// can be paid in, other than the native fee denom.
+type FeeAbsKeeper interface {
+	GetBaseDenom(ctx sdk.Context) (string, bool)
+	ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins
+	CoverFees(ctx sdk.Context, fees, required sdk.Coins) (sdk.Coins, bool)
+	ValidateFeeDenoms(ctx sdk.Context, fees sdk.Coins) error
+	RouteFees(ctx sdk.Context, fees sdk.Coins) error
+}
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,13 +14,27 @@ import (
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
+// It also sets the priority of the transaction in the app-side mempool, see
+// GetTxPriority.
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
-type MempoolFeeDecorator struct{}
+type MempoolFeeDecorator struct {
+	feeAbsKeeper FeeAbsKeeper
+}
 
 func NewMempoolFeeDecorator() MempoolFeeDecorator {
 	return MempoolFeeDecorator{}
 }
 
+// NewMempoolFeeDecoratorWithFeeAbs returns a MempoolFeeDecorator converting the
+// fees paid in the fee denoms of the FeeAbsKeeper to the native fee denom,
+// both before the check and for the priority of the transaction, which is then
+// its gas price in the native fee denom.
+func NewMempoolFeeDecoratorWithFeeAbs(fak FeeAbsKeeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{
+		feeAbsKeeper: fak,
+	}
+}
+
 func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -35,25 +50,123 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	if ctx.IsCheckTx() && !simulate {
 		minGasPrices := ctx.MinGasPrices()
 		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdk.NewDec(int64(gas))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-			}
-
-			if !feeCoins.IsAnyGTE(requiredFees) {
+			requiredFees := GetRequiredFees(minGasPrices, gas)
+			if !convertFees(ctx, mfd.feeAbsKeeper, feeCoins).IsAnyGTE(requiredFees) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
 	}
 
+	// the fees paid in the fee denoms are only comparable once converted
+	priorityFees := feeCoins
+	if mfd.feeAbsKeeper != nil {
+		if baseDenom, found := mfd.feeAbsKeeper.GetBaseDenom(ctx); found {
+			priorityFees = sdk.NewCoins(sdk.NewCoin(baseDenom, mfd.feeAbsKeeper.ConvertFees(ctx, feeCoins).AmountOf(baseDenom)))
+		}
+	}
+
+	newCtx = ctx.WithPriority(GetTxPriority(priorityFees, gas))
+	return next(newCtx, tx, simulate)
+}
+
+// GlobalMinGasPriceDecorator checks that the transaction's fee is at least as
+// large as the global minimum gas prices, which are set by governance. Unlike
+// the MempoolFeeDecorator, it applies in both CheckTx and DeliverTx, so that
+// every validator enforces the same floor. Fees paid in denoms with a fee denom
+// ratio are converted to their base denom before the check.
+// It is skipped when simulating, and for the gentxs delivered at genesis,
+// which have no fees. A nil GlobalFeeKeeper disables the check.
+// CONTRACT: Tx must implement FeeTx to use GlobalMinGasPriceDecorator
+type GlobalMinGasPriceDecorator struct {
+	globalFeeKeeper GlobalFeeKeeper
+	feeAbsKeeper    FeeAbsKeeper
+}
+
+func NewGlobalMinGasPriceDecorator(gfk GlobalFeeKeeper) GlobalMinGasPriceDecorator {
+	return GlobalMinGasPriceDecorator{
+		globalFeeKeeper: gfk,
+	}
+}
+
+// NewGlobalMinGasPriceDecoratorWithFeeAbs returns a GlobalMinGasPriceDecorator
+// which also accepts the fees paid in the fee denoms of the FeeAbsKeeper,
+// converted to the native fee denom, as an alternative to the fee denom ratios
+// of the GlobalFeeKeeper.
+func NewGlobalMinGasPriceDecoratorWithFeeAbs(gfk GlobalFeeKeeper, fak FeeAbsKeeper) GlobalMinGasPriceDecorator {
+	return GlobalMinGasPriceDecorator{
+		globalFeeKeeper: gfk,
+		feeAbsKeeper:    fak,
+	}
+}
+
+func (gmd GlobalMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if gmd.globalFeeKeeper == nil || simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := gmd.globalFeeKeeper.GetMinimumGasPrices(ctx)
+	if !minGasPrices.IsZero() {
+		requiredFees := GetRequiredFees(minGasPrices, feeTx.GetGas())
+		// each conversion is checked on its own, so that no fee is counted
+		// twice when a denom has both a fee denom ratio and a feeabs rate
+		fees := gmd.globalFeeKeeper.ConvertFees(ctx, feeTx.GetFee())
+		if !fees.IsAnyGTE(requiredFees) && (gmd.feeAbsKeeper == nil || !gmd.feeAbsKeeper.ConvertFees(ctx, feeTx.GetFee()).IsAnyGTE(requiredFees)) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the global minimum gas prices; got: %s required: %s", feeTx.GetFee(), requiredFees)
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// convertFees returns the given fees, with the fees paid in the fee denoms of
+// the FeeAbsKeeper converted and added to the native fee denom. A nil
+// FeeAbsKeeper leaves the fees unchanged.
+func convertFees(ctx sdk.Context, fak FeeAbsKeeper, fees sdk.Coins) sdk.Coins {
+	if fak == nil {
+		return fees
+	}
+	return fak.ConvertFees(ctx, fees)
+}
+
+// GetRequiredFees returns the fees required by the given minimum gas prices
+// for a gas limit, multiplying each minimum gas price by the gas limit, where
+// fee = ceil(minGasPrice * gasLimit).
+func GetRequiredFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return requiredFees
+}
+
+// GetTxPriority returns the priority of a transaction with the given fee and
+// gas limit, which is its smallest gas price among the fee denoms, truncated
+// to an integer. Transactions without fee or gas have a priority of 0.
+func GetTxPriority(fee sdk.Coins, gas uint64) int64 {
+	if fee.IsZero() || gas == 0 || gas > math.MaxInt64 {
+		return 0
+	}
+
+	priority := int64(math.MaxInt64)
+	for _, c := range fee {
+		gasPrice := c.Amount.QuoRaw(int64(gas))
+		if gasPrice.IsInt64() && gasPrice.Int64() < priority {
+			priority = gasPrice.Int64()
+		}
+	}
+
+	return priority
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -133,3 +246,119 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 
 	return nil
 }
+
+// FeeAbsDecorator checks that the transaction's fee is only paid in the native
+// fee denom and the fee denoms of the FeeAbsKeeper, and moves the part of the
+// fee paid in the fee denoms out of the fee collector, to the fee recipient
+// module if one is set. The part of the fee paying the base fee of the
+// FeeMarketKeeper is left to the FeeMarketDecorator. A nil FeeAbsKeeper allows
+// any fee denom.
+// CONTRACT: Tx must implement FeeTx and DeductFeeDecorator must be called
+// before FeeAbsDecorator
+type FeeAbsDecorator struct {
+	feeAbsKeeper    FeeAbsKeeper
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewFeeAbsDecorator(fak FeeAbsKeeper, fmk FeeMarketKeeper) FeeAbsDecorator {
+	return FeeAbsDecorator{
+		feeAbsKeeper:    fak,
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (fad FeeAbsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if fad.feeAbsKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := fad.feeAbsKeeper.ValidateFeeDenoms(ctx, feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+
+	baseFees, err := getBaseFees(ctx, fad.feeMarketKeeper, fad.feeAbsKeeper, feeTx, simulate)
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := fad.feeAbsKeeper.RouteFees(ctx, feeTx.GetFee().Sub(baseFees)); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// FeeMarketDecorator checks that the transaction's fee covers the base fee of
+// the fee market for its gas limit, and moves that part of the fee out of the
+// fee collector, to be burned or sent to the fee recipient module. The rest of
+// the fee is left to the validators as a tip. The fee denoms of the
+// FeeAbsKeeper pay for the part of the base fee in the native fee denom which
+// the fee doesn't cover in that denom.
+// It is skipped when simulating, and for the gentxs delivered at genesis,
+// which have no fees. A nil FeeMarketKeeper disables the base fee.
+// CONTRACT: Tx must implement FeeTx and DeductFeeDecorator must be called
+// before FeeMarketDecorator
+type FeeMarketDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+	feeAbsKeeper    FeeAbsKeeper
+}
+
+func NewFeeMarketDecorator(fmk FeeMarketKeeper, fak FeeAbsKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		feeMarketKeeper: fmk,
+		feeAbsKeeper:    fak,
+	}
+}
+
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFees, err := getBaseFees(ctx, fmd.feeMarketKeeper, fmd.feeAbsKeeper, feeTx, simulate)
+	if err != nil {
+		return ctx, err
+	}
+
+	if baseFees.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := fmd.feeMarketKeeper.CollectBaseFee(ctx, baseFees); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getBaseFees returns the part of the transaction's fee paying the base fee of
+// the fee market for its gas limit, converting the fees paid in the fee denoms
+// of the FeeAbsKeeper. It is empty when the FeeMarketDecorator is skipped or
+// the base fee is zero.
+func getBaseFees(ctx sdk.Context, fmk FeeMarketKeeper, fak FeeAbsKeeper, feeTx sdk.FeeTx, simulate bool) (sdk.Coins, error) {
+	if fmk == nil || simulate || ctx.BlockHeight() == 0 {
+		return nil, nil
+	}
+
+	baseFee := fmk.GetBaseFee(ctx)
+	if baseFee.IsZero() {
+		return nil, nil
+	}
+
+	requiredFees := GetRequiredFees(baseFee, feeTx.GetGas())
+	baseFees, ok := requiredFees, feeTx.GetFee().IsAllGTE(requiredFees)
+	if fak != nil {
+		baseFees, ok = fak.CoverFees(ctx, feeTx.GetFee(), requiredFees)
+	}
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee; got: %s required: %s", feeTx.GetFee(), requiredFees)
+	}
+
+	return baseFees, nil
+}
@@ -1,11 +1,19 @@
 package ante_test
 
 import (
+	"math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feeabstypes "github.com/cosmos/cosmos-sdk/x/feeabs/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	globalfeetypes "github.com/cosmos/cosmos-sdk/x/globalfee/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -56,8 +64,191 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	lowGasPrice := []sdk.DecCoin{atomPrice}
 	suite.ctx = suite.ctx.WithMinGasPrices(lowGasPrice)
 
-	_, err = antehandler(suite.ctx, tx, false)
+	newCtx, err := antehandler(suite.ctx, tx, false)
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
+	suite.Require().Equal(ante.GetTxPriority(feeAmount, gasLimit), newCtx.Priority())
+}
+
+func (suite *AnteTestSuite) TestEnsureMempoolFeesConverted() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewMempoolFeeDecoratorWithFeeAbs(suite.app.FeeAbsKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures, paying 150atom for 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the local minimum gas price requires 200stake
+	ctx := suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 3))))
+
+	// without fee denoms, fees are compared denom by denom
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	testCases := []struct {
+		name      string
+		rate      sdk.Dec
+		expectErr bool
+		priority  int64
+	}{
+		{"converted fee below the minimum gas price", sdk.OneDec(), true, 0},
+		{"converted fee above the minimum gas price", sdk.NewDec(2), false, 0},
+		{"priority of the converted fee", sdk.NewDec(2000), false, 3},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.app.FeeAbsKeeper.SetParams(ctx, feeabstypes.NewParams(
+				"stake", []feeabstypes.FeeDenom{feeabstypes.NewFeeDenom("atom", tc.rate)}, "",
+			))
+
+			newCtx, err := antehandler(ctx, tx, false)
+			if tc.expectErr {
+				suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+			} else {
+				suite.Require().NoError(err)
+				// the priority is the gas price in the base denom
+				suite.Require().Equal(tc.priority, newCtx.Priority())
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestGlobalMinGasPriceDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	gmd := ante.NewGlobalMinGasPriceDecoratorWithFeeAbs(suite.app.GlobalFeeKeeper, suite.app.FeeAbsKeeper)
+	antehandler := sdk.ChainAnteDecorators(gmd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures, paying 150atom for 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// without global minimum gas prices, any fee is accepted
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	atomPrice := func(price sdk.Dec) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", price))
+	}
+	stakePrice := func(price sdk.Dec) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", price))
+	}
+
+	testCases := []struct {
+		name      string
+		prices    sdk.DecCoins
+		ratios    []globalfeetypes.FeeDenomRatio
+		feeDenoms []feeabstypes.FeeDenom
+		expectErr bool
+	}{
+		{"fee below the minimum gas price", atomPrice(sdk.NewDecWithPrec(2, 3)), nil, nil, true},
+		{"fee above the minimum gas price", atomPrice(sdk.NewDecWithPrec(1, 3)), nil, nil, false},
+		{"fee in another denom", stakePrice(sdk.NewDecWithPrec(5, 4)), nil, nil, true},
+		{"fee in any of the denoms", atomPrice(sdk.NewDecWithPrec(1, 3)).Add(stakePrice(sdk.NewDecWithPrec(1, 3))...), nil, nil, false},
+		{
+			"fee below the minimum gas price with a fee denom ratio",
+			stakePrice(sdk.NewDecWithPrec(2, 3)),
+			[]globalfeetypes.FeeDenomRatio{globalfeetypes.NewFeeDenomRatio("atom", "stake", sdk.OneDec())},
+			nil,
+			true,
+		},
+		{
+			"fee above the minimum gas price with a fee denom ratio",
+			stakePrice(sdk.NewDecWithPrec(2, 3)),
+			[]globalfeetypes.FeeDenomRatio{globalfeetypes.NewFeeDenomRatio("atom", "stake", sdk.NewDec(2))},
+			nil,
+			false,
+		},
+		{
+			"fee below the minimum gas price with a feeabs fee denom",
+			stakePrice(sdk.NewDecWithPrec(2, 3)),
+			nil,
+			[]feeabstypes.FeeDenom{feeabstypes.NewFeeDenom("atom", sdk.OneDec())},
+			true,
+		},
+		{
+			"fee above the minimum gas price with a feeabs fee denom",
+			stakePrice(sdk.NewDecWithPrec(2, 3)),
+			nil,
+			[]feeabstypes.FeeDenom{feeabstypes.NewFeeDenom("atom", sdk.NewDec(2))},
+			false,
+		},
+		{
+			"fee converted by neither conversion alone",
+			stakePrice(sdk.NewDecWithPrec(2, 3)),
+			[]globalfeetypes.FeeDenomRatio{globalfeetypes.NewFeeDenomRatio("atom", "stake", sdk.OneDec())},
+			[]feeabstypes.FeeDenom{feeabstypes.NewFeeDenom("atom", sdk.OneDec())},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.app.GlobalFeeKeeper.SetParams(suite.ctx, globalfeetypes.NewParams(tc.prices, tc.ratios))
+			suite.app.FeeAbsKeeper.SetParams(suite.ctx, feeabstypes.NewParams("stake", tc.feeDenoms, ""))
+
+			// the global minimum gas prices apply in both CheckTx and DeliverTx
+			for _, isCheckTx := range []bool{true, false} {
+				_, err := antehandler(suite.ctx.WithIsCheckTx(isCheckTx), tx, false)
+				if tc.expectErr {
+					suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+				} else {
+					suite.Require().NoError(err)
+				}
+			}
+
+			// they are skipped when simulating, and for gentxs
+			_, err = antehandler(suite.ctx, tx, true)
+			suite.Require().NoError(err)
+			_, err = antehandler(suite.ctx.WithBlockHeight(0), tx, false)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestGetTxPriority() {
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		priority int64
+	}{
+		{"no fee", nil, 100, 0},
+		{"no gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), 0, 0},
+		{"single denom", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 100, 10},
+		{"truncated gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 100, 1},
+		{"smallest gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 300)), 100, 3},
+		{"gas price below one", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 30)), 100, 0},
+		{"overflowing gas price", sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntFromUint64(math.MaxUint64))), 1, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.priority, ante.GetTxPriority(tc.fee, tc.gas))
+		})
+	}
 }
 
 func (suite *AnteTestSuite) TestDeductFees() {
@@ -102,3 +293,162 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+func (suite *AnteTestSuite) TestFeeMarketDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	fmd := ante.NewFeeMarketDecorator(suite.app.FeeMarketKeeper, suite.app.FeeAbsKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd, fmd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures, paying 150atom for 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err = simapp.FundAccount(suite.app, suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	suite.Require().NoError(err)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	distribution := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+
+	atom := []feeabstypes.FeeDenom{feeabstypes.NewFeeDenom("atom", sdk.NewDec(2))}
+
+	testCases := []struct {
+		name            string
+		enabled         bool
+		baseFee         sdk.DecCoin
+		feeDenoms       []feeabstypes.FeeDenom
+		feeRecipient    string
+		expectErr       bool
+		expFeeCollector int64
+		expRecipient    int64
+		expBurned       int64
+	}{
+		{"base fee disabled", false, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)), nil, "", false, 150, 0, 0},
+		{"zero base fee", true, sdk.NewDecCoinFromDec("atom", sdk.ZeroDec()), nil, "", false, 150, 0, 0},
+		{"fee below the base fee", true, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 3)), nil, "", true, 0, 0, 0},
+		{"base fee burned", true, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)), nil, "", false, 50, 0, 100},
+		{"base fee sent to the fee recipient", true, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)), nil, distrtypes.ModuleName, false, 50, 100, 0},
+		{"fee in another denom", true, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 3)), nil, "", true, 0, 0, 0},
+		{"base fee paid in a fee denom", true, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 3)), atom, "", false, 100, 0, 50},
+		{"converted fee below the base fee", true, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(4, 3)), atom, "", true, 0, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			params := feemarkettypes.DefaultParams()
+			params.Enabled = tc.enabled
+			params.BaseFeeDenom = tc.baseFee.Denom
+			params.FeeRecipient = tc.feeRecipient
+			suite.app.FeeMarketKeeper.SetParams(ctx, params)
+			suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, tc.baseFee.Amount)
+			suite.app.FeeAbsKeeper.SetParams(ctx, feeabstypes.NewParams("stake", tc.feeDenoms, ""))
+
+			supply := suite.app.BankKeeper.GetSupply(ctx, "atom")
+			_, err := antehandler(ctx, tx, false)
+			if tc.expectErr {
+				suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(sdk.NewInt64Coin("atom", tc.expFeeCollector), suite.app.BankKeeper.GetBalance(ctx, feeCollector, "atom"))
+			suite.Require().Equal(sdk.NewInt64Coin("atom", tc.expRecipient), suite.app.BankKeeper.GetBalance(ctx, distribution, "atom"))
+			suite.Require().Equal(supply.SubAmount(sdk.NewInt(tc.expBurned)), suite.app.BankKeeper.GetSupply(ctx, "atom"))
+
+			// the base fee is skipped when simulating, and for gentxs
+			ctx, _ = suite.ctx.CacheContext()
+			suite.app.FeeMarketKeeper.SetParams(ctx, params)
+			suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.NewDec(1))
+			_, err = antehandler(ctx, tx, true)
+			suite.Require().NoError(err)
+			_, err = antehandler(ctx.WithBlockHeight(0), tx, false)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestFeeAbsDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	fad := ante.NewFeeAbsDecorator(suite.app.FeeAbsKeeper, suite.app.FeeMarketKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd, fad)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures, paying 150atom for 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err = simapp.FundAccount(suite.app, suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	suite.Require().NoError(err)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	distribution := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	atom := feeabstypes.NewFeeDenom("atom", sdk.NewDec(2))
+	photon := feeabstypes.NewFeeDenom("photon", sdk.OneDec())
+
+	testCases := []struct {
+		name            string
+		params          feeabstypes.Params
+		baseFee         sdk.Dec
+		expectErr       bool
+		expFeeCollector int64
+		expRecipient    int64
+	}{
+		{"no fee denoms", feeabstypes.DefaultParams(), sdk.ZeroDec(), false, 150, 0},
+		{"base denom", feeabstypes.NewParams("atom", []feeabstypes.FeeDenom{photon}, distrtypes.ModuleName), sdk.ZeroDec(), false, 150, 0},
+		{"fee denom not allowed", feeabstypes.NewParams("stake", []feeabstypes.FeeDenom{photon}, ""), sdk.ZeroDec(), true, 0, 0},
+		{"fee denom", feeabstypes.NewParams("stake", []feeabstypes.FeeDenom{atom}, ""), sdk.ZeroDec(), false, 150, 0},
+		{"fee denom routed", feeabstypes.NewParams("stake", []feeabstypes.FeeDenom{atom}, distrtypes.ModuleName), sdk.ZeroDec(), false, 0, 150},
+		// the 100stake base fee is paid with 50atom, left for the FeeMarketDecorator
+		{"fee denom routed after the base fee", feeabstypes.NewParams("stake", []feeabstypes.FeeDenom{atom}, distrtypes.ModuleName), sdk.NewDecWithPrec(1, 3), false, 50, 100},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.app.FeeAbsKeeper.SetParams(ctx, tc.params)
+
+			feeMarketParams := feemarkettypes.DefaultParams()
+			feeMarketParams.Enabled = true
+			suite.app.FeeMarketKeeper.SetParams(ctx, feeMarketParams)
+			suite.app.FeeMarketKeeper.SetBaseFeeAmount(ctx, tc.baseFee)
+
+			_, err := antehandler(ctx, tx, false)
+			if tc.expectErr {
+				suite.Require().True(feeabstypes.ErrFeeDenomNotAllowed.Is(err), err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(sdk.NewInt64Coin("atom", tc.expFeeCollector), suite.app.BankKeeper.GetBalance(ctx, feeCollector, "atom"))
+			suite.Require().Equal(sdk.NewInt64Coin("atom", tc.expRecipient), suite.app.BankKeeper.GetBalance(ctx, distribution, "atom"))
+		})
+	}
+}
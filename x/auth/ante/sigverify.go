@@ -42,6 +42,7 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig signing.Sig
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
+// The abstract accounts are skipped, as they are not authenticated with their pubkey
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SetPubKeyDecorator struct {
 	ak AccountKeeper
@@ -73,16 +74,23 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
+
+		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+		// the signer info of an abstract account holds the pubkey its
+		// authenticator verifies, which does not match its address
+		if _, ok := acc.(types.AbstractAccountI); ok {
+			continue
+		}
+
 		// Only make check if simulate=false
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
 		}
 
-		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
-		if err != nil {
-			return ctx, err
-		}
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
@@ -99,6 +107,7 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
+// The signatures of abstract accounts are skipped, as their authenticator consumes the gas of their verification
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigGasConsumeDecorator struct {
@@ -134,6 +143,9 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if err != nil {
 			return ctx, err
 		}
+		if _, ok := signerAcc.(types.AbstractAccountI); ok {
+			continue
+		}
 
 		pubKey := signerAcc.GetPubKey()
 
@@ -163,18 +175,31 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator decorator will not get executed on ReCheck.
+// The signatures of abstract accounts are verified by the Authenticator
+// registered under their authenticator name instead of against their pubkey,
+// and are rejected if there is none.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	authenticators  map[string]Authenticator
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+	return NewSigVerificationDecoratorWithAuthenticators(ak, signModeHandler, nil)
+}
+
+// NewSigVerificationDecoratorWithAuthenticators returns a SigVerificationDecorator
+// authenticating the abstract accounts with the given authenticators, by name.
+func NewSigVerificationDecoratorWithAuthenticators(
+	ak AccountKeeper, signModeHandler authsigning.SignModeHandler, authenticators map[string]Authenticator,
+) SigVerificationDecorator {
 	return SigVerificationDecorator{
 		ak:              ak,
 		signModeHandler: signModeHandler,
+		authenticators:  authenticators,
 	}
 }
 
@@ -229,9 +254,13 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
-		// retrieve pubkey
+		// retrieve pubkey, which is the one of the signer info for abstract
+		// accounts
+		abstractAcc, isAbstract := acc.(types.AbstractAccountI)
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if isAbstract {
+			pubKey = sig.PubKey
+		} else if !simulate && pubKey == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
@@ -258,13 +287,22 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			accNum = acc.GetAccountNumber()
 		}
 		signerData := authsigning.SignerData{
+			Address:       acc.GetAddress().String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
 			Sequence:      acc.GetSequence(),
+			PubKey:        pubKey,
+		}
+
+		if isAbstract {
+			if err := svd.authenticate(ctx, abstractAcc, signerData, sig, tx, simulate); err != nil {
+				return ctx, err
+			}
+			continue
 		}
 
 		if !simulate {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+			err := authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
 				if onlyAminoSigners {
@@ -283,6 +321,27 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticate verifies a signature of an abstract account with the
+// authenticator registered under its authenticator name.
+func (svd SigVerificationDecorator) authenticate(
+	ctx sdk.Context, acc types.AbstractAccountI, signerData authsigning.SignerData,
+	sig signing.SignatureV2, tx sdk.Tx, simulate bool,
+) error {
+	authenticator, ok := svd.authenticators[acc.GetAuthenticator()]
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no authenticator %s registered for account %s", acc.GetAuthenticator(), acc.GetAddress())
+	}
+
+	return authenticator.Authenticate(ctx, AuthenticationRequest{
+		Account:         acc,
+		SignerData:      signerData,
+		Signature:       sig,
+		SignModeHandler: svd.signModeHandler,
+		Tx:              tx,
+		Simulate:        simulate,
+	})
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
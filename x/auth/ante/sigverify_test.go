@@ -14,9 +14,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -185,6 +187,109 @@ func (suite *AnteTestSuite) TestSigVerification() {
 	}
 }
 
+// testAbstractAccount is an abstract account authenticated by the "session"
+// authenticator.
+type testAbstractAccount struct {
+	*types.BaseAccount
+}
+
+func (acc testAbstractAccount) GetAuthenticator() string { return "session" }
+
+// abstractAccountKeeper returns the account of the given address as an abstract
+// account.
+type abstractAccountKeeper struct {
+	ante.AccountKeeper
+	addr sdk.AccAddress
+}
+
+func (ak abstractAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI {
+	acc := ak.AccountKeeper.GetAccount(ctx, addr)
+	if acc != nil && addr.Equals(ak.addr) {
+		return testAbstractAccount{acc.(*types.BaseAccount)}
+	}
+	return acc
+}
+
+// sessionAuthenticator authenticates the signatures of a session key.
+type sessionAuthenticator struct {
+	sessionKey cryptotypes.PubKey
+	simulated  *bool
+}
+
+func (sa sessionAuthenticator) Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	*sa.simulated = req.Simulate
+	if req.Simulate {
+		return nil
+	}
+	if !sa.sessionKey.Equals(req.SignerData.PubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not the session key")
+	}
+	return authsigning.VerifySignature(sdk.WrapSDKContext(ctx), req.SignerData.PubKey, req.SignerData, req.Signature.Data, req.SignModeHandler, req.Tx)
+}
+
+func (suite *AnteTestSuite) TestSigVerification_AbstractAccount() {
+	suite.SetupTest(true) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	accNum := acc.GetAccountNumber()
+
+	ak := abstractAccountKeeper{suite.app.AccountKeeper, addr}
+	var simulated bool
+	authenticators := map[string]ante.Authenticator{
+		"session": sessionAuthenticator{sessionKey: sessionPriv.PubKey(), simulated: &simulated},
+	}
+
+	testCases := []struct {
+		name           string
+		priv           cryptotypes.PrivKey
+		accSeq         uint64
+		authenticators map[string]ante.Authenticator
+		simulate       bool
+		expErr         error
+	}{
+		{"session key", sessionPriv, 1, authenticators, false, nil},
+		{"other key", otherPriv, 1, authenticators, false, sdkerrors.ErrUnauthorized},
+		{"wrong sequence", sessionPriv, 0, authenticators, false, sdkerrors.ErrWrongSequence},
+		{"no authenticator", sessionPriv, 1, nil, false, sdkerrors.ErrUnauthorized},
+		{"simulate", sessionPriv, 1, authenticators, true, nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			simulated = false
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tc.priv}, []uint64{accNum}, []uint64{tc.accSeq}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			antehandler := sdk.ChainAnteDecorators(
+				ante.NewSetPubKeyDecorator(ak),
+				ante.NewSigGasConsumeDecorator(ak, ante.DefaultSigVerificationGasConsumer),
+				ante.NewSigVerificationDecoratorWithAuthenticators(ak, suite.clientCtx.TxConfig.SignModeHandler(), tc.authenticators),
+			)
+			_, err = antehandler(suite.ctx, tx, tc.simulate)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.simulate, simulated)
+			}
+
+			// the session key is not set as the pubkey of the account
+			suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetPubKey())
+		})
+	}
+}
+
 // This test is exactly like the one above, but we set the codec explicitly to
 // Amino.
 // Once https://github.com/cosmos/cosmos-sdk/issues/6190 is in, we can remove
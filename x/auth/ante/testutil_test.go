@@ -68,6 +68,9 @@ func (suite *AnteTestSuite) SetupTest(isCheckTx bool) {
 			AccountKeeper:   suite.app.AccountKeeper,
 			BankKeeper:      suite.app.BankKeeper,
 			FeegrantKeeper:  suite.app.FeeGrantKeeper,
+			GlobalFeeKeeper: suite.app.GlobalFeeKeeper,
+			FeeMarketKeeper: suite.app.FeeMarketKeeper,
+			FeeAbsKeeper:    suite.app.FeeAbsKeeper,
 			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
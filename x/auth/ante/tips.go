@@ -0,0 +1,57 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TipDecorator transfers the tip of the tx, if any, from the tipper to the fee
+// payer. It allows a user holding no native tokens to reward the fee payer for
+// paying the tx fees on their behalf. Txs not implementing TipTx can't carry a
+// tip and are passed through.
+type TipDecorator struct {
+	bankKeeper types.BankKeeper
+}
+
+// NewTipDecorator returns a new TipDecorator.
+func NewTipDecorator(bankKeeper types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		bankKeeper: bankKeeper,
+	}
+}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method.
+func (td TipDecorator) AnteHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	tipTx, ok := sdkTx.(tx.TipTx)
+	if !ok {
+		return next(ctx, sdkTx, simulate)
+	}
+
+	if err := td.transferTip(ctx, tipTx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, sdkTx, simulate)
+}
+
+// transferTip transfers the tip from the tipper to the fee payer.
+func (td TipDecorator) transferTip(ctx sdk.Context, tipTx tx.TipTx) error {
+	tip := tipTx.GetTip()
+	if tip == nil {
+		return nil
+	}
+
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return err
+	}
+
+	err = td.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), tip.Amount)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "tipper %s cannot pay the tip: %s", tip.Tipper, err)
+	}
+
+	return nil
+}
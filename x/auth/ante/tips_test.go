@@ -0,0 +1,105 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (suite *AnteTestSuite) TestTipDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(2)
+	tipper, feePayer := accounts[0], accounts[1]
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(tipper.acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeePayer(feePayer.acc.GetAddress())
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTipDecorator(suite.app.BankKeeper))
+
+	// no tip, nothing is transferred
+	_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10000000), suite.app.BankKeeper.GetBalance(suite.ctx, tipper.acc.GetAddress(), "atom").Amount.Int64())
+	suite.Require().Equal(int64(10000000), suite.app.BankKeeper.GetBalance(suite.ctx, feePayer.acc.GetAddress(), "atom").Amount.Int64())
+
+	// the tip is transferred from the tipper to the fee payer
+	suite.txBuilder.SetTip(&txtypes.Tip{
+		Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+		Tipper: tipper.acc.GetAddress().String(),
+	})
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(9999000), suite.app.BankKeeper.GetBalance(suite.ctx, tipper.acc.GetAddress(), "atom").Amount.Int64())
+	suite.Require().Equal(int64(10001000), suite.app.BankKeeper.GetBalance(suite.ctx, feePayer.acc.GetAddress(), "atom").Amount.Int64())
+
+	// the tipper can't pay a tip larger than its balance
+	suite.txBuilder.SetTip(&txtypes.Tip{
+		Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 100000000)),
+		Tipper: tipper.acc.GetAddress().String(),
+	})
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().Error(err)
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerAuxSigner() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(2)
+	auxSigner, feePayer := accounts[0], accounts[1]
+	tip := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	feeAmount := testdata.NewTestFeeAmount()
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(auxSigner.acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeePayer(feePayer.acc.GetAddress())
+	suite.txBuilder.SetTip(&txtypes.Tip{Amount: tip, Tipper: auxSigner.acc.GetAddress().String()})
+
+	// the aux signer signs in SIGN_MODE_DIRECT_AUX, the fee payer in
+	// SIGN_MODE_DIRECT
+	modes := []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignMode_SIGN_MODE_DIRECT}
+	privs := []cryptotypes.PrivKey{auxSigner.priv, feePayer.priv}
+
+	sigsV2 := make([]signing.SignatureV2, len(privs))
+	for i, priv := range privs {
+		sigsV2[i] = signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: modes[i]},
+			Sequence: 0,
+		}
+	}
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sigsV2...))
+
+	var err error
+	for i, priv := range privs {
+		signerData := xauthsigning.SignerData{
+			Address:       accounts[i].acc.GetAddress().String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: accounts[i].acc.GetAccountNumber(),
+			Sequence:      0,
+			PubKey:        priv.PubKey(),
+		}
+		sigsV2[i], err = tx.SignWithPrivKey(modes[i], signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, 0)
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sigsV2...))
+
+	_, err = suite.anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+
+	// the fee payer paid the fees and received the tip
+	tipperBalance := suite.app.BankKeeper.GetBalance(suite.ctx, auxSigner.acc.GetAddress(), "atom")
+	feePayerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feePayer.acc.GetAddress(), "atom")
+	suite.Require().Equal(sdk.NewInt(10000000).Sub(tip.AmountOf("atom")), tipperBalance.Amount)
+	suite.Require().Equal(sdk.NewInt(10000000).Add(tip.AmountOf("atom")).Sub(feeAmount.AmountOf("atom")), feePayerBalance.Amount)
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetAuxToFeeCommand returns the command to add the fee payer to a tx signed
+// by auxiliary signers.
+func GetAuxToFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-to-fee [aux_signer_data.json]...",
+		Short: "Sign as the fee payer of a tx signed by auxiliary signers and broadcast it",
+		Long: strings.TrimSpace(`Read the aux signer data generated with the --aux flag by one or more
+auxiliary signers, and combine them into a tx paid for by the --from key. The
+aux signers only sign over the tx body and tip, the fee payer then adds the fee,
+signs over the whole tx and broadcasts it, receiving the tip in exchange.
+If you supply a dash (-) argument in place of an input filename, the command
+reads from standard input.
+
+$ <appd> tx aux-to-fee ./aux_signer_data.json --from feepayer --fees 1000stake
+
+The --generate-only flag prints the tx without the fee payer's signature, to be
+signed with the sign command.
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := clienttx.NewFactoryCLI(clientCtx, cmd.Flags())
+			txBuilder, err := clienttx.BuildUnsignedTx(txf)
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range args {
+				auxSignerData, err := readAuxSignerDataFromFile(clientCtx, filename)
+				if err != nil {
+					return err
+				}
+
+				if err := txBuilder.AddAuxSignerData(auxSignerData); err != nil {
+					return err
+				}
+			}
+
+			txBuilder.SetFeePayer(clientCtx.GetFromAddress())
+			txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
+			if clientCtx.GenerateOnly {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+
+			// keep the signatures of the aux signers
+			err = authclient.SignTx(txf, clientCtx, clientCtx.GetFromName(), txBuilder, clientCtx.Offline, false)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAuxSignerDataFromFile reads and decodes an AuxSignerData from the given
+// filename. Can pass "-" to read from stdin.
+func readAuxSignerDataFromFile(clientCtx client.Context, filename string) (tx.AuxSignerData, error) {
+	var (
+		bz  []byte
+		err error
+	)
+
+	if filename == "-" {
+		bz, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bz, err = ioutil.ReadFile(filename)
+	}
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	var auxSignerData tx.AuxSignerData
+	if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, &auxSignerData); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	return auxSignerData, nil
+}
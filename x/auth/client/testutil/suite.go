@@ -15,6 +15,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -29,6 +30,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -233,6 +237,50 @@ func (s *IntegrationTestSuite) TestCLISignAminoJSON() {
 	require.Equal(txAmino.Tx.Signatures[1].PubKey, valInfo.GetPubKey())
 }
 
+func (s *IntegrationTestSuite) TestCLISignTextual() {
+	require := s.Require()
+	val1 := s.network.Validators[0]
+
+	// the validator client context only enables the default sign modes
+	signModes := append([]signing.SignMode{}, authtx.DefaultSignModes...)
+	signModes = append(signModes, signing.SignMode_SIGN_MODE_TEXTUAL)
+	clientCtx := val1.ClientCtx.WithTxConfig(authtx.NewTxConfigWithTextual(
+		codec.NewProtoCodec(val1.ClientCtx.InterfaceRegistry), signModes,
+		textual.NewTextual(textual.NewGRPCCoinMetadataQueryFn(val1.ClientCtx)),
+	))
+
+	sendTokens := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+	txBz, err := s.createBankMsg(val1, val1.Address, sendTokens, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	require.NoError(err)
+	fileUnsigned := testutil.WriteToNewTempFile(s.T(), txBz.String())
+
+	valInfo, err := val1.ClientCtx.Keyring.Key(val1.Moniker)
+	require.NoError(err)
+
+	res, err := TxSignExec(clientCtx, val1.Address, fileUnsigned.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, val1.ClientCtx.ChainID), "--sign-mode=textual")
+	require.NoError(err)
+
+	signedTx, err := clientCtx.TxConfig.TxJSONDecoder()(res.Bytes())
+	require.NoError(err)
+	sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(err)
+	require.Len(sigs, 1)
+	require.True(sigs[0].PubKey.Equals(valInfo.GetPubKey()))
+	require.Equal(signing.SignMode_SIGN_MODE_TEXTUAL, sigs[0].Data.(*signing.SingleSignatureData).SignMode)
+
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), res.String())
+	clientCtx.BroadcastMode = flags.BroadcastBlock
+	res, err = TxBroadcastExec(clientCtx, signedTxFile.Name())
+	require.NoError(err)
+
+	var txRes sdk.TxResponse
+	require.NoError(clientCtx.JSONMarshaler.UnmarshalJSON(res.Bytes(), &txRes))
+	require.Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	require.NoError(s.network.WaitForNextBlock())
+}
+
 func checkSignatures(require *require.Assertions, txCfg client.TxConfig, output []byte, pks ...cryptotypes.PubKey) {
 	sigs, err := txCfg.UnmarshalSignatureJSON(output)
 	require.NoError(err, string(output))
@@ -243,6 +291,57 @@ func checkSignatures(require *require.Assertions, txCfg client.TxConfig, output
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLIAuxToFee() {
+	require := s.Require()
+	val1 := s.network.Validators[0]
+
+	feePayerInfo, _, err := val1.ClientCtx.Keyring.NewMnemonic("auxFeePayer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(err)
+	feePayer := feePayerInfo.GetAddress()
+
+	// fund the fee payer
+	_, err = s.createBankMsg(val1, feePayer, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))))
+	require.NoError(err)
+	require.NoError(s.network.WaitForNextBlock())
+
+	// the validator signs a send as an aux signer, tipping the fee payer
+	tip := sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(7))
+	sendTokens := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+	res, err := bankcli.MsgSendExec(val1.ClientCtx, val1.Address, feePayer, sendTokens,
+		fmt.Sprintf("--%s=true", flags.FlagAux),
+		fmt.Sprintf("--%s=%s", flags.FlagTip, tip),
+	)
+	require.NoError(err)
+
+	var auxSignerData tx.AuxSignerData
+	require.NoError(val1.ClientCtx.JSONMarshaler.UnmarshalJSON(res.Bytes(), &auxSignerData))
+	require.Equal(val1.Address.String(), auxSignerData.Address)
+	require.Equal(signing.SignMode_SIGN_MODE_DIRECT_AUX, auxSignerData.Mode)
+	require.Equal(&tx.Tip{Amount: sdk.NewCoins(tip), Tipper: val1.Address.String()}, auxSignerData.SignDoc.Tip)
+	auxFile := testutil.WriteToNewTempFile(s.T(), res.String())
+
+	// the fee payer pays the fees, signs and broadcasts the tx
+	fee := sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))
+	res, err = TxAuxToFeeExec(val1.ClientCtx, feePayer, auxFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, fee),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	)
+	require.NoError(err)
+
+	var txRes sdk.TxResponse
+	require.NoError(val1.ClientCtx.JSONMarshaler.UnmarshalJSON(res.Bytes(), &txRes))
+	require.Equal(uint32(0), txRes.Code, txRes.RawLog)
+	require.NoError(s.network.WaitForNextBlock())
+
+	// the fee payer received the sent tokens and the tip, and paid the fees
+	resp, err := bankcli.QueryBalancesExec(val1.ClientCtx, feePayer)
+	require.NoError(err)
+	var balRes banktypes.QueryAllBalancesResponse
+	require.NoError(val1.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), &balRes))
+	require.Equal(sdk.NewInt(100).Add(sendTokens.AmountOf(s.cfg.BondDenom)).Add(tip.Amount).Sub(fee.Amount), balRes.Balances.AmountOf(s.cfg.BondDenom))
+}
+
 func (s *IntegrationTestSuite) TestCLIQueryTxCmd() {
 	val := s.network.Validators[0]
 
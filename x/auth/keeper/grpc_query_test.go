@@ -3,8 +3,14 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -150,6 +156,64 @@ func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
 	}
 }
 
+// abstractAccount is an abstract account encoded as a module account holding
+// the name of its authenticator. It names its own message type instead of
+// being registered in the global proto registry.
+type abstractAccount struct {
+	types.ModuleAccount
+}
+
+func (acc *abstractAccount) GetAuthenticator() string { return acc.Name }
+
+func (acc *abstractAccount) XXX_MessageName() string {
+	return "cosmos.auth.v1beta1.testutil.AbstractAccount"
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAbstractAccount() {
+	// the abstract account is only known by a local interface registry, used by
+	// an account keeper of the auth store of the app
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	registry.RegisterImplementations((*types.AccountI)(nil), &abstractAccount{})
+	accountKeeper := keeper.NewAccountKeeper(
+		codec.NewProtoCodec(registry), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		types.ProtoBaseAccount, simapp.GetMaccPerms(),
+	)
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, registry)
+	types.RegisterQueryServer(queryHelper, accountKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	baseAcc := accountKeeper.NewAccountWithAddress(suite.ctx, addr).(*types.BaseAccount)
+	accountKeeper.SetAccount(suite.ctx, &abstractAccount{types.ModuleAccount{BaseAccount: baseAcc, Name: "session"}})
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	requireAbstractAccount := func(any *codectypes.Any) {
+		var account types.AccountI
+		suite.Require().NoError(registry.UnpackAny(any, &account))
+		abstractAcc, ok := account.(types.AbstractAccountI)
+		suite.Require().True(ok)
+		suite.Require().True(addr.Equals(abstractAcc.GetAddress()))
+		suite.Require().Equal("session", abstractAcc.GetAuthenticator())
+	}
+
+	res, err := queryClient.Account(ctx, &types.QueryAccountRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	requireAbstractAccount(res.Account)
+
+	accountsRes, err := queryClient.Accounts(ctx, &types.QueryAccountsRequest{})
+	suite.Require().NoError(err)
+	found := false
+	for _, any := range accountsRes.Accounts {
+		if any.TypeUrl == "/cosmos.auth.v1beta1.testutil.AbstractAccount" {
+			requireAbstractAccount(any)
+			found = true
+		}
+	}
+	suite.Require().True(found)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryParameters() {
 	var (
 		req       *types.QueryParamsRequest
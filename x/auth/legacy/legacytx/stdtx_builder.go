@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -84,9 +85,21 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetFeePayer does nothing for stdtx
+func (s *StdTxBuilder) SetFeePayer(_ sdk.AccAddress) {}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
+// SetTip does nothing for stdtx
+func (s *StdTxBuilder) SetTip(_ *txtypes.Tip) {}
+
+// AddAuxSignerData returns an error for stdtx, as auxiliary signers are only
+// supported for protobuf transactions.
+func (s *StdTxBuilder) AddAuxSignerData(_ txtypes.AuxSignerData) error {
+	return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "aux signers are not supported by amino StdTx")
+}
+
 // StdTxConfig is a context.TxConfig for StdTx
 type StdTxConfig struct {
 	Cdc *codec.LegacyAmino
@@ -1,6 +1,9 @@
 package signing
 
 import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -20,9 +23,36 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler which may need a
+// context.Context to generate sign bytes, e.g. to read state while rendering
+// a transaction. On chain, the context.Context wraps the sdk.Context of the
+// transaction.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes of a Tx using the handler's
+// GetSignBytesWithContext if it implements SignModeHandlerWithContext, or its
+// GetSignBytes otherwise.
+func GetSignBytesWithContext(ctx context.Context, h SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if hc, ok := h.(SignModeHandlerWithContext); ok {
+		return hc.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return h.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
+	// Address is the bech32-encoded address of the signer. It is only required
+	// by SIGN_MODE_DIRECT_AUX.
+	Address string
+
 	// ChainID is the chain that this transaction is targeted
 	ChainID string
 
@@ -34,4 +64,8 @@ type SignerData struct {
 	// since in SIGN_MODE_DIRECT the account sequence is already in the signer
 	// info.
 	Sequence uint64
+
+	// PubKey is the public key of the signer. It is only required by
+	// SIGN_MODE_DIRECT_AUX, which signs over it.
+	PubKey cryptotypes.PubKey
 }
@@ -1,9 +1,12 @@
 package tx
 
 import (
+	"bytes"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +20,8 @@ import (
 // wrapper is a wrapper around the tx.Tx proto.Message which retain the raw
 // body and auth_info bytes.
 type wrapper struct {
+	cdc codec.ProtoCodecMarshaler
+
 	tx *tx.Tx
 
 	// bodyBz represents the protobuf encoding of TxBody. This should be encoding
@@ -35,6 +40,7 @@ var (
 	_ client.TxBuilder           = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
+	_ tx.TipTx                   = &wrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -45,8 +51,9 @@ type ExtensionOptionsTxBuilder interface {
 	SetNonCriticalExtensionOptions(...*codectypes.Any)
 }
 
-func newBuilder() *wrapper {
+func newBuilder(cdc codec.ProtoCodecMarshaler) *wrapper {
 	return &wrapper{
+		cdc: cdc,
 		tx: &tx.Tx{
 			Body: &tx.TxBody{},
 			AuthInfo: &tx.AuthInfo{
@@ -156,6 +163,11 @@ func (w *wrapper) FeeGranter() sdk.AccAddress {
 	return nil
 }
 
+// GetTip returns the transaction's tip (if set).
+func (w *wrapper) GetTip() *tx.Tip {
+	return w.tx.AuthInfo.Tip
+}
+
 func (w *wrapper) GetMemo() string {
 	return w.tx.Body.Memo
 }
@@ -282,6 +294,14 @@ func (w *wrapper) SetFeeGranter(feeGranter sdk.AccAddress) {
 	w.authInfoBz = nil
 }
 
+// SetTip sets the tip the tipper pays to the fee payer of the transaction.
+func (w *wrapper) SetTip(tip *tx.Tip) {
+	w.tx.AuthInfo.Tip = tip
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	w.authInfoBz = nil
+}
+
 func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -317,6 +337,74 @@ func (w *wrapper) setSignatures(sigs [][]byte) {
 	w.tx.Signatures = sigs
 }
 
+// AddAuxSignerData adds the signature of an auxiliary signer to the
+// transaction. The body and tip of the transaction are set from the aux
+// signer's sign doc, so that they match what the aux signer signed over. The
+// signer info and signature are placed at the index of the aux signer in
+// GetSigners.
+func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if w.cdc == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "tx builder has no codec to decode the aux signer's body bytes")
+	}
+
+	// all the signers of a tx must sign over the same body
+	if len(w.tx.Signatures) > 0 && !bytes.Equal(w.getBodyBytes(), data.SignDoc.BodyBytes) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "body bytes of aux signer %s don't match the tx body", data.Address)
+	}
+
+	var body tx.TxBody
+	if err := w.cdc.UnmarshalBinaryBare(data.SignDoc.BodyBytes, &body); err != nil {
+		return err
+	}
+
+	w.tx.Body = &body
+	// keep the exact bytes the aux signer signed over
+	w.bodyBz = data.SignDoc.BodyBytes
+	w.SetTip(data.SignDoc.Tip)
+
+	signerIndex := -1
+	for i, signer := range w.GetSigners() {
+		if signer.String() == data.Address {
+			signerIndex = i
+			break
+		}
+	}
+	if signerIndex < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "aux signer %s is not a signer of the tx", data.Address)
+	}
+
+	// grow the signer infos and signatures up to the aux signer's index,
+	// leaving empty entries for the signers that haven't signed yet
+	signerInfos := w.tx.AuthInfo.SignerInfos
+	sigs := w.tx.Signatures
+	for len(signerInfos) <= signerIndex {
+		signerInfos = append(signerInfos, &tx.SignerInfo{})
+	}
+	for len(sigs) <= signerIndex {
+		sigs = append(sigs, nil)
+	}
+
+	signerInfos[signerIndex] = &tx.SignerInfo{
+		PublicKey: data.SignDoc.PublicKey,
+		ModeInfo: &tx.ModeInfo{
+			Sum: &tx.ModeInfo_Single_{
+				Single: &tx.ModeInfo_Single{Mode: data.Mode},
+			},
+		},
+		Sequence: data.SignDoc.Sequence,
+	}
+	sigs[signerIndex] = data.Sig
+
+	w.setSignerInfos(signerInfos)
+	w.setSignatures(sigs)
+
+	return nil
+}
+
 func (w *wrapper) GetTx() authsigning.Tx {
 	return w
 }
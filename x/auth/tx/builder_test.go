@@ -19,7 +19,7 @@ func TestTxBuilder(t *testing.T) {
 	_, pubkey, addr := testdata.KeyTestPubAddr()
 
 	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
-	txBuilder := newBuilder()
+	txBuilder := newBuilder(nil)
 
 	memo := "sometestmemo"
 	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
@@ -136,7 +136,7 @@ func TestBuilderValidateBasic(t *testing.T) {
 	// require to fail validation upon invalid fee
 	badFeeAmount := testdata.NewTestFeeAmount()
 	badFeeAmount[0].Amount = sdk.NewInt(-5)
-	txBuilder := newBuilder()
+	txBuilder := newBuilder(nil)
 
 	var sig1, sig2 signing.SignatureV2
 	sig1 = signing.SignatureV2{
@@ -277,7 +277,7 @@ func TestBuilderFeePayer(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			// setup basic tx
-			txBuilder := newBuilder()
+			txBuilder := newBuilder(nil)
 			err := txBuilder.SetMsgs(msgs...)
 			require.NoError(t, err)
 			txBuilder.SetGasLimit(200000)
@@ -301,7 +301,7 @@ func TestBuilderFeeGranter(t *testing.T) {
 	feeAmount := testdata.NewTestFeeAmount()
 	msgs := []sdk.Msg{msg1}
 
-	txBuilder := newBuilder()
+	txBuilder := newBuilder(nil)
 	err := txBuilder.SetMsgs(msgs...)
 	require.NoError(t, err)
 	txBuilder.SetGasLimit(200000)
@@ -313,3 +313,96 @@ func TestBuilderFeeGranter(t *testing.T) {
 	txBuilder.SetFeeGranter(addr1)
 	require.Equal(t, addr1, txBuilder.GetTx().FeeGranter())
 }
+
+func TestBuilderTip(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	txBuilder := newBuilder(nil)
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	require.Nil(t, txBuilder.GetTip())
+
+	tip := &txtypes.Tip{Amount: testdata.NewTestFeeAmount(), Tipper: addr1.String()}
+	authInfoBz := txBuilder.getAuthInfoBytes()
+	txBuilder.SetTip(tip)
+	require.Equal(t, tip, txBuilder.GetTip())
+	require.NotEqual(t, authInfoBz, txBuilder.getAuthInfoBytes())
+}
+
+func TestBuilderAddAuxSignerData(t *testing.T) {
+	_, auxPubKey, auxAddr := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	body := &txtypes.TxBody{Memo: "aux memo"}
+	msgAny, err := codectypes.NewAnyWithValue(testdata.NewTestMsg(auxAddr))
+	require.NoError(t, err)
+	body.Messages = []*codectypes.Any{msgAny}
+	bodyBz, err := marshaler.MarshalBinaryBare(body)
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(auxPubKey)
+	require.NoError(t, err)
+
+	tip := &txtypes.Tip{Amount: testdata.NewTestFeeAmount(), Tipper: auxAddr.String()}
+	auxSignerData := txtypes.AuxSignerData{
+		Address: auxAddr.String(),
+		SignDoc: &txtypes.SignDocDirectAux{
+			BodyBytes:     bodyBz,
+			PublicKey:     pkAny,
+			ChainId:       "test-chain",
+			AccountNumber: 1,
+			Sequence:      3,
+			Tip:           tip,
+		},
+		Mode: signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		Sig:  []byte("aux signature"),
+	}
+
+	t.Log("verify that a codec is required")
+	require.Error(t, newBuilder(nil).AddAuxSignerData(auxSignerData))
+
+	txBuilder := newBuilder(marshaler)
+	require.NoError(t, txBuilder.AddAuxSignerData(auxSignerData))
+	txBuilder.SetFeePayer(feePayerAddr)
+
+	t.Log("verify that the body and tip are set from the sign doc")
+	require.Equal(t, bodyBz, txBuilder.getBodyBytes())
+	require.Equal(t, "aux memo", txBuilder.GetMemo())
+	require.Equal(t, []sdk.Msg{testdata.NewTestMsg(auxAddr)}, txBuilder.GetMsgs())
+	require.Equal(t, tip, txBuilder.GetTip())
+	require.Equal(t, []sdk.AccAddress{auxAddr, feePayerAddr}, txBuilder.GetSigners())
+
+	t.Log("verify that the aux signature is set at the aux signer's index")
+	sigs, err := txBuilder.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, auxPubKey, sigs[0].PubKey)
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+	require.Equal(t, &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		Signature: []byte("aux signature"),
+	}, sigs[0].Data)
+
+	t.Log("verify that a body that doesn't match the signed one is rejected")
+	otherBody := *body
+	otherBody.Memo = "other memo"
+	otherBodyBz, err := marshaler.MarshalBinaryBare(&otherBody)
+	require.NoError(t, err)
+	otherData := auxSignerData
+	otherSignDoc := *auxSignerData.SignDoc
+	otherSignDoc.BodyBytes = otherBodyBz
+	otherData.SignDoc = &otherSignDoc
+	require.Error(t, txBuilder.AddAuxSignerData(otherData))
+
+	t.Log("verify that a non signer can't add aux signer data")
+	nonSignerData := auxSignerData
+	nonSignerData.Address = feePayerAddr.String()
+	require.NoError(t, newBuilder(marshaler).AddAuxSignerData(auxSignerData))
+	require.Error(t, newBuilder(marshaler).AddAuxSignerData(nonSignerData))
+
+	t.Log("verify that invalid aux signer data is rejected")
+	invalidData := auxSignerData
+	invalidData.Mode = signing.SignMode_SIGN_MODE_DIRECT
+	require.Error(t, newBuilder(marshaler).AddAuxSignerData(invalidData))
+}
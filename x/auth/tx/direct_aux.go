@@ -0,0 +1,74 @@
+package tx
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	types "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ signing.SignModeHandler = signModeDirectAuxHandler{}
+
+// signModeDirectAuxHandler defines the SIGN_MODE_DIRECT_AUX SignModeHandler
+type signModeDirectAuxHandler struct{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeDirectAuxHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_DIRECT_AUX
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeDirectAuxHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeDirectAuxHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_DIRECT_AUX {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	if data.Address == "" {
+		return nil, fmt.Errorf("got empty address in %s handler", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	// the fee payer can't sign with SIGN_MODE_DIRECT_AUX, as it doesn't sign
+	// over the fee
+	if protoTx.FeePayer().String() == data.Address {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee payer %s cannot sign with %s", data.Address, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	if data.PubKey == nil {
+		return nil, fmt.Errorf("got empty pubkey in %s handler", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(data.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return DirectAuxSignBytes(protoTx.getBodyBytes(), pkAny, data.ChainID, data.AccountNumber, data.Sequence, protoTx.tx.AuthInfo.Tip)
+}
+
+// DirectAuxSignBytes returns the SIGN_MODE_DIRECT_AUX sign bytes for the provided TxBody bytes, public key, chain ID,
+// account number, sequence and tip.
+func DirectAuxSignBytes(bodyBytes []byte, pkAny *codectypes.Any, chainID string, accnum, seq uint64, tip *types.Tip) ([]byte, error) {
+	signDoc := types.SignDocDirectAux{
+		BodyBytes:     bodyBytes,
+		PublicKey:     pkAny,
+		ChainId:       chainID,
+		AccountNumber: accnum,
+		Sequence:      seq,
+		Tip:           tip,
+	}
+	return signDoc.Marshal()
+}
@@ -0,0 +1,143 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestDirectAuxHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX})
+	txBuilder := txConfig.NewTxBuilder()
+
+	memo := "sometestmemo"
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+	accSeq := uint64(2) // Arbitrary account sequence
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tiptoken", 10)), Tipper: addr.String()}
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+	}
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     sigData,
+		Sequence: accSeq,
+	}
+
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+	txBuilder.SetFeePayer(feePayerAddr)
+	txBuilder.SetTip(tip)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	t.Log("verify modes and default-mode")
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		PubKey:        pubkey,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify that the sign bytes are the marshaled SignDocDirectAux")
+	bodyBz, err := marshaler.MarshalBinaryBare(txBuilder.GetTx().(*wrapper).tx.Body)
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(pubkey)
+	require.NoError(t, err)
+	expectedSignDoc := txtypes.SignDocDirectAux{
+		BodyBytes:     bodyBz,
+		PublicKey:     pkAny,
+		ChainId:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		Tip:           tip,
+	}
+	expectedSignBytes, err := expectedSignDoc.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that the sign bytes don't depend on the fee")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	txBuilder.SetGasLimit(40000)
+	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify the signature")
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	err = signing.VerifySignature(context.Background(), pubkey, signingData, sigData, modeHandler, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify that the sign bytes depend on the tip")
+	txBuilder.SetTip(&txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tiptoken", 20)), Tipper: addr.String()})
+	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that the fee payer can't sign with SIGN_MODE_DIRECT_AUX")
+	signingData.Address = feePayerAddr.String()
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify that the address and the pubkey are required")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignerData{PubKey: pubkey}, txBuilder.GetTx())
+	require.Error(t, err)
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignerData{Address: addr.String()}, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestDirectAuxHandler_nonDIRECT_AUX_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			var dh signModeDirectAuxHandler
+			var signingData signing.SignerData
+			_, err := dh.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestDirectAuxHandler_nonProtoTx(t *testing.T) {
+	var dh signModeDirectAuxHandler
+	var signingData signing.SignerData
+	tx := new(nonProtoTx)
+	_, err := dh.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
@@ -5,17 +5,20 @@ import (
 
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
 var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
+	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_LEGACY_AMINO_JSON and, if a
+// textual renderer is provided, SIGN_MODE_TEXTUAL.
+func makeSignModeHandler(modes []signingtypes.SignMode, t *textual.Textual) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -26,8 +29,15 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 		switch mode {
 		case signingtypes.SignMode_SIGN_MODE_DIRECT:
 			handlers[i] = signModeDirectHandler{}
+		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
+			handlers[i] = signModeDirectAuxHandler{}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			if t == nil {
+				panic(fmt.Errorf("sign mode %+v requires a textual renderer", mode))
+			}
+			handlers[i] = signModeTextualHandler{t: t}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -0,0 +1,58 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, or nil if the denom
+// has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// BankKeeper defines the expected bank keeper to look up coin metadata on
+// chain.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading coin
+// metadata from the bank keeper. The context.Context it is called with must
+// wrap an sdk.Context, see sdk.WrapSDKContext.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("coin metadata can only be read from the bank keeper with a wrapped sdk.Context")
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn querying coin
+// metadata through the bank gRPC query service, e.g. with a client.Context.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := banktypes.NewQueryClient(conn).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &res.Metadata, nil
+	}
+}
@@ -0,0 +1,270 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Screen is a single line of text shown to the signer on a device. Screens
+// with Expert set are only shown when the device is in expert mode, but are
+// signed over all the same.
+type Screen struct {
+	Text   string
+	Indent int
+	Expert bool
+}
+
+// ValueRenderer renders a protobuf message into screens. The returned screens
+// are indented relative to the message itself, which is why they usually start
+// at indentation 0.
+type ValueRenderer func(ctx context.Context, t *Textual, msg proto.Message) ([]Screen, error)
+
+// TxData is the data of a transaction needed to render it.
+type TxData struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	Body          *txtypes.TxBody
+	AuthInfo      *txtypes.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// Textual renders transactions into a deterministic list of screens for
+// SIGN_MODE_TEXTUAL. Messages are rendered field by field unless a
+// ValueRenderer is registered for their protobuf message name.
+//
+// The same renderers must be registered on every Textual used to sign and to
+// verify transactions, otherwise the rendered screens, and therefore the sign
+// bytes, differ.
+type Textual struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	renderers           map[string]ValueRenderer
+}
+
+// NewTextual returns a Textual looking up coin metadata with the provided
+// query function. It panics if the query function is nil.
+func NewTextual(coinMetadataQuerier CoinMetadataQueryFn) *Textual {
+	if coinMetadataQuerier == nil {
+		panic("coin metadata query function cannot be nil")
+	}
+
+	return &Textual{
+		coinMetadataQuerier: coinMetadataQuerier,
+		renderers:           make(map[string]ValueRenderer),
+	}
+}
+
+// RegisterValueRenderer registers a ValueRenderer for the protobuf message with
+// the provided fully-qualified name, e.g. "cosmos.bank.v1beta1.MsgSend". It
+// panics if a renderer is already registered for that message.
+func (t *Textual) RegisterValueRenderer(msgName string, r ValueRenderer) {
+	if _, ok := t.renderers[msgName]; ok {
+		panic(fmt.Errorf("value renderer for %s already registered", msgName))
+	}
+
+	t.renderers[msgName] = r
+}
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of a transaction, that
+// is the SHA-256 hash of its encoded screens.
+func (t *Textual) GetSignBytes(ctx context.Context, data TxData) ([]byte, error) {
+	screens, err := t.RenderTx(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(EncodeScreens(screens))
+	return hash[:], nil
+}
+
+// RenderTx renders a transaction into the screens shown to its signer.
+func (t *Textual) RenderTx(ctx context.Context, data TxData) ([]Screen, error) {
+	if data.Body == nil || data.AuthInfo == nil {
+		return nil, fmt.Errorf("transaction body and auth info must be set")
+	}
+
+	screens := []Screen{
+		{Text: fmt.Sprintf("Chain id: %s", data.ChainID)},
+		{Text: fmt.Sprintf("Account number: %d", data.AccountNumber)},
+		{Text: fmt.Sprintf("Sequence: %d", data.Sequence)},
+	}
+
+	msgs := data.Body.Messages
+	if len(msgs) == 1 {
+		screens = append(screens, Screen{Text: "This transaction has 1 Message"})
+	} else {
+		screens = append(screens, Screen{Text: fmt.Sprintf("This transaction has %d Messages", len(msgs))})
+	}
+
+	for i, msg := range msgs {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Message (%d/%d): %s", i+1, len(msgs), msg.TypeUrl)})
+
+		msgScreens, err := t.formatAnyValue(ctx, msg, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, msgScreens...)
+		screens = append(screens, Screen{Text: "End of Message"})
+	}
+
+	if data.Body.Memo != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Memo: %s", data.Body.Memo)})
+	}
+
+	fee := data.AuthInfo.Fee
+	if fee == nil {
+		fee = &txtypes.Fee{}
+	}
+
+	fees, err := t.FormatCoins(ctx, fee.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	screens = append(screens, Screen{Text: fmt.Sprintf("Fees: %s", fees)})
+
+	if tip := data.AuthInfo.Tip; tip != nil {
+		tipAmount, err := t.FormatCoins(ctx, tip.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens,
+			Screen{Text: fmt.Sprintf("Tip: %s", tipAmount)},
+			Screen{Text: fmt.Sprintf("Tipper: %s", tip.Tipper)},
+		)
+	}
+
+	var expert []Screen
+	if fee.Payer != "" {
+		expert = append(expert, Screen{Text: fmt.Sprintf("Fee payer: %s", fee.Payer)})
+	}
+	if fee.Granter != "" {
+		expert = append(expert, Screen{Text: fmt.Sprintf("Fee granter: %s", fee.Granter)})
+	}
+
+	expert = append(expert, Screen{Text: fmt.Sprintf("Gas limit: %s", FormatInteger(fmt.Sprintf("%d", fee.GasLimit)))})
+
+	if data.Body.TimeoutHeight != 0 {
+		expert = append(expert, Screen{Text: fmt.Sprintf("Timeout height: %d", data.Body.TimeoutHeight)})
+	}
+
+	signerInfos := data.AuthInfo.SignerInfos
+	for i, si := range signerInfos {
+		signerScreens, err := formatSignerInfo(si, i, len(signerInfos))
+		if err != nil {
+			return nil, err
+		}
+
+		expert = append(expert, signerScreens...)
+	}
+
+	expert = append(expert, formatExtensionOptions("Extension options", data.Body.ExtensionOptions)...)
+	expert = append(expert, formatExtensionOptions("Non critical extension options", data.Body.NonCriticalExtensionOptions)...)
+	expert = append(expert, Screen{Text: fmt.Sprintf("Hash of raw bytes: %s", hashRawBytes(data.BodyBytes, data.AuthInfoBytes))})
+
+	for i := range expert {
+		expert[i].Expert = true
+	}
+
+	return append(screens, expert...), nil
+}
+
+// FormatFields renders the fields of a protobuf message one after the other,
+// ignoring any ValueRenderer registered for the message itself. Custom
+// renderers may use it to render the fields they don't need to customize.
+func (t *Textual) FormatFields(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	return t.formatFields(ctx, msg, 0)
+}
+
+// formatSignerInfo renders the i-th of n signer infos of a transaction.
+func formatSignerInfo(si *txtypes.SignerInfo, i, n int) ([]Screen, error) {
+	if si == nil {
+		return nil, fmt.Errorf("signer info %d is nil", i)
+	}
+
+	screens := []Screen{{Text: fmt.Sprintf("Signer (%d/%d):", i+1, n)}}
+
+	if si.PublicKey != nil {
+		text := fmt.Sprintf("Public key: %s", si.PublicKey.TypeUrl)
+		if pk, ok := si.PublicKey.GetCachedValue().(cryptotypes.PubKey); ok {
+			text = fmt.Sprintf("%s %s", text, strings.ToUpper(hex.EncodeToString(pk.Bytes())))
+		}
+		screens = append(screens, Screen{Text: text, Indent: 1})
+	}
+
+	if si.ModeInfo != nil {
+		switch mi := si.ModeInfo.Sum.(type) {
+		case *txtypes.ModeInfo_Single_:
+			screens = append(screens, Screen{Text: fmt.Sprintf("Sign mode: %s", mi.Single.Mode), Indent: 1})
+		case *txtypes.ModeInfo_Multi_:
+			screens = append(screens, Screen{Text: fmt.Sprintf("Sign mode: multisig of %d", len(mi.Multi.ModeInfos)), Indent: 1})
+		}
+	}
+
+	screens = append(screens, Screen{Text: fmt.Sprintf("Sequence: %d", si.Sequence), Indent: 1})
+
+	return screens, nil
+}
+
+// formatExtensionOptions renders the type URLs of the extension options of a
+// transaction. Their content is covered by the hash of the raw bytes.
+func formatExtensionOptions(title string, opts []*codectypes.Any) []Screen {
+	var screens []Screen
+	for i, opt := range opts {
+		screens = append(screens, Screen{Text: fmt.Sprintf("%s (%d/%d): %s", title, i+1, len(opts), opt.TypeUrl)})
+	}
+
+	return screens
+}
+
+// hashRawBytes returns the hex-encoded SHA-256 hash of the length-prefixed
+// body and auth info bytes. Including it in the screens guarantees that two
+// different transactions never render to the same screens.
+func hashRawBytes(bodyBytes, authInfoBytes []byte) string {
+	h := sha256.New()
+	for _, bz := range [][]byte{bodyBytes, authInfoBytes} {
+		h.Write(encodeUvarint(uint64(len(bz))))
+		h.Write(bz)
+	}
+
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+}
+
+// EncodeScreens encodes screens into the bytes hashed to sign a transaction.
+// It writes the number of screens followed by, for each screen, its
+// length-prefixed text, its indentation and a byte set to 1 for expert screens.
+// All numbers are unsigned varints.
+func EncodeScreens(screens []Screen) []byte {
+	bz := encodeUvarint(uint64(len(screens)))
+	for _, s := range screens {
+		bz = append(bz, encodeUvarint(uint64(len(s.Text)))...)
+		bz = append(bz, s.Text...)
+		bz = append(bz, encodeUvarint(uint64(s.Indent))...)
+
+		if s.Expert {
+			bz = append(bz, 1)
+		} else {
+			bz = append(bz, 0)
+		}
+	}
+
+	return bz
+}
+
+func encodeUvarint(n uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, n)]
+}
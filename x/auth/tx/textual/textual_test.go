@@ -0,0 +1,344 @@
+package textual_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var atomMetadata = banktypes.Metadata{
+	Base:    "uatom",
+	Display: "atom",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0},
+		{Denom: "matom", Exponent: 3},
+		{Denom: "atom", Exponent: 6},
+	},
+}
+
+func metadataQueryFn(metadatas ...banktypes.Metadata) textual.CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		for _, m := range metadatas {
+			if m.Base == denom {
+				return &m, nil
+			}
+			for _, unit := range m.DenomUnits {
+				if unit.Denom == denom {
+					return &m, nil
+				}
+			}
+		}
+		return nil, nil
+	}
+}
+
+func TestFormatInteger(t *testing.T) {
+	testCases := []struct {
+		in  string
+		exp string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"123", "123"},
+		{"1234", "1'234"},
+		{"123456", "123'456"},
+		{"1234567", "1'234'567"},
+		{"-1234567", "-1'234'567"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, textual.FormatInteger(tc.in), tc.in)
+	}
+}
+
+func TestFormatDec(t *testing.T) {
+	testCases := []struct {
+		in  string
+		exp string
+	}{
+		{"0", "0"},
+		{"1.5", "1.5"},
+		{"1234.500", "1'234.5"},
+		{"1000000", "1'000'000"},
+		{"0.000001", "0.000001"},
+		{"-1234.01", "-1'234.01"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, textual.FormatDec(sdk.MustNewDecFromStr(tc.in)), tc.in)
+	}
+}
+
+func TestFormatCoin(t *testing.T) {
+	r := textual.NewTextual(metadataQueryFn(
+		atomMetadata,
+		banktypes.Metadata{Base: "ustake", Display: "ustake", DenomUnits: []*banktypes.DenomUnit{{Denom: "ustake"}}},
+		banktypes.Metadata{Base: "ufoo", Display: "foo", DenomUnits: []*banktypes.DenomUnit{{Denom: "ufoo"}}},
+	))
+	ctx := context.Background()
+
+	testCases := []struct {
+		coin sdk.Coin
+		exp  string
+	}{
+		{sdk.NewInt64Coin("uatom", 1500000), "1.5 atom"},
+		{sdk.NewInt64Coin("uatom", 1), "0.000001 atom"},
+		{sdk.NewInt64Coin("uatom", 1234000000), "1'234 atom"},
+		{sdk.NewInt64Coin("matom", 1500), "1.5 atom"},
+		{sdk.NewInt64Coin("atom", 2), "2 atom"},
+		{sdk.NewInt64Coin("ustake", 1000), "1'000 ustake"},
+		{sdk.NewInt64Coin("ufoo", 1000), "1'000 ufoo"},
+		{sdk.NewInt64Coin("nometadata", 1000), "1'000 nometadata"},
+	}
+
+	for _, tc := range testCases {
+		s, err := r.FormatCoin(ctx, tc.coin)
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, s, tc.coin.String())
+	}
+
+	s, err := r.FormatCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000), sdk.NewInt64Coin("ustake", 10)))
+	require.NoError(t, err)
+	require.Equal(t, "1.5 atom, 10 ustake", s)
+
+	s, err = r.FormatCoins(ctx, sdk.Coins{})
+	require.NoError(t, err)
+	require.Equal(t, "zero", s)
+
+	expErr := fmt.Errorf("connection refused")
+	r = textual.NewTextual(func(context.Context, string) (*banktypes.Metadata, error) {
+		return nil, expErr
+	})
+	_, err = r.FormatCoin(ctx, sdk.NewInt64Coin("uatom", 1))
+	require.Equal(t, expErr, err)
+}
+
+func makeTxData(t *testing.T, msgs ...proto.Message) textual.TxData {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+
+	pubKey := secp256k1.GenPrivKeyFromSecret([]byte("textual")).PubKey()
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	body := &txtypes.TxBody{Messages: anys, Memo: "hello", TimeoutHeight: 10}
+	authInfo := &txtypes.AuthInfo{
+		SignerInfos: []*txtypes.SignerInfo{{
+			PublicKey: pkAny,
+			ModeInfo: &txtypes.ModeInfo{
+				Sum: &txtypes.ModeInfo_Single_{Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_TEXTUAL}},
+			},
+			Sequence: 3,
+		}},
+		Fee: &txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)), GasLimit: 200000},
+	}
+
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+	authInfoBz, err := authInfo.Marshal()
+	require.NoError(t, err)
+
+	return textual.TxData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      3,
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+}
+
+func TestRenderTx(t *testing.T) {
+	r := textual.NewTextual(metadataQueryFn(atomMetadata))
+
+	msg := banktypes.NewMsgSend(
+		sdk.AccAddress("from________________"), sdk.AccAddress("to__________________"),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+	)
+	data := makeTxData(t, msg)
+
+	screens, err := r.RenderTx(context.Background(), data)
+	require.NoError(t, err)
+
+	pk := data.AuthInfo.SignerInfos[0].PublicKey.GetCachedValue().(*secp256k1.PubKey)
+	expected := []textual.Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Account number: 1"},
+		{Text: "Sequence: 3"},
+		{Text: "This transaction has 1 Message"},
+		{Text: "Message (1/1): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: fmt.Sprintf("From address: %s", msg.FromAddress), Indent: 1},
+		{Text: fmt.Sprintf("To address: %s", msg.ToAddress), Indent: 1},
+		{Text: "Amount: 1.5 atom", Indent: 1},
+		{Text: "End of Message"},
+		{Text: "Memo: hello"},
+		{Text: "Fees: 0.002 atom"},
+		{Text: "Gas limit: 200'000", Expert: true},
+		{Text: "Timeout height: 10", Expert: true},
+		{Text: "Signer (1/1):", Expert: true},
+		{Text: fmt.Sprintf("Public key: /cosmos.crypto.secp256k1.PubKey %X", pk.Bytes()), Indent: 1, Expert: true},
+		{Text: "Sign mode: SIGN_MODE_TEXTUAL", Indent: 1, Expert: true},
+		{Text: "Sequence: 3", Indent: 1, Expert: true},
+	}
+
+	require.Len(t, screens, len(expected)+1)
+	require.Equal(t, expected, screens[:len(expected)])
+
+	last := screens[len(screens)-1]
+	require.True(t, last.Expert)
+	require.True(t, strings.HasPrefix(last.Text, "Hash of raw bytes: "))
+
+	// the same transaction always renders to the same screens, and different
+	// bytes to a different hash
+	again, err := r.RenderTx(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, screens, again)
+
+	data.BodyBytes = append(data.BodyBytes, 0)
+	again, err = r.RenderTx(context.Background(), data)
+	require.NoError(t, err)
+	require.NotEqual(t, last, again[len(again)-1])
+}
+
+func TestRenderTxTip(t *testing.T) {
+	r := textual.NewTextual(metadataQueryFn(atomMetadata))
+
+	tipper := sdk.AccAddress("tipper______________")
+	msg := banktypes.NewMsgSend(tipper, sdk.AccAddress("to__________________"), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	data := makeTxData(t, msg)
+	data.AuthInfo.Tip = &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), Tipper: tipper.String()}
+
+	screens, err := r.RenderTx(context.Background(), data)
+	require.NoError(t, err)
+
+	for i, screen := range screens {
+		if screen.Text == "Fees: 0.002 atom" {
+			require.Equal(t, textual.Screen{Text: "Tip: 0.0005 atom"}, screens[i+1])
+			require.Equal(t, textual.Screen{Text: fmt.Sprintf("Tipper: %s", tipper)}, screens[i+2])
+			return
+		}
+	}
+	t.Fatal("fees screen not found")
+}
+
+func TestRenderTxNestedValues(t *testing.T) {
+	r := textual.NewTextual(metadataQueryFn(atomMetadata))
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			{Address: "input", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000))},
+		},
+		Outputs: []banktypes.Output{
+			{Address: "output1", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 400000))},
+			{Address: "output2", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 600000))},
+		},
+	}
+
+	screens, err := r.RenderTx(context.Background(), makeTxData(t, msg))
+	require.NoError(t, err)
+
+	expected := []textual.Screen{
+		{Text: "Message (1/1): /cosmos.bank.v1beta1.MsgMultiSend"},
+		{Text: "Inputs: 1 cosmos.bank.v1beta1.Input", Indent: 1},
+		{Text: "Inputs (1/1): cosmos.bank.v1beta1.Input", Indent: 2},
+		{Text: "Address: input", Indent: 3},
+		{Text: "Coins: 1 atom", Indent: 3},
+		{Text: "Outputs: 2 cosmos.bank.v1beta1.Output", Indent: 1},
+		{Text: "Outputs (1/2): cosmos.bank.v1beta1.Output", Indent: 2},
+		{Text: "Address: output1", Indent: 3},
+		{Text: "Coins: 0.4 atom", Indent: 3},
+		{Text: "Outputs (2/2): cosmos.bank.v1beta1.Output", Indent: 2},
+		{Text: "Address: output2", Indent: 3},
+		{Text: "Coins: 0.6 atom", Indent: 3},
+		{Text: "End of Message"},
+	}
+	require.Equal(t, expected, screens[4:4+len(expected)])
+}
+
+func TestRegisterValueRenderer(t *testing.T) {
+	r := textual.NewTextual(metadataQueryFn(atomMetadata))
+
+	sendRenderer := func(ctx context.Context, t *textual.Textual, msg proto.Message) ([]textual.Screen, error) {
+		send := msg.(*banktypes.MsgSend)
+		amount, err := t.FormatCoins(ctx, send.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		return []textual.Screen{{Text: fmt.Sprintf("Send %s to %s", amount, send.ToAddress)}}, nil
+	}
+	r.RegisterValueRenderer(proto.MessageName(&banktypes.MsgSend{}), sendRenderer)
+	require.Panics(t, func() {
+		r.RegisterValueRenderer(proto.MessageName(&banktypes.MsgSend{}), sendRenderer)
+	})
+
+	msg := banktypes.NewMsgSend(
+		sdk.AccAddress("from________________"), sdk.AccAddress("to__________________"),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+	)
+
+	screens, err := r.RenderTx(context.Background(), makeTxData(t, msg))
+	require.NoError(t, err)
+	require.Equal(t, textual.Screen{Text: "Message (1/1): /cosmos.bank.v1beta1.MsgSend"}, screens[4])
+	require.Equal(t, textual.Screen{Text: fmt.Sprintf("Send 1.5 atom to %s", msg.ToAddress), Indent: 1}, screens[5])
+	require.Equal(t, textual.Screen{Text: "End of Message"}, screens[6])
+
+	// the default rendering remains available to custom renderers
+	fields, err := r.FormatFields(context.Background(), msg)
+	require.NoError(t, err)
+	require.Len(t, fields, 3)
+}
+
+func TestGetSignBytes(t *testing.T) {
+	r := textual.NewTextual(metadataQueryFn(atomMetadata))
+	data := makeTxData(t, banktypes.NewMsgSend(
+		sdk.AccAddress("from________________"), sdk.AccAddress("to__________________"),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+	))
+
+	screens, err := r.RenderTx(context.Background(), data)
+	require.NoError(t, err)
+
+	signBytes, err := r.GetSignBytes(context.Background(), data)
+	require.NoError(t, err)
+	expected := sha256.Sum256(textual.EncodeScreens(screens))
+	require.Equal(t, expected[:], signBytes)
+
+	// the display units are part of the sign bytes
+	r = textual.NewTextual(metadataQueryFn())
+	otherBytes, err := r.GetSignBytes(context.Background(), data)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherBytes)
+}
+
+func TestEncodeScreens(t *testing.T) {
+	screens := []textual.Screen{{Text: "a"}, {Text: "b", Indent: 1}}
+	require.Equal(t, []byte{2, 1, 'a', 0, 0, 1, 'b', 1, 0}, textual.EncodeScreens(screens))
+
+	expert := []textual.Screen{{Text: "a"}, {Text: "b", Indent: 1, Expert: true}}
+	require.NotEqual(t, textual.EncodeScreens(screens), textual.EncodeScreens(expert))
+
+	// screen boundaries are unambiguous
+	require.NotEqual(t,
+		textual.EncodeScreens([]textual.Screen{{Text: "ab"}, {Text: "c"}}),
+		textual.EncodeScreens([]textual.Screen{{Text: "a"}, {Text: "bc"}}),
+	)
+}
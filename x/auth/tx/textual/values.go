@@ -0,0 +1,419 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	coinType     = reflect.TypeOf(sdk.Coin{})
+	coinsType    = reflect.TypeOf(sdk.Coins{})
+	decCoinType  = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType = reflect.TypeOf(sdk.DecCoins{})
+	intType      = reflect.TypeOf(sdk.Int{})
+	decType      = reflect.TypeOf(sdk.Dec{})
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	anyPtrType   = reflect.TypeOf(&codectypes.Any{})
+	protoMsgType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// FormatInteger formats the decimal representation of an integer with a
+// thousands separator, e.g. "1234567" is formatted as "1'234'567".
+func FormatInteger(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	var sb strings.Builder
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+
+	return sign + sb.String()
+}
+
+// FormatDec formats a decimal without trailing zeros and with a thousands
+// separator in its integer part, e.g. 1234.500 is formatted as "1'234.5".
+func FormatDec(d sdk.Dec) string {
+	s := d.String()
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], strings.TrimRight(s[i+1:], "0")
+	}
+
+	if fracPart == "" {
+		return FormatInteger(intPart)
+	}
+
+	return FormatInteger(intPart) + "." + fracPart
+}
+
+// FormatCoin formats a coin in the display unit of its bank metadata, e.g.
+// 1500000uatom is formatted as "1.5 atom". Coins whose denom has no metadata,
+// or whose metadata has no denom unit for the coin or display denom, are
+// formatted in their own denom.
+func (t *Textual) FormatCoin(ctx context.Context, coin sdk.Coin) (string, error) {
+	metadata, err := t.coinMetadataQuerier(ctx, coin.Denom)
+	if err != nil {
+		return "", err
+	}
+
+	raw := fmt.Sprintf("%s %s", FormatInteger(coin.Amount.String()), coin.Denom)
+	if metadata == nil || metadata.Display == "" || metadata.Display == coin.Denom {
+		return raw, nil
+	}
+
+	coinExp, dispExp := int64(-1), int64(-1)
+	for _, unit := range metadata.DenomUnits {
+		if unit == nil {
+			continue
+		}
+		if unit.Denom == coin.Denom {
+			coinExp = int64(unit.Exponent)
+		}
+		if unit.Denom == metadata.Display {
+			dispExp = int64(unit.Exponent)
+		}
+	}
+
+	// the conversion must be exact, which sdk.Dec only guarantees up to its
+	// precision
+	if coinExp < 0 || dispExp < coinExp || dispExp-coinExp > sdk.Precision {
+		return raw, nil
+	}
+
+	amount := sdk.NewDecFromIntWithPrec(coin.Amount, dispExp-coinExp)
+	return fmt.Sprintf("%s %s", FormatDec(amount), metadata.Display), nil
+}
+
+// FormatCoins formats coins with FormatCoin, separated by commas. Empty coins
+// are formatted as "zero".
+func (t *Textual) FormatCoins(ctx context.Context, coins sdk.Coins) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		s, err := t.FormatCoin(ctx, coin)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// formatDecCoins formats decimal coins in their own denom.
+func formatDecCoins(coins sdk.DecCoins) string {
+	if len(coins) == 0 {
+		return "zero"
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		formatted[i] = fmt.Sprintf("%s %s", FormatDec(coin.Amount), coin.Denom)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// formatAnyValue renders the message packed in an Any, with the renderer
+// registered for it if any.
+func (t *Textual) formatAnyValue(ctx context.Context, any *codectypes.Any, indent int) ([]Screen, error) {
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot render unpacked Any %s", any.TypeUrl)
+	}
+
+	return t.formatMessage(ctx, msg, indent)
+}
+
+// formatMessage renders a protobuf message with the renderer registered for it,
+// or field by field otherwise.
+func (t *Textual) formatMessage(ctx context.Context, msg proto.Message, indent int) ([]Screen, error) {
+	r, ok := t.renderers[proto.MessageName(msg)]
+	if !ok {
+		return t.formatFields(ctx, msg, indent)
+	}
+
+	screens, err := r(ctx, t, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range screens {
+		screens[i].Indent += indent
+	}
+
+	return screens, nil
+}
+
+// formatFields renders the non-empty fields of a protobuf message in the order
+// they are declared.
+func (t *Textual) formatFields(ctx context.Context, msg proto.Message, indent int) ([]Screen, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render message of type %T", msg)
+	}
+
+	return t.formatStruct(ctx, v.Elem(), indent)
+}
+
+func (t *Textual) formatStruct(ctx context.Context, v reflect.Value, indent int) ([]Screen, error) {
+	var screens []Screen
+
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fv := v.Field(i)
+
+		// oneof fields hold a pointer to a wrapper struct with a single field
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			if fv.IsNil() {
+				continue
+			}
+
+			wrapper := fv.Elem().Elem()
+			field, fv = wrapper.Type().Field(0), wrapper.Field(0)
+		}
+
+		name := protoFieldName(field)
+		if name == "" {
+			continue
+		}
+
+		fieldScreens, err := t.formatField(ctx, fieldTitle(name), fv, indent)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// formatField renders a field value as a "<title>: <value>" screen, followed
+// by the screens of its nested values. Empty values are not rendered.
+func (t *Textual) formatField(ctx context.Context, title string, v reflect.Value, indent int) ([]Screen, error) {
+	if isEmptyValue(v) {
+		return nil, nil
+	}
+
+	// repeated fields, except bytes and coins which are rendered on one line
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 &&
+		v.Type() != coinsType && v.Type() != decCoinsType {
+		n := v.Len()
+		screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", title, n, typeName(v.Type().Elem())), Indent: indent}}
+
+		for i := 0; i < n; i++ {
+			text, nested, err := t.formatValue(ctx, v.Index(i), indent+2)
+			if err != nil {
+				return nil, err
+			}
+
+			screens = append(screens, Screen{Text: fmt.Sprintf("%s (%d/%d): %s", title, i+1, n, text), Indent: indent + 1})
+			screens = append(screens, nested...)
+		}
+
+		return screens, nil
+	}
+
+	text, nested, err := t.formatValue(ctx, v, indent+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]Screen{{Text: fmt.Sprintf("%s: %s", title, text), Indent: indent}}, nested...), nil
+}
+
+// formatValue renders a single value into the text of its own screen and, for
+// messages, the screens of its fields at the provided indentation.
+func (t *Textual) formatValue(ctx context.Context, v reflect.Value, indent int) (string, []Screen, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "nil", nil, nil
+		}
+
+		if v.Type() == anyPtrType {
+			any := v.Interface().(*codectypes.Any)
+			screens, err := t.formatAnyValue(ctx, any, indent)
+			return any.TypeUrl, screens, err
+		}
+
+		if v.Type().Implements(protoMsgType) {
+			msg := v.Interface().(proto.Message)
+			screens, err := t.formatMessage(ctx, msg, indent)
+			return proto.MessageName(msg), screens, err
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Type() {
+	case coinType:
+		s, err := t.FormatCoin(ctx, v.Interface().(sdk.Coin))
+		return s, nil, err
+
+	case coinsType:
+		s, err := t.FormatCoins(ctx, v.Interface().(sdk.Coins))
+		return s, nil, err
+
+	case decCoinType:
+		return formatDecCoins(sdk.DecCoins{v.Interface().(sdk.DecCoin)}), nil, nil
+
+	case decCoinsType:
+		return formatDecCoins(v.Interface().(sdk.DecCoins)), nil, nil
+
+	case intType:
+		return FormatInteger(v.Interface().(sdk.Int).String()), nil, nil
+
+	case decType:
+		return FormatDec(v.Interface().(sdk.Dec)), nil, nil
+
+	case timeType:
+		return v.Interface().(time.Time).UTC().Format(time.RFC3339Nano), nil, nil
+
+	case durationType:
+		return v.Interface().(time.Duration).String(), nil, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil, nil
+
+	case reflect.Bool:
+		if v.Bool() {
+			return "True", nil, nil
+		}
+		return "False", nil, nil
+
+	case reflect.Int32:
+		// protobuf enums
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), nil, nil
+		}
+		return FormatInteger(strconv.FormatInt(v.Int(), 10)), nil, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64:
+		return FormatInteger(strconv.FormatInt(v.Int(), 10)), nil, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return FormatInteger(strconv.FormatUint(v.Uint(), 10)), nil, nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return strings.ToUpper(hex.EncodeToString(v.Bytes())), nil, nil
+		}
+
+	case reflect.Struct:
+		// messages embedded by value, render them through a pointer so that
+		// their registered renderer, if any, is used
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		if msg, ok := ptr.Interface().(proto.Message); ok {
+			screens, err := t.formatMessage(ctx, msg, indent)
+			return proto.MessageName(msg), screens, err
+		}
+
+		screens, err := t.formatStruct(ctx, v, indent)
+		return typeName(v.Type()), screens, err
+	}
+
+	return "", nil, fmt.Errorf("cannot render value of type %s", v.Type())
+}
+
+// isEmptyValue reports whether v is the default value of a protobuf field.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+
+	case reflect.Bool:
+		return !v.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+
+	case reflect.Struct:
+		switch val := v.Interface().(type) {
+		case sdk.Int:
+			return val.IsNil() || val.IsZero()
+		case sdk.Dec:
+			return val.IsNil() || val.IsZero()
+		case sdk.Coin:
+			return val.Denom == "" && (val.Amount.IsNil() || val.Amount.IsZero())
+		case time.Time:
+			return val.IsZero()
+		}
+	}
+
+	return false
+}
+
+// protoFieldName returns the protobuf name of a struct field, or an empty
+// string for fields which are not protobuf fields.
+func protoFieldName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
+
+// fieldTitle turns a protobuf field name into a title, e.g. "from_address"
+// becomes "From address".
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// typeName returns the protobuf message name of a type if it has one, or its
+// Go name otherwise.
+func typeName(typ reflect.Type) string {
+	if typ.Kind() != reflect.Ptr {
+		typ = reflect.PtrTo(typ)
+	}
+
+	if typ.Implements(protoMsgType) {
+		if name := proto.MessageName(reflect.Zero(typ).Interface().(proto.Message)); name != "" {
+			return name
+		}
+	}
+
+	return typ.Elem().Name()
+}
@@ -0,0 +1,146 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func newTestTextual() *textual.Textual {
+	return textual.NewTextual(func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if denom != "uatom" {
+			return nil, nil
+		}
+
+		return &banktypes.Metadata{
+			Base:       "uatom",
+			Display:    "atom",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+		}, nil
+	})
+}
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	renderer := newTestTextual()
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, renderer)
+	txBuilder := txConfig.NewTxBuilder()
+
+	accSeq := uint64(2)
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+	}
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     sigData,
+		Sequence: accSeq,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	t.Log("verify modes and default-mode")
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify that the sign bytes are the hash of the rendered screens")
+	protoTx := txBuilder.GetTx().(*wrapper)
+	expectedSignBytes, err := renderer.GetSignBytes(context.Background(), textual.TxData{
+		ChainID:       signingData.ChainID,
+		AccountNumber: signingData.AccountNumber,
+		Sequence:      signingData.Sequence,
+		Body:          protoTx.tx.Body,
+		AuthInfo:      protoTx.tx.AuthInfo,
+		BodyBytes:     protoTx.getBodyBytes(),
+		AuthInfoBytes: protoTx.getAuthInfoBytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that setting signature doesn't change sign bytes")
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify the signature")
+	err = signing.VerifySignature(context.Background(), pubkey, signingData, sigData, modeHandler, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify that the sign bytes depend on the signer data")
+	signingData.Sequence++
+	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, expectedSignBytes, signBytes)
+	err = signing.VerifySignature(context.Background(), pubkey, signingData, sigData, modeHandler, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestTextualModeHandler_nonTEXTUAL_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			h := signModeTextualHandler{t: newTestTextual()}
+			var signingData signing.SignerData
+			_, err := h.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestTextualModeHandler_nonProtoTx(t *testing.T) {
+	h := signModeTextualHandler{t: newTestTextual()}
+	var signingData signing.SignerData
+	tx := new(nonProtoTx)
+	_, err := h.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
+
+func TestNewTxConfigWithTextual(t *testing.T) {
+	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	modes := []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT, signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+
+	require.Panics(t, func() { NewTxConfig(marshaler, modes) })
+
+	txConfig := NewTxConfigWithTextual(marshaler, modes, newTestTextual())
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT, txConfig.SignModeHandler().DefaultMode())
+	require.Equal(t, modes, txConfig.SignModeHandler().Modes())
+}
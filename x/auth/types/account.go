@@ -339,6 +339,17 @@ type ModuleAccountI interface {
 	HasPermission(string) bool
 }
 
+// AbstractAccountI defines an account interface for the accounts authenticating
+// their transactions with custom logic instead of the signatures of their
+// public key, such as smart contract or session key accounts. Their signatures
+// are verified by the authenticator registered in the ante handler under the
+// name returned by GetAuthenticator.
+type AbstractAccountI interface {
+	AccountI
+
+	GetAuthenticator() string
+}
+
 // GenesisAccounts defines a slice of GenesisAccount objects
 type GenesisAccounts []GenesisAccount
 
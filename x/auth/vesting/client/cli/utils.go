@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines the JSON layout of a vesting schedule file, as read by
+// the create-periodic-vesting-account and create-clawback-vesting-account
+// commands.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a single vesting period of a vesting schedule file.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// ParseVestingSchedule reads and parses a vesting schedule file, returning the
+// schedule's start time and its vesting periods.
+func ParseVestingSchedule(path string) (int64, []types.Period, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make([]types.Period, 0, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid coins in period %d: %w", i, err)
+		}
+
+		if p.Length < 1 {
+			return 0, nil, fmt.Errorf("invalid length in period %d: %d", i, p.Length)
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return data.StartTime, periods, nil
+}
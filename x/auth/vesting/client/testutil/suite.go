@@ -2,8 +2,11 @@ package testutil
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -130,3 +133,149 @@ func (s *IntegrationTestSuite) TestNewMsgCreateVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	val := s.network.Validators[0]
+
+	schedule := filepath.Join(s.T().TempDir(), "periods.json")
+	s.Require().NoError(ioutil.WriteFile(schedule, []byte(fmt.Sprintf(`{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 2592000},
+    {"coins": "10%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom)), 0600))
+
+	badSchedule := filepath.Join(s.T().TempDir(), "bad_periods.json")
+	s.Require().NoError(ioutil.WriteFile(badSchedule, []byte(`{"start_time": 1625204910, "periods": [{"coins": "fooo", "length_seconds": 1}]}`), 0600))
+
+	testCases := map[string]struct {
+		cmd          func() *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"create a periodic vesting account": {
+			cmd: cli.NewMsgCreatePeriodicVestingAccountCmd,
+			args: []string{
+				sdk.AccAddress("addr5_______________").String(),
+				schedule,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"create a clawback vesting account": {
+			cmd: cli.NewMsgCreateClawbackVestingAccountCmd,
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				schedule,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid schedule file": {
+			cmd: cli.NewMsgCreateClawbackVestingAccountCmd,
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				badSchedule,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"missing schedule file": {
+			cmd: cli.NewMsgCreatePeriodicVestingAccountCmd,
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				filepath.Join(s.T().TempDir(), "missing.json"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	clawbackTestCases := map[string]struct {
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"clawback by the funder": {
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagDest, sdk.AccAddress("addr8_______________")),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid destination": {
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagDest, "foo"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	for name, tc := range clawbackTestCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgClawbackCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+			}
+		})
+	}
+}
@@ -2,14 +2,18 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +27,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +95,239 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create periodic vesting account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+10000, periods),
+			expectErr: false,
+		},
+		{
+			name:      "periodic vesting account already exists",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+10000, periods),
+			expectErr: true,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, ctx.BlockTime().Unix()+10000, []types.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 10000))},
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				acc, ok := suite.app.AccountKeeper.GetAccount(ctx, toAddr).(*types.PeriodicVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), acc.GetVestingCoins(ctx.BlockTime()))
+				suite.Require().Equal(tc.msg.StartTime+200, acc.GetEndTime())
+				suite.Require().NoError(acc.Validate())
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+	}
+	msg := types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+10000, periods)
+	_, err := suite.handler(ctx, msg)
+	suite.Require().NoError(err)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(addr1, acc.GetFunder())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), acc.GetVestingCoins(ctx.BlockTime()))
+	suite.Require().NoError(acc.Validate())
+
+	// the account can't be created twice
+	_, err = suite.handler(ctx, msg)
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	startTime := int64(10000)
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+	// the first of three vesting periods is over
+	ctx = ctx.WithBlockTime(time.Unix(startTime+150, 0))
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	funder := sdk.AccAddress([]byte("funder______________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000))))
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, other, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000))))
+
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000))))
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(1000), true)
+
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+	}
+
+	// createAccount creates a clawback vesting account with 200 unvested
+	// tokens, delegating and then undelegating the given amounts.
+	createAccount := func(addr sdk.AccAddress, delegated, undelegated int64) {
+		_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, startTime, periods))
+		suite.Require().NoError(err)
+
+		validator, found := suite.app.StakingKeeper.GetValidator(ctx, valAddr)
+		suite.Require().True(found)
+		_, err = suite.app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(delegated), stakingtypes.Unbonded, validator, true)
+		suite.Require().NoError(err)
+
+		shares, err := suite.app.StakingKeeper.ValidateUnbondAmount(ctx, addr, valAddr, sdk.NewInt(undelegated))
+		suite.Require().NoError(err)
+		_, err = suite.app.StakingKeeper.Undelegate(ctx, addr, valAddr, shares)
+		suite.Require().NoError(err)
+	}
+
+	delegatedTokens := func(delAddr sdk.AccAddress) sdk.Int {
+		delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return sdk.ZeroInt()
+		}
+		validator, _ := suite.app.StakingKeeper.GetValidator(ctx, valAddr)
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	unbondingTokens := func(delAddr sdk.AccAddress) sdk.Int {
+		total := sdk.ZeroInt()
+		ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return total
+		}
+		for _, entry := range ubd.Entries {
+			total = total.Add(entry.Balance)
+		}
+		return total
+	}
+
+	suite.Run("only the funder can claw back", func() {
+		addr := sdk.AccAddress([]byte("addr1_______________"))
+		createAccount(addr, 150, 50)
+
+		_, err := suite.handler(ctx, types.NewMsgClawback(other, addr, nil))
+		suite.Require().Error(err)
+
+		_, err = suite.handler(ctx, types.NewMsgClawback(funder, other, nil))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("the destination can't be a vesting account", func() {
+		addr := sdk.AccAddress([]byte("addr1_______________"))
+		dest := sdk.AccAddress([]byte("addr2_______________"))
+		createAccount(dest, 150, 50)
+
+		_, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("claw back from the balance and the delegations", func() {
+		addr := sdk.AccAddress([]byte("addr3_______________"))
+		dest := sdk.AccAddress([]byte("dest3_______________"))
+		// 150 tokens are spendable, 100 delegated and 50 unbonding
+		createAccount(addr, 150, 50)
+
+		_, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(sdk.NewInt(150), suite.app.BankKeeper.GetBalance(ctx, dest, bondDenom).Amount)
+		suite.Require().Equal(sdk.NewInt(50), delegatedTokens(dest))
+		suite.Require().Equal(sdk.ZeroInt(), unbondingTokens(dest))
+		suite.Require().Equal(sdk.ZeroInt(), suite.app.BankKeeper.GetBalance(ctx, addr, bondDenom).Amount)
+		suite.Require().Equal(sdk.NewInt(50), delegatedTokens(addr))
+		suite.Require().Equal(sdk.NewInt(50), unbondingTokens(addr))
+
+		acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		suite.Require().True(ok)
+		suite.Require().NoError(acc.Validate())
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetOriginalVesting())
+		suite.Require().True(acc.GetVestingCoins(ctx.BlockTime()).IsZero())
+		suite.Require().True(acc.GetDelegatedVesting().IsZero())
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetDelegatedFree())
+
+		// nothing is left to claw back
+		_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(150), suite.app.BankKeeper.GetBalance(ctx, dest, bondDenom).Amount)
+	})
+
+	suite.Run("claw back from the delegations and the unbonding delegations", func() {
+		addr := sdk.AccAddress([]byte("addr4_______________"))
+		// all the tokens are staked, 100 delegated and 200 unbonding
+		createAccount(addr, 300, 200)
+
+		funderBalance := suite.app.BankKeeper.GetBalance(ctx, funder, bondDenom).Amount
+		_, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, nil))
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(funderBalance, suite.app.BankKeeper.GetBalance(ctx, funder, bondDenom).Amount)
+		suite.Require().Equal(sdk.ZeroInt(), delegatedTokens(addr))
+		suite.Require().Equal(sdk.NewInt(100), unbondingTokens(addr))
+		suite.Require().Equal(sdk.NewInt(100), unbondingTokens(funder))
+
+		acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		suite.Require().True(ok)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetDelegatedFree())
+
+		// the unbonding delegations left complete into the accounts
+		_, err = suite.app.StakingKeeper.CompleteUnbonding(ctx.WithBlockTime(ctx.BlockTime().Add(suite.app.StakingKeeper.UnbondingTime(ctx))), addr, valAddr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(ctx, addr, bondDenom).Amount)
+		acc, ok = suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		suite.Require().True(ok)
+		suite.Require().True(acc.GetDelegatedFree().IsZero())
+	})
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -11,18 +12,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -98,3 +101,243 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 
 	return &types.MsgCreateVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+
+	baseAccount, totalCoins, err := s.newVestingBaseAccount(ctx, msg.FromAddress, msg.ToAddress, msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins, msg.StartTime, msg.VestingPeriods)
+	ak.SetAccount(ctx, vestingAccount)
+
+	if err := s.fundVestingAccount(ctx, msg.FromAddress, msg.ToAddress, totalCoins, "create_periodic_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+
+	baseAccount, totalCoins, err := s.newVestingBaseAccount(ctx, msg.FromAddress, msg.ToAddress, msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	funder, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, funder, totalCoins, msg.StartTime, msg.VestingPeriods)
+	ak.SetAccount(ctx, vestingAccount)
+
+	if err := s.fundVestingAccount(ctx, msg.FromAddress, msg.ToAddress, totalCoins, "create_clawback_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the clawed back coins go to the funder by default
+	destAddress := msg.FunderAddress
+	if msg.DestAddress != "" {
+		destAddress = msg.DestAddress
+	}
+	dest, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", destAddress)
+	}
+
+	// the delegations moved to the destination are not tracked by the vesting
+	// accounting of another vesting account
+	if _, ok := ak.GetAccount(ctx, dest).(exported.VestingAccount); ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "clawback destination %s is a vesting account", destAddress)
+	}
+
+	va, ok := ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of %s", msg.FunderAddress, msg.Address)
+	}
+
+	// once the unvested coins are removed from the vesting schedule, all the
+	// coins left in the account are vested and spendable
+	clawback := va.ComputeClawback(ctx.BlockTime())
+	ak.SetAccount(ctx, va)
+
+	// the coins are reclaimed from the balance first, then from the
+	// delegations and the unbonding delegations
+	fromBalance := coinsMin(clawback, bk.SpendableCoins(ctx, addr))
+	if !fromBalance.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom := sk.BondDenom(ctx)
+	wantStaked := clawback.Sub(fromBalance).AmountOf(bondDenom)
+	staked := sdk.ZeroInt()
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !staked.LT(wantStaked) {
+			break
+		}
+
+		amount, err := sk.TransferDelegation(ctx, addr, dest, delegation.GetValidatorAddr(), wantStaked.Sub(staked))
+		if err != nil {
+			return nil, err
+		}
+		staked = staked.Add(amount)
+	}
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !staked.LT(wantStaked) {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		staked = staked.Add(sk.TransferUnbonding(ctx, addr, dest, valAddr, wantStaked.Sub(staked)))
+	}
+
+	// the reclaimed delegations are no longer delegated by the account
+	if staked.IsPositive() {
+		va, ok = ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+		}
+
+		stakedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, staked))
+		va.DelegatedFree = va.DelegatedFree.Sub(coinsMin(va.DelegatedFree, stakedCoins))
+		ak.SetAccount(ctx, va)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// newVestingBaseAccount checks that a vesting account funded with the coins
+// of the given periods can be created at toAddress, and returns its base
+// account along with the total funded coins.
+func (s msgServer) newVestingBaseAccount(
+	ctx sdk.Context, fromAddress, toAddress string, periods []types.Period,
+) (*authtypes.BaseAccount, sdk.Coins, error) {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	var totalCoins sdk.Coins
+	for _, period := range periods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+	totalCoins = totalCoins.Sort()
+
+	if err := bk.SendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, nil, err
+	}
+
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", toAddress)
+	}
+
+	baseAccount, ok := ak.NewAccountWithAddress(ctx, to).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	return baseAccount, totalCoins, nil
+}
+
+// fundVestingAccount sends the vested coins to a newly created vesting
+// account.
+func (s msgServer) fundVestingAccount(ctx sdk.Context, fromAddress, toAddress string, amount sdk.Coins, metric string) error {
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", metric},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return nil
+}
+
+// coinsMin returns the minimum amount of each denom of a in a and b.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	var coins sdk.Coins
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			coins = coins.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return coins
+}
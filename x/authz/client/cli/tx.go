@@ -27,6 +27,9 @@ const FlagMsgType = "msg-type"
 const FlagExpiration = "expiration"
 const FlagAllowedValidators = "allowed-validators"
 const FlagDenyValidators = "deny-validators"
+const FlagMaxUses = "max-uses"
+const FlagAllowedValues = "allowed-values"
+const FlagFieldSpendLimit = "field-spend-limit"
 const delegate = "delegate"
 const redelegate = "redelegate"
 const unbond = "unbond"
@@ -53,7 +56,7 @@ func GetTxCmd() *cobra.Command {
 
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"limited\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf:
@@ -61,7 +64,10 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.Msg/Vote --from=cosmos1sk..
-	`, version.AppName, types.ModuleName, bank.SendAuthorization{}.MethodName(), version.AppName, types.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. limited --msg-type=/cosmos.bank.v1beta1.Msg/Send --max-uses=3 \
+	--allowed-values=to_address=cosmos1ab..,cosmos1cd.. --field-spend-limit=amount=1000stake --from=cosmos1sk..
+	`, version.AppName, types.ModuleName, bank.SendAuthorization{}.MethodName(), version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -105,6 +111,23 @@ Examples:
 				}
 
 				authorization = types.NewGenericAuthorization(msgType)
+			case "limited":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+				if err != nil {
+					return err
+				}
+
+				fieldLimits, err := parseFieldLimits(cmd)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewLimitedAuthorization(msgType, maxUses, fieldLimits...)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -176,7 +199,10 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or LimitedAuthorization")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "Max number of executions of a LimitedAuthorization (0 means unlimited)")
+	cmd.Flags().StringArray(FlagAllowedValues, []string{}, "Allowed values of a message field for a LimitedAuthorization, as <field>=<value1>,<value2>")
+	cmd.Flags().StringArray(FlagFieldSpendLimit, []string{}, "Spend limit of a Coin or Coins message field for a LimitedAuthorization, as <field>=<coins>")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
@@ -184,6 +210,50 @@ Examples:
 	return cmd
 }
 
+// parseFieldLimits parses the field limits of a LimitedAuthorization from the command flags.
+func parseFieldLimits(cmd *cobra.Command) ([]types.FieldLimit, error) {
+	allowedValues, err := cmd.Flags().GetStringArray(FlagAllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	spendLimits, err := cmd.Flags().GetStringArray(FlagFieldSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var fieldLimits []types.FieldLimit
+	for _, arg := range allowedValues {
+		field, values, err := splitFieldArg(arg)
+		if err != nil {
+			return nil, err
+		}
+		fieldLimits = append(fieldLimits, types.NewAllowedValuesLimit(field, strings.Split(values, ",")...))
+	}
+
+	for _, arg := range spendLimits {
+		field, limit, err := splitFieldArg(arg)
+		if err != nil {
+			return nil, err
+		}
+		spendLimit, err := sdk.ParseCoinsNormalized(limit)
+		if err != nil {
+			return nil, err
+		}
+		fieldLimits = append(fieldLimits, types.NewSpendLimit(field, spendLimit))
+	}
+
+	return fieldLimits, nil
+}
+
+func splitFieldArg(arg string) (string, string, error) {
+	parts := strings.SplitN(arg, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid field limit %s, expected <field>=<value>", arg)
+	}
+	return parts[0], parts[1], nil
+}
+
 func NewCmdRevokeAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revoke [grantee] [msg_type] --from=[granter]",
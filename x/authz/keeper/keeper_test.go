@@ -205,6 +205,67 @@ func (s *TestSuite) TestKeeperFees() {
 	s.Require().NotNil(authorization)
 }
 
+func (s *TestSuite) TestKeeperLimitedAuthorization() {
+	app, addrs := s.app, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	s.Require().NoError(simapp.FundAccount(app, s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := s.ctx.BlockHeader().Time
+
+	methodName := banktypes.SendAuthorization{}.MethodName()
+	authorization := types.NewLimitedAuthorization(methodName, 2,
+		types.NewAllowedValuesLimit("to_address", recipientAddr.String()),
+		types.NewSpendLimit("amount", sdk.NewCoins(sdk.NewInt64Coin("steak", 10))),
+	)
+	err := app.AuthzKeeper.Grant(s.ctx, granteeAddr, granterAddr, authorization, now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	sendMsgs := func(to sdk.AccAddress, amount int64) []sdk.ServiceMsg {
+		msgs := types.NewMsgExecAuthorized(granteeAddr, []sdk.ServiceMsg{
+			{
+				MethodName: methodName,
+				Request: &banktypes.MsgSend{
+					Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", amount)),
+					FromAddress: granterAddr.String(),
+					ToAddress:   to.String(),
+				},
+			},
+		})
+		s.Require().NoError(msgs.UnpackInterfaces(app.AppCodec()))
+		executeMsgs, err := msgs.GetServiceMsgs()
+		s.Require().NoError(err)
+		return executeMsgs
+	}
+
+	s.T().Log("verify dispatch fails with a recipient that is not allowed")
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, sendMsgs(granteeAddr, 2))
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch fails over the spend limit")
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, sendMsgs(recipientAddr, 11))
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch decreases the spend limit and the remaining uses")
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, sendMsgs(recipientAddr, 4))
+	s.Require().NoError(err)
+	updated, _ := app.AuthzKeeper.GetOrRevokeAuthorization(s.ctx, granteeAddr, granterAddr, methodName)
+	s.Require().NotNil(updated)
+	limited := updated.(*types.LimitedAuthorization)
+	s.Require().Equal(uint64(1), limited.MaxUses)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 6)), limited.FieldLimits[1].SpendLimit)
+
+	s.T().Log("verify the authorization is removed once its uses are exhausted")
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, sendMsgs(recipientAddr, 1))
+	s.Require().NoError(err)
+	updated, _ = app.AuthzKeeper.GetOrRevokeAuthorization(s.ctx, granteeAddr, granterAddr, methodName)
+	s.Require().Nil(updated)
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, sendMsgs(recipientAddr, 1))
+	s.Require().Error(err)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
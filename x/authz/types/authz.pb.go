@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -78,18 +80,152 @@ func (m *GenericAuthorization) GetMessageName() string {
 	return ""
 }
 
+// LimitedAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, a limited number of times and/or
+// with limits on the values of the fields of the executed message.
+type LimitedAuthorization struct {
+	// method name to grant permissions to execute
+	MessageName string `protobuf:"bytes,1,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
+	// max_uses is the remaining number of executions of the authorization. The
+	// authorization is removed once it is used up. 0 means unlimited.
+	MaxUses uint64 `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// field_limits are the limits on the fields of the executed message.
+	FieldLimits []FieldLimit `protobuf:"bytes,3,rep,name=field_limits,json=fieldLimits,proto3" json:"field_limits"`
+}
+
+func (m *LimitedAuthorization) Reset()         { *m = LimitedAuthorization{} }
+func (m *LimitedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*LimitedAuthorization) ProtoMessage()    {}
+func (*LimitedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *LimitedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitedAuthorization.Merge(m, src)
+}
+func (m *LimitedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitedAuthorization proto.InternalMessageInfo
+
+func (m *LimitedAuthorization) GetMessageName() string {
+	if m != nil {
+		return m.MessageName
+	}
+	return ""
+}
+
+func (m *LimitedAuthorization) GetMaxUses() uint64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *LimitedAuthorization) GetFieldLimits() []FieldLimit {
+	if m != nil {
+		return m.FieldLimits
+	}
+	return nil
+}
+
+// FieldLimit limits the values of a field of a message executed with a
+// LimitedAuthorization. Exactly one of allowed_values or spend_limit must be set.
+type FieldLimit struct {
+	// field is the path of the limited field in the message, given as the
+	// dot-separated proto names of the fields (e.g. "to_address").
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// allowed_values restricts a string field to the given values.
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// spend_limit caps the total amount of a Coin or Coins field over all the
+	// executions of the authorization. It is decreased on every execution.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *FieldLimit) Reset()         { *m = FieldLimit{} }
+func (m *FieldLimit) String() string { return proto.CompactTextString(m) }
+func (*FieldLimit) ProtoMessage()    {}
+func (*FieldLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *FieldLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldLimit.Merge(m, src)
+}
+func (m *FieldLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldLimit proto.InternalMessageInfo
+
+func (m *FieldLimit) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldLimit) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+func (m *FieldLimit) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
 // AuthorizationGrant gives permissions to execute
 // the provide method with expiration time.
 type AuthorizationGrant struct {
-	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    time.Time  `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    time.Time   `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
 }
 
 func (m *AuthorizationGrant) Reset()         { *m = AuthorizationGrant{} }
 func (m *AuthorizationGrant) String() string { return proto.CompactTextString(m) }
 func (*AuthorizationGrant) ProtoMessage()    {}
 func (*AuthorizationGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *AuthorizationGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,7 +254,7 @@ func (m *AuthorizationGrant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuthorizationGrant proto.InternalMessageInfo
 
-func (m *AuthorizationGrant) GetAuthorization() *types.Any {
+func (m *AuthorizationGrant) GetAuthorization() *types1.Any {
 	if m != nil {
 		return m.Authorization
 	}
@@ -134,34 +270,47 @@ func (m *AuthorizationGrant) GetExpiration() time.Time {
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*LimitedAuthorization)(nil), "cosmos.authz.v1beta1.LimitedAuthorization")
+	proto.RegisterType((*FieldLimit)(nil), "cosmos.authz.v1beta1.FieldLimit")
 	proto.RegisterType((*AuthorizationGrant)(nil), "cosmos.authz.v1beta1.AuthorizationGrant")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0xff, 0xc3, 0x3f, 0xba, 0x84, 0x18, 0x9a, 0x1e, 0x84, 0x43, 0x4b, 0x38, 0x19,
-	0x13, 0x5a, 0xd1, 0x9b, 0x37, 0x1a, 0x12, 0x4e, 0x78, 0x20, 0x9e, 0xf4, 0x40, 0xb6, 0x30, 0xb6,
-	0x8d, 0x6e, 0xa7, 0xe9, 0x6e, 0x0d, 0xf0, 0x14, 0xbc, 0x86, 0x77, 0x1f, 0x82, 0x78, 0x22, 0x9e,
-	0x3c, 0xa1, 0x29, 0x2f, 0x62, 0xe8, 0x2e, 0x09, 0xe0, 0x69, 0x67, 0xbe, 0xfd, 0xbe, 0xdf, 0x4e,
-	0x76, 0x68, 0x73, 0x8c, 0x82, 0xa3, 0xf0, 0x58, 0x2e, 0xa3, 0xb9, 0xf7, 0xda, 0x09, 0x40, 0xb2,
-	0x8e, 0xea, 0xdc, 0x34, 0x43, 0x89, 0xa6, 0xa5, 0x1c, 0xae, 0xd2, 0xb4, 0xa3, 0x51, 0x57, 0xea,
-	0xa8, 0xf4, 0x78, 0xda, 0x52, 0x36, 0x0d, 0x27, 0x44, 0x0c, 0x5f, 0xc0, 0x2b, 0xbb, 0x20, 0x7f,
-	0xf2, 0x64, 0xcc, 0x41, 0x48, 0xc6, 0x53, 0x6d, 0xb0, 0x42, 0x0c, 0x51, 0x05, 0xb7, 0x95, 0x56,
-	0xeb, 0xc7, 0x31, 0x96, 0xcc, 0xd4, 0x55, 0xeb, 0x91, 0x5a, 0x7d, 0x48, 0x20, 0x8b, 0xc7, 0xdd,
-	0x5c, 0x46, 0x98, 0xc5, 0x73, 0x26, 0x63, 0x4c, 0xcc, 0x2b, 0x5a, 0xe1, 0x20, 0x23, 0x9c, 0x8c,
-	0x12, 0xc6, 0xe1, 0x9c, 0x34, 0xc9, 0xc5, 0xa9, 0x7f, 0x56, 0xac, 0x9d, 0xca, 0x00, 0x84, 0x60,
-	0x21, 0xdc, 0x31, 0x0e, 0x43, 0xaa, 0x3c, 0xdb, 0xfa, 0xb6, 0xf6, 0xf9, 0xde, 0xae, 0x1e, 0x40,
-	0x5a, 0x6f, 0x84, 0x9a, 0x07, 0x4a, 0x3f, 0x63, 0x89, 0x34, 0x07, 0xb4, 0xca, 0xf6, 0xd5, 0x92,
-	0x5e, 0xb9, 0xb6, 0x5c, 0x35, 0xa6, 0xbb, 0x1b, 0xd3, 0xed, 0x26, 0x33, 0xbf, 0xf6, 0x71, 0x8c,
-	0x1d, 0x1e, 0xa6, 0xcd, 0x1e, 0xa5, 0x30, 0x4d, 0xe3, 0x4c, 0xb1, 0xfe, 0x95, 0xac, 0xc6, 0x1f,
-	0xd6, 0xfd, 0xee, 0xa7, 0xfc, 0x93, 0xe5, 0xda, 0x31, 0x16, 0xdf, 0x0e, 0x19, 0xee, 0xe5, 0xfc,
-	0xde, 0xb2, 0xb0, 0xc9, 0xaa, 0xb0, 0xc9, 0x4f, 0x61, 0x93, 0xc5, 0xc6, 0x36, 0x56, 0x1b, 0xdb,
-	0xf8, 0xda, 0xd8, 0xc6, 0xc3, 0x65, 0x18, 0xcb, 0x28, 0x0f, 0xdc, 0x31, 0x72, 0xbd, 0x0d, 0x7d,
-	0xb4, 0xc5, 0xe4, 0xd9, 0x9b, 0xea, 0xfd, 0xca, 0x59, 0x0a, 0x22, 0xf8, 0x5f, 0xbe, 0x77, 0xf3,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x62, 0x49, 0x9d, 0xc0, 0xfc, 0x01, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xb7, 0x01, 0x9b, 0x43, 0x41, 0xb3, 0x72, 0x68, 0x7b, 0x48, 0xa3, 0x4a, 0x48, 0x11,
+	0xd2, 0x92, 0x6d, 0xdc, 0xb8, 0x2d, 0x4c, 0x4c, 0x48, 0x8c, 0x43, 0x04, 0x1c, 0xe0, 0x10, 0x39,
+	0x8d, 0x9b, 0x5a, 0xc4, 0x71, 0x14, 0x3b, 0xa3, 0xdd, 0xaf, 0xd8, 0xdf, 0x80, 0x33, 0x12, 0x67,
+	0x6e, 0x13, 0xa7, 0x89, 0x13, 0xa7, 0x0d, 0xb5, 0x7f, 0x04, 0xc5, 0x76, 0xcb, 0x3a, 0x26, 0x2e,
+	0x9c, 0xea, 0xef, 0xf9, 0xbd, 0xe7, 0xf7, 0x7d, 0xfd, 0x02, 0xdd, 0x21, 0x17, 0x8c, 0x8b, 0x00,
+	0xd7, 0x72, 0x7c, 0x1a, 0x9c, 0xec, 0x25, 0x44, 0xe2, 0x3d, 0x5d, 0xf9, 0x65, 0xc5, 0x25, 0x47,
+	0xb6, 0x66, 0xf8, 0x1a, 0x33, 0x8c, 0x5e, 0x57, 0xa3, 0xb1, 0xe2, 0x04, 0x86, 0xa2, 0x8a, 0x5e,
+	0x3f, 0xe3, 0x3c, 0xcb, 0x49, 0xa0, 0xaa, 0xa4, 0x1e, 0x05, 0x92, 0x32, 0x22, 0x24, 0x66, 0xa5,
+	0x21, 0xd8, 0x19, 0xcf, 0xb8, 0x16, 0x36, 0x27, 0x83, 0x76, 0x6f, 0xca, 0x70, 0x31, 0x35, 0x57,
+	0x8e, 0x09, 0x99, 0x60, 0x41, 0x96, 0x19, 0x87, 0x9c, 0x16, 0xfa, 0x7e, 0xf0, 0x1e, 0xda, 0x47,
+	0xa4, 0x20, 0x15, 0x1d, 0x1e, 0xd4, 0x72, 0xcc, 0x2b, 0x7a, 0x8a, 0x25, 0xe5, 0x05, 0xda, 0x85,
+	0x16, 0x23, 0x72, 0xcc, 0xd3, 0xb8, 0xc0, 0x8c, 0x74, 0x80, 0x0b, 0xbc, 0xad, 0xf0, 0xe1, 0xec,
+	0xb2, 0x6f, 0x1d, 0x13, 0x21, 0x70, 0x46, 0x5e, 0x61, 0x46, 0x22, 0xa8, 0x39, 0xcd, 0xf9, 0xe9,
+	0xf6, 0x8f, 0x2f, 0x3b, 0xed, 0x15, 0x93, 0xc1, 0x37, 0x00, 0xed, 0x97, 0x94, 0x51, 0x49, 0xd2,
+	0xff, 0x74, 0x47, 0x5d, 0xb8, 0xc9, 0xf0, 0x24, 0xae, 0x05, 0x11, 0x9d, 0x35, 0x17, 0x78, 0x1b,
+	0xd1, 0x3d, 0x86, 0x27, 0x6f, 0x04, 0x11, 0xe8, 0x05, 0xbc, 0x3f, 0xa2, 0x24, 0x4f, 0xe3, 0xbc,
+	0x79, 0x4a, 0x74, 0xd6, 0xdd, 0x75, 0xcf, 0xda, 0x77, 0xfd, 0xdb, 0x86, 0xef, 0x3f, 0x6f, 0x98,
+	0x2a, 0x53, 0xb8, 0x71, 0x7e, 0xd9, 0x6f, 0x45, 0xd6, 0x68, 0x89, 0x88, 0xdb, 0x7a, 0xf8, 0x0a,
+	0x20, 0xfc, 0x23, 0x42, 0x36, 0xbc, 0xa3, 0x04, 0x3a, 0x73, 0xa4, 0x0b, 0xf4, 0x08, 0x3e, 0xc0,
+	0x79, 0xce, 0x3f, 0x92, 0x34, 0x3e, 0xc1, 0x79, 0xad, 0x32, 0xae, 0x7b, 0x5b, 0x51, 0xdb, 0xa0,
+	0x6f, 0x15, 0x88, 0x72, 0x68, 0x89, 0x92, 0x14, 0x26, 0xa9, 0x09, 0xda, 0x5d, 0x04, 0x6d, 0xfe,
+	0xa2, 0x65, 0xce, 0x67, 0x9c, 0x16, 0xe1, 0x6e, 0x93, 0xf0, 0xf3, 0x55, 0xdf, 0xcb, 0xa8, 0x1c,
+	0xd7, 0x89, 0x3f, 0xe4, 0xcc, 0xec, 0x8b, 0xf9, 0xd9, 0x11, 0xe9, 0x87, 0x40, 0x4e, 0x4b, 0x22,
+	0x94, 0x40, 0x44, 0x50, 0xf9, 0xab, 0xa8, 0x83, 0x4f, 0x00, 0xa2, 0x95, 0x5e, 0x8e, 0x2a, 0x5c,
+	0x48, 0x74, 0x0c, 0xdb, 0xf8, 0x3a, 0xaa, 0x3a, 0xb1, 0xf6, 0x6d, 0x5f, 0x2f, 0x91, 0xbf, 0x58,
+	0x22, 0xff, 0xa0, 0x98, 0x86, 0xdb, 0xdf, 0x6f, 0x0e, 0x24, 0x5a, 0x55, 0xa3, 0x43, 0x08, 0xc9,
+	0xa4, 0xa4, 0x95, 0xf6, 0x5a, 0x53, 0x5e, 0xbd, 0xbf, 0xbc, 0x5e, 0x2f, 0xf6, 0x38, 0xdc, 0x6c,
+	0x7a, 0x3a, 0xbb, 0xea, 0x83, 0xe8, 0x9a, 0x2e, 0x3c, 0x3c, 0x9f, 0x39, 0xe0, 0x62, 0xe6, 0x80,
+	0x5f, 0x33, 0x07, 0x9c, 0xcd, 0x9d, 0xd6, 0xc5, 0xdc, 0x69, 0xfd, 0x9c, 0x3b, 0xad, 0x77, 0x8f,
+	0xff, 0xd9, 0xfb, 0xc4, 0x7c, 0x7d, 0x6a, 0x06, 0xc9, 0x5d, 0xf5, 0xde, 0x93, 0xdf, 0x03, 0x00,
+	0x57, 0x4d, 0xdc, 0x35, 0x9a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -194,6 +343,108 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldLimits) > 0 {
+		for iNdEx := len(m.FieldLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MessageName) > 0 {
+		i -= len(m.MessageName)
+		copy(dAtA[i:], m.MessageName)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MessageName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AuthorizationGrant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -261,6 +512,53 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *LimitedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageName)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxUses))
+	}
+	if len(m.FieldLimits) > 0 {
+		for _, e := range m.FieldLimits {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AuthorizationGrant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -364,6 +662,289 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldLimits = append(m.FieldLimits, FieldLimit{})
+			if err := m.FieldLimits[len(m.FieldLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AuthorizationGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -423,7 +1004,7 @@ func (m *AuthorizationGrant) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
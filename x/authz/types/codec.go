@@ -22,6 +22,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*exported.Authorization)(nil),
 		&bank.SendAuthorization{},
 		&GenericAuthorization{},
+		&LimitedAuthorization{},
 		&staking.StakeAuthorization{},
 	)
 
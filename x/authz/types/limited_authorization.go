@@ -0,0 +1,221 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// gasCostPerIteration is the gas consumed for each field limit, field value
+// and allowed value checked when accepting a message.
+const gasCostPerIteration = uint64(10)
+
+var (
+	_ exported.Authorization = &LimitedAuthorization{}
+)
+
+// NewLimitedAuthorization creates a new LimitedAuthorization object. A maxUses of 0 means
+// the authorization can be used an unlimited number of times.
+func NewLimitedAuthorization(methodName string, maxUses uint64, fieldLimits ...FieldLimit) *LimitedAuthorization {
+	return &LimitedAuthorization{
+		MessageName: methodName,
+		MaxUses:     maxUses,
+		FieldLimits: fieldLimits,
+	}
+}
+
+// NewAllowedValuesLimit creates a FieldLimit restricting a string field to the given values.
+func NewAllowedValuesLimit(field string, allowedValues ...string) FieldLimit {
+	return FieldLimit{
+		Field:         field,
+		AllowedValues: allowedValues,
+	}
+}
+
+// NewSpendLimit creates a FieldLimit capping the total amount of a Coin or Coins field.
+func NewSpendLimit(field string, spendLimit sdk.Coins) FieldLimit {
+	return FieldLimit{
+		Field:      field,
+		SpendLimit: spendLimit,
+	}
+}
+
+// MethodName implements Authorization.MethodName.
+func (authorization LimitedAuthorization) MethodName() string {
+	return authorization.MessageName
+}
+
+// Accept implements Authorization.Accept. It checks the field limits against the message,
+// decreases the spend limits and the remaining uses, and deletes the authorization once
+// either is used up.
+func (authorization LimitedAuthorization) Accept(ctx sdk.Context, msg sdk.ServiceMsg) (updated exported.Authorization, delete bool, err error) {
+	if msg.MethodName != authorization.MessageName {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	var fields map[string]interface{}
+	if len(authorization.FieldLimits) > 0 {
+		bz, err := codec.ProtoMarshalJSON(msg.Request, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		ctx.GasMeter().ConsumeGas(uint64(len(bz))*storetypes.KVGasConfig().ReadCostPerByte, "limited authorization")
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			return nil, false, err
+		}
+	}
+
+	var limits []FieldLimit
+	for _, limit := range authorization.FieldLimits {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "limited authorization")
+		values, found := fieldValues(fields, strings.Split(limit.Field, "."))
+		if !found {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "field %s not found in message", limit.Field)
+		}
+
+		if len(limit.AllowedValues) > 0 {
+			if err := limit.checkAllowedValues(ctx, values); err != nil {
+				return nil, false, err
+			}
+			limits = append(limits, limit)
+			continue
+		}
+
+		spent, err := spentCoins(ctx, limit.Field, values)
+		if err != nil {
+			return nil, false, err
+		}
+		limitLeft, isNegative := limit.SpendLimit.SafeSub(spent)
+		if isNegative {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount of %s is more than spend limit", limit.Field)
+		}
+		if limitLeft.IsZero() {
+			delete = true
+		}
+		limits = append(limits, NewSpendLimit(limit.Field, limitLeft))
+	}
+
+	switch authorization.MaxUses {
+	case 0:
+		// unlimited uses
+	case 1:
+		delete = true
+	default:
+		authorization.MaxUses--
+	}
+	if delete {
+		return nil, true, nil
+	}
+
+	authorization.FieldLimits = limits
+	return &authorization, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization LimitedAuthorization) ValidateBasic() error {
+	if !msgservice.IsServiceMsg(authorization.MessageName) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, " %s is not a valid service msg", authorization.MessageName)
+	}
+	for _, limit := range authorization.FieldLimits {
+		if err := limit.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the field limit.
+func (limit FieldLimit) ValidateBasic() error {
+	if strings.TrimSpace(limit.Field) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "field limit must name a field")
+	}
+	hasAllowedValues := len(limit.AllowedValues) > 0
+	hasSpendLimit := len(limit.SpendLimit) > 0
+	if hasAllowedValues == hasSpendLimit {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "field limit of %s must set exactly one of allowed values or spend limit", limit.Field)
+	}
+	if hasSpendLimit && !limit.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s must be positive", limit.Field)
+	}
+	return nil
+}
+
+// checkAllowedValues checks that all the given values of the field are allowed.
+func (limit FieldLimit) checkAllowedValues(ctx sdk.Context, values []interface{}) error {
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "field %s is not a string", limit.Field)
+		}
+		allowed := false
+		for _, allowedValue := range limit.AllowedValues {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "limited authorization")
+			if str == allowedValue {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "value %s of field %s is not allowed", str, limit.Field)
+		}
+	}
+	return nil
+}
+
+// fieldValues returns the values found at the given path of the JSON representation of a
+// message. Repeated fields along the path are flattened, so that every element is returned.
+func fieldValues(value interface{}, path []string) ([]interface{}, bool) {
+	switch v := value.(type) {
+	case []interface{}:
+		var values []interface{}
+		for _, elem := range v {
+			elemValues, found := fieldValues(elem, path)
+			if !found {
+				return nil, false
+			}
+			values = append(values, elemValues...)
+		}
+		return values, true
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return []interface{}{v}, true
+		}
+		field, ok := v[path[0]]
+		if !ok {
+			return nil, false
+		}
+		return fieldValues(field, path[1:])
+	default:
+		if len(path) > 0 {
+			return nil, false
+		}
+		return []interface{}{v}, true
+	}
+}
+
+// spentCoins sums the Coin values found in a field.
+func spentCoins(ctx sdk.Context, field string, values []interface{}) (sdk.Coins, error) {
+	spent := sdk.NewCoins()
+	for _, value := range values {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "limited authorization")
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		var coin sdk.Coin
+		if err := json.Unmarshal(bz, &coin); err != nil || coin.Denom == "" || coin.Amount.IsNil() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "field %s is not a coin", field)
+		}
+		if err := coin.Validate(); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "field %s: %s", field, err)
+		}
+		spent = spent.Add(coin)
+	}
+	return spent, nil
+}
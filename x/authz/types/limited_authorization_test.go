@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	coins100 = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	fromAddr = sdk.AccAddress("_____from _____")
+	toAddr   = sdk.AccAddress("_______to________")
+)
+
+func TestLimitedAuthorizationValidateBasic(t *testing.T) {
+	sendMethod := banktypes.SendAuthorization{}.MethodName()
+
+	testCases := []struct {
+		msg           string
+		authorization *types.LimitedAuthorization
+		expectErr     bool
+	}{
+		{"valid", types.NewLimitedAuthorization(sendMethod, 1), false},
+		{"non-service msg", types.NewLimitedAuthorization(banktypes.TypeMsgSend, 1), true},
+		{"valid field limits", types.NewLimitedAuthorization(sendMethod, 0,
+			types.NewAllowedValuesLimit("to_address", toAddr.String()),
+			types.NewSpendLimit("amount", coins100),
+		), false},
+		{"empty field", types.NewLimitedAuthorization(sendMethod, 0,
+			types.NewAllowedValuesLimit("", toAddr.String()),
+		), true},
+		{"no limit", types.NewLimitedAuthorization(sendMethod, 0, types.FieldLimit{Field: "amount"}), true},
+		{"both limits", types.NewLimitedAuthorization(sendMethod, 0, types.FieldLimit{
+			Field: "amount", AllowedValues: []string{"1steak"}, SpendLimit: coins100,
+		}), true},
+		{"non-positive spend limit", types.NewLimitedAuthorization(sendMethod, 0, types.FieldLimit{
+			Field: "amount", SpendLimit: sdk.Coins{sdk.NewInt64Coin("steak", 0)},
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLimitedAuthorizationAccept(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	sendMethod := banktypes.SendAuthorization{}.MethodName()
+	sendMsg := func(to sdk.AccAddress, amount int64) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: sendMethod,
+			Request:    banktypes.NewMsgSend(fromAddr, to, sdk.NewCoins(sdk.NewInt64Coin("steak", amount))),
+		}
+	}
+
+	t.Log("verify unlimited uses are never deleted")
+	authorization := types.NewLimitedAuthorization(sendMethod, 0)
+	updated, del, err := authorization.Accept(ctx, sendMsg(toAddr, 10))
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, authorization, updated)
+
+	t.Log("verify max uses are decremented and the last use deletes the authorization")
+	authorization = types.NewLimitedAuthorization(sendMethod, 2)
+	updated, del, err = authorization.Accept(ctx, sendMsg(toAddr, 10))
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, uint64(1), updated.(*types.LimitedAuthorization).MaxUses)
+	_, del, err = updated.Accept(ctx, sendMsg(toAddr, 10))
+	require.NoError(t, err)
+	require.True(t, del)
+
+	t.Log("verify a message of another method is rejected")
+	_, _, err = authorization.Accept(ctx, sdk.ServiceMsg{
+		MethodName: "/cosmos.staking.v1beta1.Msg/Delegate",
+		Request:    stakingtypes.NewMsgDelegate(fromAddr, sdk.ValAddress(toAddr), sdk.NewInt64Coin("steak", 1)),
+	})
+	require.Error(t, err)
+
+	t.Log("verify allowed values")
+	authorization = types.NewLimitedAuthorization(sendMethod, 0, types.NewAllowedValuesLimit("to_address", toAddr.String()))
+	_, _, err = authorization.Accept(ctx, sendMsg(toAddr, 10))
+	require.NoError(t, err)
+	_, _, err = authorization.Accept(ctx, sendMsg(fromAddr, 10))
+	require.Error(t, err)
+
+	t.Log("verify unknown fields are rejected")
+	authorization = types.NewLimitedAuthorization(sendMethod, 0, types.NewAllowedValuesLimit("recipient", toAddr.String()))
+	_, _, err = authorization.Accept(ctx, sendMsg(toAddr, 10))
+	require.Error(t, err)
+
+	t.Log("verify spend limits are decreased, and delete the authorization once spent")
+	authorization = types.NewLimitedAuthorization(sendMethod, 0, types.NewSpendLimit("amount", coins100))
+	updated, del, err = authorization.Accept(ctx, sendMsg(toAddr, 40))
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("steak", 60)), updated.(*types.LimitedAuthorization).FieldLimits[0].SpendLimit)
+	require.Equal(t, coins100, authorization.FieldLimits[0].SpendLimit)
+	_, _, err = updated.Accept(ctx, sendMsg(toAddr, 61))
+	require.Error(t, err)
+	_, del, err = updated.Accept(ctx, sendMsg(toAddr, 60))
+	require.NoError(t, err)
+	require.True(t, del)
+
+	t.Log("verify spend limits on a single coin field")
+	authorization = types.NewLimitedAuthorization("/cosmos.staking.v1beta1.Msg/Delegate", 0, types.NewSpendLimit("amount", coins100))
+	updated, _, err = authorization.Accept(ctx, sdk.ServiceMsg{
+		MethodName: "/cosmos.staking.v1beta1.Msg/Delegate",
+		Request:    stakingtypes.NewMsgDelegate(fromAddr, sdk.ValAddress(toAddr), sdk.NewInt64Coin("steak", 30)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("steak", 70)), updated.(*types.LimitedAuthorization).FieldLimits[0].SpendLimit)
+
+	t.Log("verify gas is consumed for each allowed value checked")
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	authorization = types.NewLimitedAuthorization(sendMethod, 0, types.NewAllowedValuesLimit("to_address", toAddr.String()))
+	_, _, err = authorization.Accept(ctx, sendMsg(toAddr, 10))
+	require.NoError(t, err)
+	gasOneValue := ctx.GasMeter().GasConsumed()
+	require.Positive(t, gasOneValue)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	authorization = types.NewLimitedAuthorization(sendMethod, 0, types.NewAllowedValuesLimit("to_address", fromAddr.String(), toAddr.String()))
+	_, _, err = authorization.Accept(ctx, sendMsg(toAddr, 10))
+	require.NoError(t, err)
+	require.Greater(t, ctx.GasMeter().GasConsumed(), gasOneValue)
+}
@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/autocli"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -31,6 +32,8 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ autocli.HasAutoCLIConfig = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -82,6 +85,28 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// AutoCLIOptions returns the options of the commands generated for the
+// services of the bank module.
+func (AppModuleBasic) AutoCLIOptions() []*autocli.ServiceCommandDescriptor {
+	return []*autocli.ServiceCommandDescriptor{
+		{
+			Service: "cosmos.bank.v1beta1.Query",
+			Use:     types.ModuleName,
+			RPCCommandOptions: []*autocli.RPCCommandOptions{
+				{RPCMethod: "Balance", Short: "Query the balance of an account for a denom", PositionalArgs: []string{"address", "denom"}},
+				{RPCMethod: "AllBalances", Short: "Query the balances of an account", PositionalArgs: []string{"address"}},
+			},
+		},
+		{
+			Service: "cosmos.bank.v1beta1.Msg",
+			Use:     types.ModuleName,
+			RPCCommandOptions: []*autocli.RPCCommandOptions{
+				{RPCMethod: "Send", Short: "Send funds from the --from account to another", PositionalArgs: []string{"to_address", "amount"}},
+			},
+		},
+	}
+}
+
 // RegisterInterfaces registers interfaces and implementations of the bank module.
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
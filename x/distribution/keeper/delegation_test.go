@@ -625,3 +625,67 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission and a delegation of the same power
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(addr[1], valAddrs[0], valTokens)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the whole delegation is tokenized, the record being owned by addr[2]
+	shareToken, record, err := app.StakingKeeper.TokenizeShares(ctx, addr[1], valAddrs[0], valTokens, addr[2])
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the delegation of the record gets a quarter of the allocated rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	ownerBalance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))), rewards)
+	require.Equal(t, ownerBalance.Add(rewards[0]), app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the delegator does not own the record
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the rewards withdrawn when the last tokenized shares are redeemed are
+	// sent to the owner of the record
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	ownerBalance = app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addr[1], shareToken)
+	require.NoError(t, err)
+	require.Equal(t, ownerBalance.AddAmount(initial.QuoRaw(4)), app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
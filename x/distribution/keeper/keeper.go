@@ -112,6 +112,46 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// held by the tokenize share records of an owner, and sends them to the owner
+// along with the rewards already withdrawn to the module accounts of the
+// records when their delegations were modified.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards := sdk.Coins{}
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr) {
+		moduleAddr := record.GetModuleAddress()
+		valAddr := record.GetValidatorAddr()
+
+		if k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		// nothing can sign for the module account of a record, so its whole
+		// balance is made of the withdrawn rewards
+		rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyOwner, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the fee denoms, which are stored as parameters and updated through
+// governance parameter change proposals.
+type Keeper struct {
+	paramSpace       paramtypes.Subspace
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feeabs Keeper instance
+func NewKeeper(
+	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// GetParams returns the total set of feeabs parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feeabs parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// getParamsIfExists returns the feeabs parameters, which are empty until the
+// module is initialized.
+func (k Keeper) getParamsIfExists(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetIfExists(ctx, types.KeyBaseDenom, &params.BaseDenom)
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenoms, &params.FeeDenoms)
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeRecipient, &params.FeeRecipient)
+	return params
+}
+
+// GetBaseDenom returns the base denom the fee denoms are converted to, and false
+// if there are no fee denoms.
+func (k Keeper) GetBaseDenom(ctx sdk.Context) (string, bool) {
+	var params types.Params
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenoms, &params.FeeDenoms)
+	if len(params.FeeDenoms) == 0 {
+		return "", false
+	}
+
+	k.paramSpace.GetIfExists(ctx, types.KeyBaseDenom, &params.BaseDenom)
+	return params.BaseDenom, true
+}
+
+// ConvertFees returns the given fees, with the amounts of the fee denoms
+// converted to the base denom and added to it.
+func (k Keeper) ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	return k.getParamsIfExists(ctx).ConvertFees(fees)
+}
+
+// CoverFees returns the part of the given fees covering the required fees, with
+// the fee denoms paying for the base denom, and whether they are sufficient.
+func (k Keeper) CoverFees(ctx sdk.Context, fees, required sdk.Coins) (sdk.Coins, bool) {
+	return k.getParamsIfExists(ctx).CoverFees(fees, required)
+}
+
+// ValidateFeeDenoms checks that the given fees are only paid in the base denom
+// and the fee denoms, if there are any.
+func (k Keeper) ValidateFeeDenoms(ctx sdk.Context, fees sdk.Coins) error {
+	// the base denom is only read when there are fee denoms, to keep the gas
+	// cost of the ante handler unchanged for the chains not using them
+	var params types.Params
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenoms, &params.FeeDenoms)
+	if len(params.FeeDenoms) == 0 {
+		return nil
+	}
+
+	k.paramSpace.GetIfExists(ctx, types.KeyBaseDenom, &params.BaseDenom)
+	for _, fee := range fees {
+		if !params.IsAllowedFeeDenom(fee.Denom) {
+			return sdkerrors.Wrapf(types.ErrFeeDenomNotAllowed, "cannot pay fees in %s", fee.Denom)
+		}
+	}
+
+	return nil
+}
+
+// RouteFees moves the part of the fees of a transaction paid in the fee denoms
+// out of the fee collector, to the fee recipient module. The fees are left to
+// the fee collector if there is no fee recipient.
+func (k Keeper) RouteFees(ctx sdk.Context, fees sdk.Coins) error {
+	var params types.Params
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeRecipient, &params.FeeRecipient)
+	if params.FeeRecipient == "" {
+		return nil
+	}
+
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenoms, &params.FeeDenoms)
+
+	var routed sdk.Coins
+	for _, fee := range fees {
+		if _, found := params.GetFeeDenom(fee.Denom); found {
+			routed = routed.Add(fee)
+		}
+	}
+	if routed.IsZero() {
+		return nil
+	}
+
+	if k.authKeeper.GetModuleAccount(ctx, params.FeeRecipient) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee recipient module account %s does not exist", params.FeeRecipient)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, params.FeeRecipient, routed)
+}
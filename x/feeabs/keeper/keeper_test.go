@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeAbsKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = queryClient
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	app, ctx := suite.app, suite.ctx
+
+	// without fee denoms, fees can be paid in any denom, without conversion
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 100))
+	suite.Require().Equal(fees, app.FeeAbsKeeper.ConvertFees(ctx, fees))
+	suite.Require().NoError(app.FeeAbsKeeper.ValidateFeeDenoms(ctx, fees))
+	_, found := app.FeeAbsKeeper.GetBaseDenom(ctx)
+	suite.Require().False(found)
+
+	params := types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("usdc", sdk.NewDecWithPrec(5, 1))}, "")
+	app.FeeAbsKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, app.FeeAbsKeeper.GetParams(ctx))
+
+	baseDenom, found := app.FeeAbsKeeper.GetBaseDenom(ctx)
+	suite.Require().True(found)
+	suite.Require().Equal("stake", baseDenom)
+
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("usdc", 100)),
+		app.FeeAbsKeeper.ConvertFees(ctx, fees),
+	)
+	suite.Require().NoError(app.FeeAbsKeeper.ValidateFeeDenoms(ctx, fees))
+
+	covering, covered := app.FeeAbsKeeper.CoverFees(ctx, fees, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+	suite.Require().True(covered)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 40)), covering)
+
+	err := app.FeeAbsKeeper.ValidateFeeDenoms(ctx, fees.Add(sdk.NewInt64Coin("atom", 1)))
+	suite.Require().True(types.ErrFeeDenomNotAllowed.Is(err), err)
+
+	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestRouteFees() {
+	app := suite.app
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 100))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	distribution := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	usdc := types.NewFeeDenom("usdc", sdk.NewDecWithPrec(5, 1))
+
+	testCases := []struct {
+		name            string
+		params          types.Params
+		expectErr       bool
+		expFeeCollector sdk.Coins
+		expRecipient    int64
+	}{
+		{"no fee denoms", types.NewParams("stake", nil, distrtypes.ModuleName), false, fees, 0},
+		{"no fee recipient", types.NewParams("stake", []types.FeeDenom{usdc}, ""), false, fees, 0},
+		{
+			"fee denoms routed",
+			types.NewParams("stake", []types.FeeDenom{usdc}, distrtypes.ModuleName),
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			100,
+		},
+		{"unknown fee recipient", types.NewParams("stake", []types.FeeDenom{usdc}, "unknown"), true, nil, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			app.FeeAbsKeeper.SetParams(ctx, tc.params)
+
+			suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+			suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+			err := app.FeeAbsKeeper.RouteFees(ctx, fees)
+			if tc.expectErr {
+				suite.Require().True(sdkerrors.ErrUnknownAddress.Is(err), err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expFeeCollector, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+			suite.Require().Equal(sdk.NewInt64Coin("usdc", tc.expRecipient), app.BankKeeper.GetBalance(ctx, distribution, "usdc"))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUninitializedParams() {
+	// before the module is initialized, e.g. in the upgrade adding it, fees
+	// can be paid in any denom
+	k := keeper.NewKeeper(suite.app.ParamsKeeper.Subspace("uninitialized"), suite.app.AccountKeeper, suite.app.BankKeeper, authtypes.FeeCollectorName)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("usdc", 100))
+	suite.Require().Equal(fees, k.ConvertFees(suite.ctx, fees))
+	suite.Require().NoError(k.ValidateFeeDenoms(suite.ctx, fees))
+	suite.Require().NoError(k.RouteFees(suite.ctx, fees))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feeabs module sentinel errors
+var (
+	// ErrFeeDenomNotAllowed error if a fee is paid in a denom which isn't whitelisted
+	ErrFeeDenomNotAllowed = sdkerrors.Register(ModuleName, 2, "fee denom not allowed")
+)
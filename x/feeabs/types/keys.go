@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feeabs"
+
+	// QuerierRoute is the querier route for feeabs
+	QuerierRoute = ModuleName
+)
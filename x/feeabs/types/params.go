@@ -0,0 +1,228 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyBaseDenom    = []byte("BaseDenom")
+	KeyFeeDenoms    = []byte("FeeDenoms")
+	KeyFeeRecipient = []byte("FeeRecipient")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for feeabs module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(baseDenom string, feeDenoms []FeeDenom, feeRecipient string) Params {
+	return Params{
+		BaseDenom:    baseDenom,
+		FeeDenoms:    feeDenoms,
+		FeeRecipient: feeRecipient,
+	}
+}
+
+// DefaultParams returns the default feeabs module parameters, without any fee
+// denom.
+func DefaultParams() Params {
+	return Params{
+		BaseDenom:    sdk.DefaultBondDenom,
+		FeeDenoms:    []FeeDenom{},
+		FeeRecipient: "",
+	}
+}
+
+// NewFeeDenom creates a new FeeDenom object
+func NewFeeDenom(denom string, rate sdk.Dec) FeeDenom {
+	return FeeDenom{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate validates the params, and checks that the base denom isn't a fee
+// denom.
+func (p Params) Validate() error {
+	if err := validateBaseDenom(p.BaseDenom); err != nil {
+		return err
+	}
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+	if err := validateFeeRecipient(p.FeeRecipient); err != nil {
+		return err
+	}
+
+	for _, d := range p.FeeDenoms {
+		if d.Denom == p.BaseDenom {
+			return fmt.Errorf("base denom %s cannot be a fee denom", d.Denom)
+		}
+	}
+
+	return nil
+}
+
+// IsAllowedFeeDenom returns whether fees can be paid in the given denom, which
+// is the case of the base denom and the fee denoms, or of any denom if there
+// are no fee denoms.
+func (p Params) IsAllowedFeeDenom(denom string) bool {
+	if len(p.FeeDenoms) == 0 || denom == p.BaseDenom {
+		return true
+	}
+
+	_, found := p.GetFeeDenom(denom)
+	return found
+}
+
+// GetFeeDenom returns the fee denom of the given denom, if it is whitelisted.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, d := range p.FeeDenoms {
+		if d.Denom == denom {
+			return d, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+// ConvertFees returns the given fees, with the amounts of the fee denoms
+// converted to the base denom at their exchange rate and added to it.
+func (p Params) ConvertFees(fees sdk.Coins) sdk.Coins {
+	res := fees
+	for _, d := range p.FeeDenoms {
+		amt := fees.AmountOf(d.Denom)
+		if amt.IsPositive() {
+			res = res.Add(sdk.NewCoin(p.BaseDenom, d.Rate.MulInt(amt).TruncateInt()))
+		}
+	}
+
+	return res
+}
+
+// CoverFees returns the part of the given fees covering the required fees, and
+// whether they are sufficient. Each required amount is paid in its own denom
+// first, and the rest of the base denom amount is paid with what is left of the
+// fee denoms, in their order, at their exchange rate. A fee coin is never spent
+// twice, so the returned coins are always part of the given fees.
+func (p Params) CoverFees(fees, required sdk.Coins) (sdk.Coins, bool) {
+	res := sdk.NewCoins()
+	available := fees
+	missing := sdk.ZeroInt()
+	for _, req := range required {
+		paid := sdk.MinInt(available.AmountOf(req.Denom), req.Amount)
+		res = res.Add(sdk.NewCoin(req.Denom, paid))
+		available = available.Sub(sdk.NewCoins(sdk.NewCoin(req.Denom, paid)))
+
+		if req.Denom == p.BaseDenom {
+			missing = req.Amount.Sub(paid)
+		} else if paid.LT(req.Amount) {
+			return nil, false
+		}
+	}
+
+	// the fee denoms pay for the base denom with the amounts left once every
+	// required denom paid for itself
+	for _, d := range p.FeeDenoms {
+		if !missing.IsPositive() {
+			break
+		}
+
+		avail := available.AmountOf(d.Denom)
+		if !avail.IsPositive() {
+			continue
+		}
+
+		// the smallest amount of the fee denom worth the missing amount
+		amt := missing.ToDec().Quo(d.Rate).Ceil().TruncateInt()
+		if d.Rate.MulInt(amt).TruncateInt().LT(missing) {
+			amt = amt.AddRaw(1)
+		}
+		amt = sdk.MinInt(amt, avail)
+
+		res = res.Add(sdk.NewCoin(d.Denom, amt))
+		available = available.Sub(sdk.NewCoins(sdk.NewCoin(d.Denom, amt)))
+		missing = missing.Sub(d.Rate.MulInt(amt).TruncateInt())
+	}
+
+	if missing.IsPositive() {
+		return nil, false
+	}
+
+	return res, true
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBaseDenom, &p.BaseDenom, validateBaseDenom),
+		paramtypes.NewParamSetPair(KeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		paramtypes.NewParamSetPair(KeyFeeRecipient, &p.FeeRecipient, validateFeeRecipient),
+	}
+}
+
+func validateBaseDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("base denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, d := range v {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return err
+		}
+		if d.Rate.IsNil() || !d.Rate.IsPositive() {
+			return fmt.Errorf("exchange rate of fee denom %s must be positive: %s", d.Denom, d.Rate)
+		}
+		if seen[d.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", d.Denom)
+		}
+		seen[d.Denom] = true
+	}
+
+	return nil
+}
+
+func validateFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != strings.TrimSpace(v) {
+		return fmt.Errorf("invalid fee recipient module name: %q", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	usdc := types.NewFeeDenom("usdc", sdk.NewDecWithPrec(5, 1))
+
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"fee denoms", types.NewParams("stake", []types.FeeDenom{usdc, types.NewFeeDenom("atom", sdk.NewDec(10))}, ""), false},
+		{"fee recipient", types.NewParams("stake", []types.FeeDenom{usdc}, "distribution"), false},
+		{"blank base denom", types.NewParams("", []types.FeeDenom{usdc}, ""), true},
+		{"invalid fee denom", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("1usdc", sdk.OneDec())}, ""), true},
+		{"zero exchange rate", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("usdc", sdk.ZeroDec())}, ""), true},
+		{"negative exchange rate", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("usdc", sdk.NewDec(-1))}, ""), true},
+		{"duplicate fee denom", types.NewParams("stake", []types.FeeDenom{usdc, usdc}, ""), true},
+		{"base denom fee denom", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("stake", sdk.OneDec())}, ""), true},
+		{"invalid fee recipient", types.NewParams("stake", []types.FeeDenom{usdc}, "distribution "), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsAllowedFeeDenom(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsAllowedFeeDenom("stake"))
+	require.True(t, params.IsAllowedFeeDenom("usdc"))
+
+	params = types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("usdc", sdk.NewDecWithPrec(5, 1))}, "")
+	require.True(t, params.IsAllowedFeeDenom("stake"))
+	require.True(t, params.IsAllowedFeeDenom("usdc"))
+	require.False(t, params.IsAllowedFeeDenom("atom"))
+}
+
+func TestConvertFees(t *testing.T) {
+	params := types.NewParams("stake", []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDec(10)),
+		types.NewFeeDenom("usdc", sdk.NewDecWithPrec(5, 1)),
+	}, "")
+
+	testCases := []struct {
+		name     string
+		fees     sdk.Coins
+		expected sdk.Coins
+	}{
+		{"no fees", sdk.NewCoins(), sdk.NewCoins()},
+		{"base denom", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{
+			"fee denom",
+			sdk.NewCoins(sdk.NewInt64Coin("usdc", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("usdc", 100)),
+		},
+		{
+			"truncated conversion",
+			sdk.NewCoins(sdk.NewInt64Coin("usdc", 101)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("usdc", 101)),
+		},
+		{
+			"mixed denoms",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("photon", 7), sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("photon", 7), sdk.NewInt64Coin("stake", 80), sdk.NewInt64Coin("usdc", 100)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.ConvertFees(tc.fees))
+		})
+	}
+}
+
+func TestCoverFees(t *testing.T) {
+	params := types.NewParams("stake", []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDec(10)),
+		types.NewFeeDenom("usdc", sdk.NewDecWithPrec(3, 1)),
+	}, "")
+
+	testCases := []struct {
+		name     string
+		fees     sdk.Coins
+		required sdk.Coins
+		expected sdk.Coins
+		covered  bool
+	}{
+		{"no required fees", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewCoins(), sdk.NewCoins(), true},
+		{
+			"paid in the base denom first",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+			true,
+		},
+		{
+			"completed with the fee denoms in order",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 34)),
+			true,
+		},
+		{
+			"insufficient fees",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("usdc", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 41)),
+			nil,
+			false,
+		},
+		{
+			"fee denom spent on its own requirement first",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 120)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 1000)),
+			nil,
+			false,
+		},
+		{
+			"fee denom paying both requirements",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 150)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 150)),
+			true,
+		},
+		{
+			"fee denoms only pay for the base denom",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("photon", 1)),
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			covering, covered := params.CoverFees(tc.fees, tc.required)
+			require.Equal(t, tc.covered, covered)
+			require.Equal(t, tc.expected, covering)
+
+			// the covering fees are part of the fees, and worth the required fees
+			if tc.covered {
+				require.True(t, tc.fees.IsAllGTE(covering))
+				require.True(t, params.ConvertFees(covering).IsAllGTE(tc.required))
+			}
+		})
+	}
+}
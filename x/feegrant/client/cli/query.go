@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 	feegrantQueryCmd.AddCommand(
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrants(),
+		GetCmdQueryFeeGrantsByGranter(),
 	)
 
 	return feegrantQueryCmd
@@ -128,3 +129,52 @@ $ %s query feegrant grants [grantee]
 
 	return cmd
 }
+
+// GetCmdQueryFeeGrantsByGranter returns cmd to query for all grants by a granter.
+func GetCmdQueryFeeGrantsByGranter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-by-granter [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all grants by a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the grants issued by a granter address.
+
+Example:
+$ %s query feegrant grants-by-granter [granter]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowancesByGranter(
+				cmd.Context(),
+				&types.QueryAllowancesByGranterRequest{
+					Granter:    granterAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
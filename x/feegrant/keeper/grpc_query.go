@@ -10,6 +10,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
@@ -92,3 +93,40 @@ func (q Keeper) FeeAllowances(c context.Context, req *types.QueryFeeAllowancesRe
 
 	return &types.QueryFeeAllowancesResponse{FeeAllowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowancesByGranter returns all the grants given by an address.
+func (q Keeper) AllowancesByGranter(c context.Context, req *types.QueryAllowancesByGranterRequest) (*types.QueryAllowancesByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.FeeAllowanceGrant
+
+	store := ctx.KVStore(q.storeKey)
+	indexStore := prefix.NewStore(store, types.FeeAllowanceByGranterPrefix(granterAddr))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		granteeAddr := sdk.AccAddress(key[1:])
+
+		grant, found := q.GetFeeGrant(ctx, granterAddr, granteeAddr)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s missing", granterAddr, granteeAddr)
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
+}
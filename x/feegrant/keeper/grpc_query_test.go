@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
@@ -153,6 +154,94 @@ func (suite *KeeperTestSuite) TestFeeAllowances() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllowancesByGranter() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryAllowancesByGranterRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *types.QueryAllowancesByGranterResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			func() {},
+			func(*types.QueryAllowancesByGranterResponse) {},
+		},
+		{
+			"fail: invalid granter",
+			&types.QueryAllowancesByGranterRequest{
+				Granter: "invalid_granter",
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowancesByGranterResponse) {},
+		},
+		{
+			"no grants",
+			&types.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {},
+			func(resp *types.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+		{
+			"valid query: expect single grant",
+			&types.QueryAllowancesByGranterRequest{
+				Granter: suite.addrs[0].String(),
+			},
+			false,
+			func() {
+				grantFeeAllowance(suite)
+			},
+			func(resp *types.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(resp.Allowances[0].Granter, suite.addrs[0].String())
+				suite.Require().Equal(resp.Allowances[0].Grantee, suite.addrs[1].String())
+			},
+		},
+		{
+			"valid query: paginated grants",
+			&types.QueryAllowancesByGranterRequest{
+				Granter:    suite.addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			false,
+			func() {
+				err := k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], &types.BasicFeeAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+				})
+				suite.Require().NoError(err)
+			},
+			func(resp *types.QueryAllowancesByGranterResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(resp.Pagination.Total, uint64(2))
+				suite.Require().Equal(resp.Allowances[0].Granter, suite.addrs[0].String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := k.AllowancesByGranter(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func grantFeeAllowance(suite *KeeperTestSuite) {
 	err := suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &types.BasicFeeAllowance{
 		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
@@ -58,7 +58,19 @@ func (k Keeper) GrantFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddre
 		return err
 	}
 
+	// the previous grant, if any, may expire at another time
+	if prev, found := k.GetFeeGrant(ctx, granter, grantee); found {
+		if err := k.dequeueFeeAllowance(ctx, granter, grantee, prev); err != nil {
+			return err
+		}
+	}
+
 	store.Set(key, bz)
+	store.Set(types.FeeAllowanceByGranterKey(granter, grantee), []byte{})
+
+	if err := k.enqueueFeeAllowance(ctx, granter, grantee, feeAllowance); err != nil {
+		return err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -73,14 +85,14 @@ func (k Keeper) GrantFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddre
 
 // RevokeFeeAllowance removes an existing grant
 func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	store := ctx.KVStore(k.storeKey)
-	key := types.FeeAllowanceKey(granter, grantee)
-	_, found := k.GetFeeGrant(ctx, granter, grantee)
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
 	if !found {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "fee-grant not found")
 	}
 
-	store.Delete(key)
+	if err := k.removeFeeAllowance(ctx, granter, grantee, grant); err != nil {
+		return err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -196,3 +208,83 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	// if we accepted, store the updated state of the allowance
 	return k.GrantFeeAllowance(ctx, granter, grantee, grant)
 }
+
+// RemoveExpiredAllowances removes all the grants which are expired at the
+// current block time and height, using the expiration queues.
+func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context) {
+	// time expirations are reached strictly after the expiration time, while
+	// height expirations are reached at the expiration height
+	k.pruneQueue(ctx, types.FeeAllowanceTimeQueueKeyPrefix, types.FeeAllowanceByTimeKey(ctx.BlockTime()))
+	k.pruneQueue(ctx, types.FeeAllowanceHeightQueueKeyPrefix, types.FeeAllowanceByHeightKey(ctx.BlockHeight()+1))
+}
+
+// pruneQueue removes the grants of the queue entries between start and end.
+// Queue keys are made of the expiration, of the same length as end, followed
+// by the grantee and granter.
+func (k Keeper) pruneQueue(ctx sdk.Context, start, end []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	var expired [][]byte
+	iter := store.Iterator(start, end)
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		grantee, granter := types.ParseAddressesKey(key[len(end):])
+
+		store.Delete(key)
+		store.Delete(types.FeeAllowanceKey(granter, grantee))
+		store.Delete(types.FeeAllowanceByGranterKey(granter, grantee))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneFeeGrant,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			),
+		)
+	}
+}
+
+// removeFeeAllowance deletes a grant along with its index and queue entries.
+func (k Keeper) removeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, grant types.FeeAllowanceGrant) error {
+	if err := k.dequeueFeeAllowance(ctx, granter, grantee, grant); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeAllowanceKey(granter, grantee))
+	store.Delete(types.FeeAllowanceByGranterKey(granter, grantee))
+
+	return nil
+}
+
+// enqueueFeeAllowance inserts a grant in the time or height expiration queue,
+// depending on its expiration. Grants without expiration aren't queued.
+func (k Keeper) enqueueFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	key, err := types.FeeAllowanceQueueKey(granter, grantee, feeAllowance)
+	if err != nil || key == nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, []byte{})
+	return nil
+}
+
+// dequeueFeeAllowance removes a grant from its expiration queue.
+func (k Keeper) dequeueFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, grant types.FeeAllowanceGrant) error {
+	feeAllowance, err := grant.GetFeeGrant()
+	if err != nil {
+		return err
+	}
+
+	key, err := types.FeeAllowanceQueueKey(granter, grantee, feeAllowance)
+	if err != nil || key == nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(key)
+	return nil
+}
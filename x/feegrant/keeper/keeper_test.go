@@ -2,12 +2,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
@@ -256,3 +258,132 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRemoveExpiredAllowances() {
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	k := suite.app.FeeGrantKeeper
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	grants := []struct {
+		granter, grantee sdk.AccAddress
+		expiration       types.ExpiresAt
+	}{
+		{suite.addrs[0], suite.addrs[1], types.ExpiresAtHeight(11)},
+		{suite.addrs[0], suite.addrs[2], types.ExpiresAtTime(time.Unix(1005, 0).UTC())},
+		{suite.addrs[0], suite.addrs[3], types.ExpiresAt{}},
+		{suite.addrs[1], suite.addrs[2], types.ExpiresAtHeight(20)},
+	}
+
+	for _, g := range grants {
+		err := k.GrantFeeAllowance(ctx, g.granter, g.grantee, &types.BasicFeeAllowance{SpendLimit: atom, Expiration: g.expiration})
+		suite.Require().NoError(err)
+	}
+
+	// the periodic allowance expires through its basic allowance, and replaces
+	// the grant expiring at height 20
+	periodic := &types.PeriodicFeeAllowance{
+		Basic:            types.BasicFeeAllowance{SpendLimit: atom, Expiration: types.ExpiresAtHeight(12)},
+		Period:           types.BlockDuration(1),
+		PeriodSpendLimit: atom,
+	}
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], periodic))
+
+	granted := func(ctx sdk.Context) []string {
+		var res []string
+		for _, granter := range suite.addrs[:2] {
+			resp, err := k.AllowancesByGranter(sdk.WrapSDKContext(ctx), &types.QueryAllowancesByGranterRequest{Granter: granter.String()})
+			suite.Require().NoError(err)
+			for _, grant := range resp.Allowances {
+				res = append(res, grant.Grantee)
+			}
+		}
+		return res
+	}
+	suite.Require().Equal([]string{suite.addrs[1].String(), suite.addrs[2].String(), suite.addrs[3].String(), suite.addrs[2].String()}, granted(ctx))
+
+	// nothing expires at the current block
+	k.RemoveExpiredAllowances(ctx)
+	suite.Require().Len(granted(ctx), 4)
+
+	// the height expiration is reached at the expiration height
+	ctx = ctx.WithBlockHeight(11)
+	k.RemoveExpiredAllowances(ctx)
+	_, found := k.GetFeeGrant(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().False(found)
+	suite.Require().Len(granted(ctx), 3)
+
+	// the time expiration is reached after the expiration time
+	ctx = ctx.WithBlockTime(time.Unix(1005, 0).UTC())
+	k.RemoveExpiredAllowances(ctx)
+	suite.Require().Len(granted(ctx), 3)
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Unix(1006, 0).UTC())
+	k.RemoveExpiredAllowances(ctx)
+	suite.Require().Equal([]string{suite.addrs[3].String()}, granted(ctx))
+
+	// a grant without expiration is never removed, and revoked grants leave
+	// no queue entries behind
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(5000, 0).UTC())
+	k.RemoveExpiredAllowances(ctx)
+	suite.Require().Equal([]string{suite.addrs[3].String()}, granted(ctx))
+
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[3]))
+	suite.Require().Empty(granted(ctx))
+
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	suite.Require().False(iter.Valid())
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	k := suite.app.FeeGrantKeeper
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	grants := []struct {
+		granter    sdk.AccAddress
+		grantee    sdk.AccAddress
+		expiration types.ExpiresAt
+	}{
+		{suite.addrs[0], suite.addrs[1], types.ExpiresAtHeight(11)},
+		{suite.addrs[0], suite.addrs[2], types.ExpiresAtTime(time.Unix(1005, 0).UTC())},
+		{suite.addrs[1], suite.addrs[3], types.ExpiresAt{}},
+	}
+
+	for _, g := range grants {
+		err := k.GrantFeeAllowance(ctx, g.granter, g.grantee, &types.BasicFeeAllowance{SpendLimit: atom, Expiration: g.expiration})
+		suite.Require().NoError(err)
+	}
+
+	// v1 stores only have the grants: drop the index and the queues
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	iter := store.Iterator(types.FeeAllowanceByGranterKeyPrefix, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	suite.Require().Len(keys, 5)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	for _, g := range grants {
+		suite.Require().True(store.Has(types.FeeAllowanceByGranterKey(g.granter, g.grantee)))
+	}
+	suite.Require().True(store.Has(types.FeeAllowanceHeightQueueKey(11, suite.addrs[0], suite.addrs[1])))
+	suite.Require().True(store.Has(types.FeeAllowanceTimeQueueKey(time.Unix(1005, 0).UTC(), suite.addrs[0], suite.addrs[2])))
+
+	// the migrated grants expire through the queues
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1006, 0).UTC())
+	k.RemoveExpiredAllowances(ctx)
+	_, found := k.GetFeeGrant(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().False(found)
+	_, found = k.GetFeeGrant(ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().False(found)
+	_, found = k.GetFeeGrant(ctx, suite.addrs[1], suite.addrs[3])
+	suite.Require().True(found)
+}
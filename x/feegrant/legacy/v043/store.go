@@ -0,0 +1,66 @@
+package v043
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// readGrants returns all the grants of the store, which are read before the
+// store is written to.
+func readGrants(store sdk.KVStore, cdc codec.BinaryMarshaler) ([]types.FeeAllowanceGrant, error) {
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	var grants []types.FeeAllowanceGrant
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeAllowanceGrant
+		if err := cdc.UnmarshalBinaryBare(iter.Value(), &grant); err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+// MigrateStore performs in-place store migrations from v0.42 to v0.43. The
+// migration includes:
+//
+// - Indexing the grants by granter.
+// - Queueing the expiring grants by expiration time or height, to be pruned.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	grants, err := readGrants(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return err
+		}
+		feeAllowance, err := grant.GetFeeGrant()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.FeeAllowanceByGranterKey(granter, grantee), []byte{})
+
+		queueKey, err := types.FeeAllowanceQueueKey(granter, grantee, feeAllowance)
+		if err != nil {
+			return err
+		}
+		if queueKey != nil {
+			store.Set(queueKey, []byte{})
+		}
+	}
+
+	return nil
+}
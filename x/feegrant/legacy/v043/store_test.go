@@ -0,0 +1,67 @@
+package v043_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v043feegrant "github.com/cosmos/cosmos-sdk/x/feegrant/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	feegrantKey := sdk.NewKVStoreKey("feegrant")
+	ctx := testutil.DefaultContext(feegrantKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(feegrantKey)
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee1 := testdata.KeyTestPubAddr()
+	_, _, grantee2 := testdata.KeyTestPubAddr()
+	_, _, grantee3 := testdata.KeyTestPubAddr()
+	expTime := time.Unix(1005, 0).UTC()
+
+	grants := []struct {
+		grantee    sdk.AccAddress
+		expiration types.ExpiresAt
+		queueKey   []byte
+	}{
+		{grantee1, types.ExpiresAtHeight(11), types.FeeAllowanceHeightQueueKey(11, granter, grantee1)},
+		{grantee2, types.ExpiresAtTime(expTime), types.FeeAllowanceTimeQueueKey(expTime, granter, grantee2)},
+		{grantee3, types.ExpiresAt{}, nil},
+	}
+
+	// v0.42 stores only have the grants, by grantee
+	for _, g := range grants {
+		grant, err := types.NewFeeAllowanceGrant(granter, g.grantee, &types.BasicFeeAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+			Expiration: g.expiration,
+		})
+		require.NoError(t, err)
+		store.Set(types.FeeAllowanceKey(granter, g.grantee), encCfg.Marshaler.MustMarshalBinaryBare(&grant))
+	}
+
+	// Run migration.
+	err := v043feegrant.MigrateStore(ctx, feegrantKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	// The grants are indexed by granter, and queued by expiration.
+	for _, g := range grants {
+		require.True(t, store.Has(types.FeeAllowanceByGranterKey(granter, g.grantee)))
+		if g.queueKey != nil {
+			require.True(t, store.Has(g.queueKey))
+		}
+	}
+	iter := store.Iterator(types.FeeAllowanceTimeQueueKeyPrefix, sdk.PrefixEndBytes(types.FeeAllowanceHeightQueueKeyPrefix))
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	require.Equal(t, 2, count, "the grant without expiration isn't queued")
+}
@@ -19,6 +19,11 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], types.FeeAllowanceByGranterKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.FeeAllowanceTimeQueueKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.FeeAllowanceHeightQueueKeyPrefix):
+			// index and queue entries store their data in the key
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
@@ -37,6 +37,7 @@ func TestDecodeStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(types.FeeAllowanceKeyPrefix), Value: grantBz},
+			{Key: types.FeeAllowanceByGranterKey(granterAddr, granteeAddr), Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -46,6 +47,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GranterIndex", fmt.Sprintf("%X\n%X", types.FeeAllowanceByGranterKey(granterAddr, granteeAddr), types.FeeAllowanceByGranterKey(granterAddr, granteeAddr))},
 		{"other", ""},
 	}
 
@@ -50,6 +50,11 @@ func (a *BasicFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight int6
 	}
 }
 
+// ExpiresAt returns the expiration of the allowance.
+func (a *BasicFeeAllowance) ExpiresAt() (ExpiresAt, error) {
+	return a.Expiration, nil
+}
+
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
 func (a BasicFeeAllowance) ValidateBasic() error {
 	if a.SpendLimit != nil {
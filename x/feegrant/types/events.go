@@ -5,6 +5,7 @@ const (
 	EventTypeUseFeeGrant    = "use_feegrant"
 	EventTypeRevokeFeeGrant = "revoke_feegrant"
 	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypePruneFeeGrant  = "prune_feegrant"
 
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
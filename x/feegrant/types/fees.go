@@ -26,6 +26,10 @@ type FeeAllowanceI interface {
 	// given the time and height of the actual dump (may safely return self if no changes needed)
 	PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowanceI
 
+	// ExpiresAt returns the point at which the FeeAllowance expires, used to
+	// prune expired grants from the store. A zero ExpiresAt never expires.
+	ExpiresAt() (ExpiresAt, error)
+
 	// ValidateBasic should evaluate this FeeAllowance for internal consistency.
 	// Don't allow negative amounts, or negative periods for example.
 	ValidateBasic() error
@@ -106,6 +106,16 @@ func (a *AllowedMsgFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight
 	return f
 }
 
+// ExpiresAt returns the expiration of the wrapped allowance.
+func (a *AllowedMsgFeeAllowance) ExpiresAt() (ExpiresAt, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return ExpiresAt{}, err
+	}
+
+	return allowance.ExpiresAt()
+}
+
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
 func (a *AllowedMsgFeeAllowance) ValidateBasic() error {
 	if a.Allowance == nil {
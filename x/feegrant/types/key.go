@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -22,6 +25,18 @@ const (
 var (
 	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
 	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// FeeAllowanceByGranterKeyPrefix is the prefix of the index of the grants
+	// by granter
+	FeeAllowanceByGranterKeyPrefix = []byte{0x01}
+
+	// FeeAllowanceTimeQueueKeyPrefix is the prefix of the queue of the grants
+	// expiring at a given time
+	FeeAllowanceTimeQueueKeyPrefix = []byte{0x02}
+
+	// FeeAllowanceHeightQueueKeyPrefix is the prefix of the queue of the grants
+	// expiring at a given height
+	FeeAllowanceHeightQueueKeyPrefix = []byte{0x03}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -34,3 +49,73 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// FeeAllowanceByGranterKey is the key of a grant from granter to grantee in the
+// index of the grants by granter
+func FeeAllowanceByGranterKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceByGranterPrefix(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// FeeAllowanceByGranterPrefix returns a prefix to scan the index for all grants
+// from this given address.
+func FeeAllowanceByGranterPrefix(granter sdk.AccAddress) []byte {
+	return append(FeeAllowanceByGranterKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// FeeAllowanceByTimeKey returns the prefix of the time queue up to the given
+// expiration time
+func FeeAllowanceByTimeKey(expiration time.Time) []byte {
+	return append(FeeAllowanceTimeQueueKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// FeeAllowanceTimeQueueKey returns the key of a grant from granter to grantee in
+// the time queue
+func FeeAllowanceTimeQueueKey(expiration time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceByTimeKey(expiration), FeeAllowanceKey(granter, grantee)[len(FeeAllowanceKeyPrefix):]...)
+}
+
+// FeeAllowanceByHeightKey returns the prefix of the height queue up to the
+// given expiration height
+func FeeAllowanceByHeightKey(expiration int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expiration))
+	return append(FeeAllowanceHeightQueueKeyPrefix, bz...)
+}
+
+// FeeAllowanceHeightQueueKey returns the key of a grant from granter to grantee
+// in the height queue
+func FeeAllowanceHeightQueueKey(expiration int64, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceByHeightKey(expiration), FeeAllowanceKey(granter, grantee)[len(FeeAllowanceKeyPrefix):]...)
+}
+
+// FeeAllowanceQueueKey returns the key of a grant from granter to grantee in
+// the expiration queue matching its expiration, or nil if it never expires
+func FeeAllowanceQueueKey(granter sdk.AccAddress, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) ([]byte, error) {
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case exp.HasDefinedTime():
+		return FeeAllowanceTimeQueueKey(*exp.GetTime(), granter, grantee), nil
+	case exp.GetHeight() != 0:
+		return FeeAllowanceHeightQueueKey(exp.GetHeight(), granter, grantee), nil
+	default:
+		return nil, nil
+	}
+}
+
+// ParseAddressesKey parses two length-prefixed addresses, as found at the end
+// of the grant, granter index and queue keys, once their prefix is removed.
+// The addresses are returned in the order they are stored.
+func ParseAddressesKey(key []byte) (sdk.AccAddress, sdk.AccAddress) {
+	firstLen := int(key[0])
+	first := sdk.AccAddress(key[1 : 1+firstLen])
+
+	key = key[1+firstLen:]
+	secondLen := int(key[0])
+	second := sdk.AccAddress(key[1 : 1+secondLen])
+
+	return first, second
+}
@@ -90,6 +90,11 @@ func (a *PeriodicFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight i
 	}
 }
 
+// ExpiresAt returns the expiration of the allowance.
+func (a *PeriodicFeeAllowance) ExpiresAt() (ExpiresAt, error) {
+	return a.Basic.Expiration, nil
+}
+
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
 func (a PeriodicFeeAllowance) ValidateBasic() error {
 	if err := a.Basic.ValidateBasic(); err != nil {
@@ -0,0 +1,43 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker records the gas used by the block, and adjusts the base fee of
+// the next block toward the target block utilization.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumedToLimit()
+	}
+
+	var maxGas int64
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		maxGas = cp.Block.MaxGas
+	}
+
+	baseFee := params.NextBaseFee(k.GetBaseFeeAmount(ctx), gasUsed, maxGas)
+	k.SetBaseFeeAmount(ctx, baseFee)
+	k.SetBlockGas(ctx, gasUsed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGas, sdk.NewIntFromUint64(gasUsed).String()),
+		),
+	)
+}
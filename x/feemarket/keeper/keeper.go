@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryMarshaler
+	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the feemarket module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFeeAmount returns the stored base fee amount, in the base fee denom.
+func (k Keeper) GetBaseFeeAmount(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+	return dp.Dec
+}
+
+// SetBaseFeeAmount sets the base fee amount, in the base fee denom.
+func (k Keeper) SetBaseFeeAmount(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: baseFee})
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// GetBaseFee returns the gas price transactions must pay, which is empty if
+// the base fee isn't enabled, or zero.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.DecCoins {
+	var enabled bool
+	k.paramSpace.GetIfExists(ctx, types.KeyEnabled, &enabled)
+	if !enabled {
+		return nil
+	}
+
+	var denom string
+	k.paramSpace.Get(ctx, types.KeyBaseFeeDenom, &denom)
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, k.GetBaseFeeAmount(ctx)))
+}
+
+// GetBlockGas returns the gas used by the last block.
+func (k Keeper) GetBlockGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockGasKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetBlockGas sets the gas used by the last block.
+func (k Keeper) SetBlockGas(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockGasKey, sdk.Uint64ToBigEndian(gas))
+}
+
+// CollectBaseFee moves the base fee part of the fees of a transaction out of
+// the fee collector, burning it or sending it to the fee recipient module.
+func (k Keeper) CollectBaseFee(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	var recipient string
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeRecipient, &recipient)
+	if recipient != "" {
+		if k.authKeeper.GetModuleAccount(ctx, recipient) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee recipient module account %s does not exist", recipient)
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, recipient, fees)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+}
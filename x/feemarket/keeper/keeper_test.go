@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = queryClient
+}
+
+func (suite *KeeperTestSuite) TestBaseFee() {
+	app, ctx := suite.app, suite.ctx
+
+	// the base fee is disabled by default
+	app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.NewDecWithPrec(25, 3))
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 3), app.FeeMarketKeeper.GetBaseFeeAmount(ctx))
+	suite.Require().True(app.FeeMarketKeeper.GetBaseFee(ctx).IsZero())
+
+	params := types.NewParams(true, "stake", sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(5, 1), 8, "")
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, app.FeeMarketKeeper.GetParams(ctx))
+
+	baseFee := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+	suite.Require().Equal(baseFee, app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+
+	baseFeeRes, err := suite.queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(baseFee, baseFeeRes.BaseFee)
+}
+
+func (suite *KeeperTestSuite) TestEndBlocker() {
+	app := suite.app
+	ctx := suite.ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 1000}})
+
+	params := types.NewParams(true, "stake", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), 8, "")
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.OneDec())
+
+	// a full block increases the base fee by 1/8
+	gasMeter := sdk.NewGasMeter(1000)
+	gasMeter.ConsumeGas(1000, "block")
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(gasMeter), app.FeeMarketKeeper)
+	suite.Require().Equal(sdk.NewDecWithPrec(1125, 3), app.FeeMarketKeeper.GetBaseFeeAmount(ctx))
+	suite.Require().Equal(uint64(1000), app.FeeMarketKeeper.GetBlockGas(ctx))
+
+	res, err := suite.queryClient.BlockGas(gocontext.Background(), &types.QueryBlockGasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1000), res.Gas)
+
+	// an empty block decreases it, down to the min base fee
+	for i := 0; i < 50; i++ {
+		feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewGasMeter(1000)), app.FeeMarketKeeper)
+	}
+	suite.Require().Equal(params.MinBaseFee, app.FeeMarketKeeper.GetBaseFeeAmount(ctx))
+	suite.Require().Equal(uint64(0), app.FeeMarketKeeper.GetBlockGas(ctx))
+
+	// the base fee isn't adjusted when it's disabled
+	params.Enabled = false
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(gasMeter), app.FeeMarketKeeper)
+	suite.Require().Equal(params.MinBaseFee, app.FeeMarketKeeper.GetBaseFeeAmount(ctx))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,9 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeFeeMarket = "fee_market"
+
+	AttributeKeyBaseFee  = "base_fee"
+	AttributeKeyBlockGas = "block_gas"
+)
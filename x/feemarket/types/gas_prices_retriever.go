@@ -0,0 +1,26 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ client.GasPricesRetriever = GasPricesRetriever{}
+
+// GasPricesRetriever defines the properties of a type that can be used to
+// retrieve the base fee as the gas prices of transactions.
+type GasPricesRetriever struct{}
+
+// GetGasPrices queries the base fee of the fee market, which is empty if the
+// base fee isn't enabled. An error is returned if the query fails.
+func (GasPricesRetriever) GetGasPrices(clientCtx client.Context) (sdk.DecCoins, error) {
+	queryClient := NewQueryClient(clientCtx)
+	res, err := queryClient.BaseFee(context.Background(), &QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.BaseFee, nil
+}
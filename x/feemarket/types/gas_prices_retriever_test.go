@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestGasPricesRetriever(t *testing.T) {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	baseFee := sdk.NewDecWithPrec(1, 2)
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MinBaseFee = baseFee
+	cfg.GenesisState[types.ModuleName] = cfg.Codec.MustMarshalJSON(types.NewGenesisState(params, baseFee, 0))
+
+	network := network.New(t, cfg)
+	defer network.Cleanup()
+
+	_, err := network.WaitForHeight(1)
+	require.NoError(t, err)
+
+	gasPrices, err := types.GasPricesRetriever{}.GetGasPrices(network.Validators[0].ClientCtx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(cfg.BondDenom, baseFee)), gasPrices)
+}
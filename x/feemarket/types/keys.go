@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feemarket"
+
+	// StoreKey is the store key string for feemarket
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for feemarket
+	QuerierRoute = ModuleName
+)
+
+// Keys for feemarket store
+var (
+	BaseFeeKey  = []byte{0x01}
+	BlockGasKey = []byte{0x02}
+)
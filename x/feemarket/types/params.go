@@ -0,0 +1,198 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+var (
+	DefaultTargetBlockUtilization   = sdk.NewDecWithPrec(5, 1)
+	DefaultBaseFeeChangeDenominator = uint32(8)
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                  = []byte("Enabled")
+	KeyBaseFeeDenom             = []byte("BaseFeeDenom")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyTargetBlockUtilization   = []byte("TargetBlockUtilization")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyFeeRecipient             = []byte("FeeRecipient")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	enabled bool, baseFeeDenom string, minBaseFee, targetBlockUtilization sdk.Dec,
+	baseFeeChangeDenominator uint32, feeRecipient string,
+) Params {
+	return Params{
+		Enabled:                  enabled,
+		BaseFeeDenom:             baseFeeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockUtilization:   targetBlockUtilization,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		FeeRecipient:             feeRecipient,
+	}
+}
+
+// DefaultParams returns the default feemarket module parameters, with the base
+// fee disabled.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		BaseFeeDenom:             sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.ZeroDec(),
+		TargetBlockUtilization:   DefaultTargetBlockUtilization,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		FeeRecipient:             "",
+	}
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateBaseFeeDenom(p.BaseFeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateTargetBlockUtilization(p.TargetBlockUtilization); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+
+	return validateFeeRecipient(p.FeeRecipient)
+}
+
+// NextBaseFee returns the base fee of the next block, given the base fee and
+// gas used of the current block, and the max block gas. As in EIP-1559, the
+// base fee moves toward the target block utilization, by at most
+// 1/BaseFeeChangeDenominator of its value at the target utilization, and never
+// goes below MinBaseFee. It is left unchanged if the block gas isn't limited.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed uint64, maxGas int64) sdk.Dec {
+	if maxGas > 0 {
+		target := p.TargetBlockUtilization.MulInt64(maxGas)
+		gasUsedDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+		delta := baseFee.Mul(gasUsedDec.Sub(target)).Quo(target).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		baseFee = baseFee.Add(delta)
+	}
+
+	if baseFee.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+
+	return baseFee
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyBaseFeeDenom, &p.BaseFeeDenom, validateBaseFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetBlockUtilization, &p.TargetBlockUtilization, validateTargetBlockUtilization),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyFeeRecipient, &p.FeeRecipient, validateFeeRecipient),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("base fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockUtilization(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("target block utilization must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("target block utilization too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != strings.TrimSpace(v) {
+		return fmt.Errorf("invalid fee recipient module name: %q", v)
+	}
+
+	return nil
+}
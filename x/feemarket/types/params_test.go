@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"enabled", types.NewParams(true, "stake", sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(5, 1), 8, ""), false},
+		{"fee recipient", types.NewParams(true, "stake", sdk.ZeroDec(), sdk.OneDec(), 8, "distribution"), false},
+		{"blank base fee denom", types.NewParams(true, "", sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), 8, ""), true},
+		{"invalid base fee denom", types.NewParams(true, "1stake", sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), 8, ""), true},
+		{"negative min base fee", types.NewParams(true, "stake", sdk.NewDec(-1), sdk.NewDecWithPrec(5, 1), 8, ""), true},
+		{"zero target block utilization", types.NewParams(true, "stake", sdk.ZeroDec(), sdk.ZeroDec(), 8, ""), true},
+		{"target block utilization above one", types.NewParams(true, "stake", sdk.ZeroDec(), sdk.NewDecWithPrec(11, 1), 8, ""), true},
+		{"zero base fee change denominator", types.NewParams(true, "stake", sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), 0, ""), true},
+		{"invalid fee recipient", types.NewParams(true, "stake", sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), 8, " distribution"), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, "stake", sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), 8, "")
+	minParams := types.NewParams(true, "stake", sdk.NewDecWithPrec(9, 1), sdk.NewDecWithPrec(5, 1), 8, "")
+
+	testCases := []struct {
+		name       string
+		params     types.Params
+		baseFee    sdk.Dec
+		gasUsed    uint64
+		maxGas     int64
+		expBaseFee sdk.Dec
+	}{
+		{"target utilization", params, sdk.OneDec(), 500, 1000, sdk.OneDec()},
+		{"full block", params, sdk.OneDec(), 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block", params, sdk.OneDec(), 0, 1000, sdk.NewDecWithPrec(875, 3)},
+		{"above target utilization", params, sdk.NewDec(2), 750, 1000, sdk.NewDecWithPrec(2125, 3)},
+		{"below min base fee", minParams, sdk.OneDec(), 0, 1000, sdk.NewDecWithPrec(9, 1)},
+		{"unlimited block gas", params, sdk.OneDec(), 1000, -1, sdk.OneDec()},
+		{"unlimited block gas below min base fee", minParams, sdk.ZeroDec(), 1000, -1, sdk.NewDecWithPrec(9, 1)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expBaseFee, tc.params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxGas))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the global minimum gas prices, which are stored as parameters and
+// updated through governance parameter change proposals.
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new globalfee Keeper instance
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+	}
+}
+
+// GetParams returns the total set of globalfee parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of globalfee parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMinimumGasPrices returns the global minimum gas prices, which are empty
+// until the module is initialized.
+func (k Keeper) GetMinimumGasPrices(ctx sdk.Context) (res sdk.DecCoins) {
+	k.paramSpace.GetIfExists(ctx, types.KeyMinimumGasPrices, &res)
+	return res
+}
+
+// ConvertFees returns the given fees, with the amounts of the denoms with a
+// fee denom ratio converted and added to their base denoms.
+func (k Keeper) ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	var params types.Params
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeDenomRatios, &params.FeeDenomRatios)
+	return params.ConvertFees(fees)
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/keeper"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GlobalFeeKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = queryClient
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().True(app.GlobalFeeKeeper.GetMinimumGasPrices(ctx).IsZero())
+
+	params := types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3))),
+		[]types.FeeDenomRatio{types.NewFeeDenomRatio("atom", "stake", sdk.NewDecWithPrec(5, 1))},
+	)
+	app.GlobalFeeKeeper.SetParams(ctx, params)
+
+	suite.Require().Equal(params, app.GlobalFeeKeeper.GetParams(ctx))
+	suite.Require().Equal(params.MinimumGasPrices, app.GlobalFeeKeeper.GetMinimumGasPrices(ctx))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 101), sdk.NewInt64Coin("stake", 10))
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 101), sdk.NewInt64Coin("stake", 60)),
+		app.GlobalFeeKeeper.ConvertFees(ctx, fees),
+	)
+
+	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestUninitializedParams() {
+	// before the module is initialized, e.g. in the upgrade adding it, there
+	// are no global minimum gas prices
+	k := keeper.NewKeeper(suite.app.ParamsKeeper.Subspace("uninitialized"))
+	suite.Require().True(k.GetMinimumGasPrices(suite.ctx).IsZero())
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	suite.Require().Equal(fees, k.ConvertFees(suite.ctx, fees))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/globalfee/v1beta1/globalfee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the globalfee module.
+type Params struct {
+	// minimum_gas_prices are the minimum gas prices a transaction must pay in
+	// one of the denoms, both in CheckTx and DeliverTx.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices" yaml:"minimum_gas_prices"`
+	// fee_denom_ratios allow to pay fees in denoms without minimum gas price,
+	// which are converted to one of the denoms of the minimum gas prices.
+	FeeDenomRatios []FeeDenomRatio `protobuf:"bytes,2,rep,name=fee_denom_ratios,json=feeDenomRatios,proto3" json:"fee_denom_ratios" yaml:"fee_denom_ratios"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46675bc9ef474d19, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenomRatios() []FeeDenomRatio {
+	if m != nil {
+		return m.FeeDenomRatios
+	}
+	return nil
+}
+
+// FeeDenomRatio defines the conversion of a fee denom to a denom of the
+// minimum gas prices.
+type FeeDenomRatio struct {
+	// denom is the fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// base_denom is the denom of the minimum gas prices the fees are converted to.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// ratio is the amount of base_denom a unit of denom is worth.
+	Ratio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
+}
+
+func (m *FeeDenomRatio) Reset()         { *m = FeeDenomRatio{} }
+func (m *FeeDenomRatio) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRatio) ProtoMessage()    {}
+func (*FeeDenomRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46675bc9ef474d19, []int{1}
+}
+func (m *FeeDenomRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRatio.Merge(m, src)
+}
+func (m *FeeDenomRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomRatio proto.InternalMessageInfo
+
+func (m *FeeDenomRatio) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomRatio) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.globalfee.v1beta1.Params")
+	proto.RegisterType((*FeeDenomRatio)(nil), "cosmos.globalfee.v1beta1.FeeDenomRatio")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/globalfee/v1beta1/globalfee.proto", fileDescriptor_46675bc9ef474d19)
+}
+
+var fileDescriptor_46675bc9ef474d19 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x6e, 0xda, 0x40,
+	0x1c, 0xc6, 0x7d, 0x50, 0x90, 0xb8, 0xaa, 0x15, 0xb5, 0xa8, 0xea, 0xa2, 0xca, 0x46, 0x1e, 0x5a,
+	0xa4, 0xaa, 0x67, 0xd1, 0x76, 0x62, 0x74, 0x51, 0x93, 0x11, 0x79, 0xcc, 0x62, 0x9d, 0xcd, 0xe1,
+	0x58, 0xe1, 0x7c, 0xc8, 0x67, 0xa2, 0xf0, 0x16, 0x19, 0x33, 0x64, 0x08, 0x6b, 0x9e, 0x84, 0x91,
+	0x31, 0xca, 0xe0, 0x44, 0xf0, 0x06, 0x3c, 0x41, 0x74, 0x77, 0x46, 0x26, 0x89, 0x12, 0x65, 0xb2,
+	0xef, 0xfe, 0xdf, 0xf7, 0xfb, 0x3e, 0xfd, 0x75, 0xb0, 0x1b, 0x32, 0x4e, 0x19, 0x77, 0xa2, 0x09,
+	0x0b, 0xf0, 0x64, 0x4c, 0x88, 0x73, 0xda, 0x0b, 0x48, 0x86, 0x7b, 0xe5, 0x0d, 0x9a, 0xa6, 0x2c,
+	0x63, 0xba, 0xa1, 0x94, 0xa8, 0xbc, 0x2f, 0x94, 0xed, 0x56, 0xc4, 0x22, 0x26, 0x45, 0x8e, 0xf8,
+	0x53, 0xfa, 0xb6, 0x59, 0x90, 0x03, 0xcc, 0x4b, 0x68, 0xc8, 0xe2, 0x44, 0xcd, 0xed, 0x45, 0x05,
+	0xd6, 0x87, 0x38, 0xc5, 0x94, 0xeb, 0x97, 0x00, 0xea, 0x34, 0x4e, 0x62, 0x3a, 0xa3, 0x7e, 0x84,
+	0xb9, 0x3f, 0x4d, 0xe3, 0x90, 0x70, 0x03, 0x74, 0xaa, 0xdd, 0xf7, 0xbf, 0xbf, 0xa1, 0x22, 0x58,
+	0x80, 0x76, 0x99, 0x68, 0x40, 0xc2, 0x7f, 0x2c, 0x4e, 0xdc, 0xe1, 0x32, 0xb7, 0xb4, 0x6d, 0x6e,
+	0x7d, 0x9d, 0x63, 0x3a, 0xe9, 0xdb, 0xcf, 0x29, 0xf6, 0xf5, 0x9d, 0xf5, 0x33, 0x8a, 0xb3, 0xe3,
+	0x59, 0x80, 0x42, 0x46, 0x9d, 0xa2, 0x95, 0xfa, 0xfc, 0xe2, 0xa3, 0x13, 0x27, 0x9b, 0x4f, 0x09,
+	0xdf, 0x01, 0xb9, 0xd7, 0x2c, 0x18, 0x07, 0x98, 0x0f, 0x25, 0x41, 0x4f, 0x61, 0x73, 0x4c, 0x88,
+	0x3f, 0x22, 0x09, 0xa3, 0x7e, 0x8a, 0xb3, 0x98, 0x71, 0xa3, 0x22, 0xbb, 0xfd, 0x40, 0x2f, 0x2d,
+	0x05, 0xfd, 0x27, 0x64, 0x20, 0x0c, 0x9e, 0xd0, 0xbb, 0x56, 0x51, 0xf3, 0x8b, 0xaa, 0xf9, 0x14,
+	0x67, 0x7b, 0x1f, 0xc7, 0xfb, 0x7a, 0xde, 0x7f, 0x77, 0x71, 0x65, 0x69, 0xf6, 0x02, 0xc0, 0x0f,
+	0x8f, 0x40, 0x7a, 0x0b, 0xd6, 0xa4, 0xd1, 0x00, 0x1d, 0xd0, 0x6d, 0x78, 0xea, 0xa0, 0xff, 0x85,
+	0x50, 0x6c, 0x47, 0x31, 0x8d, 0x8a, 0x18, 0xb9, 0x9f, 0xb7, 0xb9, 0xf5, 0x49, 0xc5, 0x95, 0x33,
+	0xdb, 0x6b, 0x88, 0x83, 0x04, 0xea, 0x03, 0x58, 0x93, 0xf1, 0x46, 0x55, 0x1a, 0x90, 0xe8, 0x78,
+	0x9b, 0x5b, 0xdf, 0xdf, 0xb6, 0x2d, 0x4f, 0x99, 0xdd, 0xc3, 0xe5, 0xda, 0x04, 0xab, 0xb5, 0x09,
+	0xee, 0xd7, 0x26, 0x38, 0xdf, 0x98, 0xda, 0x6a, 0x63, 0x6a, 0x37, 0x1b, 0x53, 0x3b, 0x42, 0xaf,
+	0x82, 0xce, 0xf6, 0xde, 0x9c, 0x84, 0x06, 0x75, 0xf9, 0x30, 0xfe, 0x3c, 0x0c, 0x00, 0xc5, 0x5f,
+	0xf0, 0x6b, 0x94, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDenomRatios) > 0 {
+		for iNdEx := len(m.FeeDenomRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintGlobalfee(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGlobalfee(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGlobalfee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGlobalfee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGlobalfee(uint64(l))
+		}
+	}
+	if len(m.FeeDenomRatios) > 0 {
+		for _, e := range m.FeeDenomRatios {
+			l = e.Size()
+			n += 1 + l + sovGlobalfee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenomRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGlobalfee(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovGlobalfee(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovGlobalfee(uint64(l))
+	return n
+}
+
+func sovGlobalfee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGlobalfee(x uint64) (n int) {
+	return sovGlobalfee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRatios = append(m.FeeDenomRatios, FeeDenomRatio{})
+			if err := m.FeeDenomRatios[len(m.FeeDenomRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGlobalfee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGlobalfee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGlobalfee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGlobalfee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGlobalfee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGlobalfee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGlobalfee = fmt.Errorf("proto: unexpected end of group")
+)
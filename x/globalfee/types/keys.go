@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "globalfee"
+
+	// QuerierRoute is the querier route for globalfee
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyMinimumGasPrices = []byte("MinimumGasPrices")
+	KeyFeeDenomRatios   = []byte("FeeDenomRatios")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for globalfee module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(minimumGasPrices sdk.DecCoins, feeDenomRatios []FeeDenomRatio) Params {
+	return Params{
+		MinimumGasPrices: minimumGasPrices,
+		FeeDenomRatios:   feeDenomRatios,
+	}
+}
+
+// DefaultParams returns the default globalfee module parameters, without any
+// minimum gas price.
+func DefaultParams() Params {
+	return Params{
+		MinimumGasPrices: sdk.DecCoins{},
+		FeeDenomRatios:   []FeeDenomRatio{},
+	}
+}
+
+// NewFeeDenomRatio creates a new FeeDenomRatio object
+func NewFeeDenomRatio(denom, baseDenom string, ratio sdk.Dec) FeeDenomRatio {
+	return FeeDenomRatio{
+		Denom:     denom,
+		BaseDenom: baseDenom,
+		Ratio:     ratio,
+	}
+}
+
+// Validate validates the params, and checks that the fee denom ratios convert
+// to denoms of the minimum gas prices.
+func (p Params) Validate() error {
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+	if err := validateFeeDenomRatios(p.FeeDenomRatios); err != nil {
+		return err
+	}
+
+	for _, r := range p.FeeDenomRatios {
+		if p.MinimumGasPrices.AmountOf(r.BaseDenom).IsZero() {
+			return fmt.Errorf("fee denom ratio base denom %s has no minimum gas price", r.BaseDenom)
+		}
+		if !p.MinimumGasPrices.AmountOf(r.Denom).IsZero() {
+			return fmt.Errorf("fee denom ratio denom %s has a minimum gas price", r.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ConvertFees returns the given fees, with the amounts of the denoms with a
+// fee denom ratio converted and added to their base denoms.
+func (p Params) ConvertFees(fees sdk.Coins) sdk.Coins {
+	res := fees
+	for _, r := range p.FeeDenomRatios {
+		amt := fees.AmountOf(r.Denom)
+		if amt.IsPositive() {
+			res = res.Add(sdk.NewCoin(r.BaseDenom, r.Ratio.MulInt(amt).TruncateInt()))
+		}
+	}
+
+	return res
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinimumGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices),
+		paramtypes.NewParamSetPair(KeyFeeDenomRatios, &p.FeeDenomRatios, validateFeeDenomRatios),
+	}
+}
+
+func validateMinimumGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %w", err)
+	}
+
+	return nil
+}
+
+func validateFeeDenomRatios(i interface{}) error {
+	v, ok := i.([]FeeDenomRatio)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, r := range v {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(r.BaseDenom); err != nil {
+			return err
+		}
+		if r.Denom == r.BaseDenom {
+			return fmt.Errorf("fee denom ratio of %s to itself", r.Denom)
+		}
+		if r.Ratio.IsNil() || !r.Ratio.IsPositive() {
+			return fmt.Errorf("fee denom ratio of %s must be positive: %s", r.Denom, r.Ratio)
+		}
+		if seen[r.Denom] {
+			return fmt.Errorf("duplicate fee denom ratio of %s", r.Denom)
+		}
+		seen[r.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/globalfee/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	stakePrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"minimum gas prices", types.NewParams(stakePrice, nil), false},
+		{
+			"unsorted minimum gas prices",
+			types.NewParams(sdk.DecCoins{
+				sdk.NewDecCoinFromDec("stake", sdk.OneDec()),
+				sdk.NewDecCoinFromDec("atom", sdk.OneDec()),
+			}, nil),
+			true,
+		},
+		{
+			"zero minimum gas price",
+			types.NewParams(sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.ZeroDec())}, nil),
+			true,
+		},
+		{
+			"fee denom ratio",
+			types.NewParams(stakePrice, []types.FeeDenomRatio{types.NewFeeDenomRatio("atom", "stake", sdk.NewDecWithPrec(5, 1))}),
+			false,
+		},
+		{
+			"fee denom ratio without minimum gas price",
+			types.NewParams(stakePrice, []types.FeeDenomRatio{types.NewFeeDenomRatio("atom", "photon", sdk.OneDec())}),
+			true,
+		},
+		{
+			"fee denom ratio of a denom with a minimum gas price",
+			types.NewParams(
+				stakePrice.Add(sdk.NewDecCoinFromDec("atom", sdk.OneDec())),
+				[]types.FeeDenomRatio{types.NewFeeDenomRatio("atom", "stake", sdk.OneDec())},
+			),
+			true,
+		},
+		{
+			"fee denom ratio to itself",
+			types.NewParams(stakePrice, []types.FeeDenomRatio{types.NewFeeDenomRatio("stake", "stake", sdk.OneDec())}),
+			true,
+		},
+		{
+			"non-positive fee denom ratio",
+			types.NewParams(stakePrice, []types.FeeDenomRatio{types.NewFeeDenomRatio("atom", "stake", sdk.ZeroDec())}),
+			true,
+		},
+		{
+			"duplicate fee denom ratio",
+			types.NewParams(stakePrice, []types.FeeDenomRatio{
+				types.NewFeeDenomRatio("atom", "stake", sdk.OneDec()),
+				types.NewFeeDenomRatio("atom", "stake", sdk.NewDec(2)),
+			}),
+			true,
+		},
+		{
+			"invalid fee denom",
+			types.NewParams(stakePrice, []types.FeeDenomRatio{types.NewFeeDenomRatio("0atom", "stake", sdk.OneDec())}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsConvertFees(t *testing.T) {
+	params := types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3))),
+		[]types.FeeDenomRatio{
+			types.NewFeeDenomRatio("atom", "stake", sdk.NewDecWithPrec(5, 1)),
+			types.NewFeeDenomRatio("photon", "stake", sdk.NewDec(3)),
+		},
+	)
+
+	testCases := []struct {
+		name     string
+		fees     sdk.Coins
+		expected sdk.Coins
+	}{
+		{"no fees", sdk.Coins{}, sdk.Coins{}},
+		{"base denom", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{
+			"truncated conversion",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 11)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 11), sdk.NewInt64Coin("stake", 5)),
+		},
+		{
+			"conversions added to the base denom",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("photon", 2), sdk.NewInt64Coin("stake", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("photon", 2), sdk.NewInt64Coin("stake", 12)),
+		},
+		{
+			"denom without ratio",
+			sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+			sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.ConvertFees(tc.fees))
+		})
+	}
+}
@@ -36,7 +36,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.ProposalId,
 			"title", proposal.GetTitle(),
-			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
+			"min_deposit", keeper.GetProposalParams(ctx, proposal).MinDeposit.String(),
 			"total_deposit", proposal.TotalDeposit.String(),
 		)
 
@@ -56,15 +56,25 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+			var (
+				results [][]byte
+				err     error
+			)
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			// The proposal handler, or the proposal messages, may execute state
+			// mutating logic. The messages are executed atomically: if any of
+			// them fails, no state mutation is written and the error message is
+			// logged.
+			if proposal.IsMsgsProposal() {
+				results, err = keeper.ExecuteProposalMsgs(cacheCtx, proposal)
+			} else {
+				handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+				err = handler(cacheCtx, proposal.GetContent())
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
+				proposal.MessageResults = results
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
 
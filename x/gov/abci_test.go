@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -321,6 +322,78 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestMsgsProposalEndBlocker(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amounts  []int64
+		expPass  bool
+		expSpent int64
+	}{
+		{"all messages succeed", []int64{100, 200}, true, 300},
+		// the second send exceeds the funds of the gov account, so that the
+		// first one must be reverted as well
+		{"one message fails", []int64{100, 5000}, false, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			require.NoError(t, simapp.FundModuleAccount(app, ctx, types.ModuleName, funds))
+			recipientBalance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+			var msgs []sdk.ServiceMsg
+			for _, amount := range tc.amounts {
+				msgs = append(msgs, sdk.ServiceMsg{
+					MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+					Request:    banktypes.NewMsgSend(govAddr, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))),
+				})
+			}
+			proposal, err := app.GovKeeper.SubmitMsgsProposal(ctx, msgs)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			spent := sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.expSpent)
+			require.Equal(t, recipientBalance.Add(spent), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+			require.Equal(t, funds.Sub(sdk.NewCoins(spent)), app.BankKeeper.GetAllBalances(ctx, govAddr))
+
+			if tc.expPass {
+				require.Equal(t, types.StatusPassed, proposal.Status)
+				require.Len(t, proposal.MessageResults, len(msgs))
+			} else {
+				require.Equal(t, types.StatusFailed, proposal.Status)
+				require.Empty(t, proposal.MessageResults)
+			}
+		})
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -7,7 +7,9 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -42,3 +44,26 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseSubmitMsgsProposal reads a MsgSubmitProposal carrying messages from a
+// JSON file. The proposer is left empty, to be set from the signer.
+func parseSubmitMsgsProposal(cdc codec.JSONMarshaler, path string) (*types.MsgSubmitProposal, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg types.MsgSubmitProposal
+	if err := cdc.UnmarshalJSON(contents, &msg); err != nil {
+		return nil, err
+	}
+
+	if msg.Content != nil {
+		return nil, fmt.Errorf("content is not supported, use the submit-proposal command instead")
+	}
+	if len(msg.Messages) == 0 {
+		return nil, fmt.Errorf("proposal must have at least one message")
+	}
+
+	return &msg, nil
+}
@@ -590,11 +590,20 @@ $ %s query gov params
 				return err
 			}
 
+			proposalTypeRes, err := queryClient.Params(
+				ctx,
+				&types.QueryParamsRequest{ParamsType: "proposal_type"},
+			)
+			if err != nil {
+				return err
+			}
+
 			params := types.NewParams(
 				votingRes.GetVotingParams(),
 				tallyRes.GetTallyParams(),
 				depositRes.GetDepositParams(),
 			)
+			params.ProposalTypeParams = proposalTypeRes.GetProposalTypeParams()
 
 			return clientCtx.PrintObjectLegacy(params)
 		},
@@ -610,7 +619,7 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|proposal_type) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -618,8 +627,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param proposal_type
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -638,7 +648,7 @@ $ %s query gov param deposit
 				return err
 			}
 
-			var out fmt.Stringer
+			var out interface{}
 			switch args[0] {
 			case "voting":
 				out = res.GetVotingParams()
@@ -646,8 +656,10 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "proposal_type":
+				out = res.GetProposalTypeParams()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|proposal_type), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
@@ -71,6 +71,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		cmdSubmitProp,
+		NewCmdSubmitMsgsProposal(),
 	)
 
 	return govTxCmd
@@ -148,6 +149,66 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	return cmd
 }
 
+// NewCmdSubmitMsgsProposal implements submitting a proposal executing messages
+// with the gov module account as signer.
+func NewCmdSubmitMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-msgs-proposal [proposal-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages along with an initial deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal along with an initial deposit. If the proposal passes,
+its messages are executed atomically with the gov module account as their only signer,
+so the signer field of each message must be set to the gov module account address.
+
+Example:
+$ %s tx gov submit-msgs-proposal path/to/proposal.json --from mykey
+
+Where proposal.json contains:
+
+{
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.Msg/Send",
+      "from_address": "cosmos1...",
+      "to_address": "cosmos1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ],
+  "initial_deposit": [{"denom": "stake", "amount": "10"}]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSubmitMsgsProposal(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+			msg.Proposer = clientCtx.GetFromAddress().String()
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.SubmitProposal(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
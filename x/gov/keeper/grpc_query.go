@@ -175,6 +175,10 @@ func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 		tallyParams := q.GetTallyParams(ctx)
 		return &types.QueryParamsResponse{TallyParams: tallyParams}, nil
 
+	case types.ParamProposalType:
+		proposalTypeParams := q.GetProposalTypeParams(ctx)
+		return &types.QueryParamsResponse{ProposalTypeParams: proposalTypeParams}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
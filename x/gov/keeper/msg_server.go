@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -26,9 +27,23 @@ var _ types.MsgServer = msgServer{}
 
 func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	proposal, err := k.Keeper.SubmitProposal(ctx, msg.GetContent())
-	if err != nil {
-		return nil, err
+
+	var proposal types.Proposal
+	if len(msg.Messages) > 0 {
+		msgs, err := msg.GetMsgs()
+		if err != nil {
+			return nil, err
+		}
+		proposal, err = k.Keeper.SubmitMsgsProposal(ctx, msgs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		proposal, err = k.Keeper.SubmitProposal(ctx, msg.GetContent())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
@@ -46,7 +61,10 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitPro
 		),
 	)
 
-	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()))
+	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, proposal.ProposalType()))
+	for _, any := range proposal.Messages {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyProposalMessage, any.TypeUrl))
+	}
 	if votingStarted {
 		submitEvent = submitEvent.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalId)),
@@ -157,3 +175,30 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 
 	return &types.MsgDepositResponse{}, nil
 }
+
+// ExecLegacyContent implements the Msg/ExecLegacyContent method. It executes
+// the wrapped content through the legacy proposal router, and can only be
+// signed by the gov module account, i.e. as a message of a passed proposal.
+func (k msgServer) ExecLegacyContent(goCtx context.Context, msg *types.MsgExecLegacyContent) (*types.MsgExecLegacyContentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	govAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if msg.Authority != govAddr.String() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", govAddr, msg.Authority)
+	}
+
+	content := msg.GetContent()
+	if content == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalContent, "missing content")
+	}
+	if !k.router.HasRoute(content.ProposalRoute()) {
+		return nil, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	handler := k.router.GetRoute(content.ProposalRoute())
+	if err := handler(ctx, content); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	}
+
+	return &types.MsgExecLegacyContentResponse{}, nil
+}
@@ -26,6 +26,29 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetProposalTypeParams returns the params overriding the global ones for
+// proposals of given types
+func (keeper Keeper) GetProposalTypeParams(ctx sdk.Context) []types.ProposalTypeParams {
+	var params []types.ProposalTypeParams
+	// the overrides are absent from the param store of chains started before
+	// they were introduced
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyProposalTypeParams, &params)
+	return params
+}
+
+// GetProposalParams returns the deposit, voting and tally params applying to
+// the given proposal, i.e. the global params with the overrides matching the
+// proposal's types applied.
+func (keeper Keeper) GetProposalParams(ctx sdk.Context, proposal types.Proposal) types.ProposalParams {
+	return types.ResolveProposalParams(
+		proposal.ParamsTypes(),
+		keeper.GetDepositParams(ctx),
+		keeper.GetVotingParams(ctx),
+		keeper.GetTallyParams(ctx),
+		keeper.GetProposalTypeParams(ctx),
+	)
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +63,9 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetProposalTypeParams sets the params overriding the global ones for
+// proposals of given types to the global param store
+func (keeper Keeper) SetProposalTypeParams(ctx sdk.Context, params []types.ProposalTypeParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyProposalTypeParams, &params)
+}
@@ -37,6 +37,80 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		return types.Proposal{}, err
 	}
 
+	keeper.insertProposal(ctx, proposal)
+
+	return proposal, nil
+}
+
+// SubmitMsgsProposal creates a new proposal executing the given msgs with the
+// gov module account as signer once it passes. Each msg must be signed by the
+// gov module account only and be routable by the msg service router.
+func (keeper Keeper) SubmitMsgsProposal(ctx sdk.Context, msgs []sdk.ServiceMsg) (types.Proposal, error) {
+	if len(msgs) == 0 {
+		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, "proposal has no messages")
+	}
+	if err := types.ValidateProposalMsgs(msgs); err != nil {
+		return types.Proposal{}, err
+	}
+	for _, msg := range msgs {
+		if keeper.msgRouter.Handler(msg.MethodName) == nil {
+			return types.Proposal{}, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, msg.MethodName)
+		}
+	}
+
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
+
+	proposal, err := types.NewMsgsProposal(msgs, proposalID, submitTime, submitTime.Add(depositPeriod))
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	keeper.insertProposal(ctx, proposal)
+
+	return proposal, nil
+}
+
+// ExecuteProposalMsgs executes the msgs of a passed proposal through the msg
+// service router and returns the data returned by each of them. Execution
+// stops at the first failing msg; the caller is responsible for running it in
+// a branched context so that the msgs are applied atomically.
+func (keeper Keeper) ExecuteProposalMsgs(ctx sdk.Context, proposal types.Proposal) ([][]byte, error) {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		handler := keeper.msgRouter.Handler(msg.MethodName)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.MethodName)
+		}
+
+		res, err := handler(ctx, msg.Request)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d %s", i, msg.MethodName)
+		}
+
+		// the events emitted by the handler are already in the context's
+		// event manager, only the data is kept
+		results[i] = res.Data
+	}
+
+	return results, nil
+}
+
+// insertProposal stores a newly submitted proposal, adds it to the inactive
+// proposal queue and increments the proposal ID.
+func (keeper Keeper) insertProposal(ctx sdk.Context, proposal types.Proposal) {
+	proposalID := proposal.ProposalId
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
@@ -50,8 +124,6 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
-
-	return proposal, nil
 }
 
 // GetProposal get proposal from store by ProposalID
@@ -185,7 +257,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetProposalParams(ctx, proposal).VotingPeriod
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -12,6 +12,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -55,6 +58,35 @@ func TestActivateVotingPeriod(t *testing.T) {
 	activeIterator.Close()
 }
 
+func TestActivateVotingPeriodProposalTypeParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+
+	votingPeriod := time.Hour
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	app.GovKeeper.SetProposalTypeParams(ctx, []types.ProposalTypeParams{
+		{ProposalType: types.ProposalTypeText, MinDeposit: minDeposit, VotingPeriod: &votingPeriod},
+	})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+
+	params := app.GovKeeper.GetProposalParams(ctx, proposal)
+	require.Equal(t, minDeposit, params.MinDeposit)
+	require.Equal(t, votingPeriod, params.VotingPeriod)
+	require.True(t, params.TallyParams.Equal(app.GovKeeper.GetTallyParams(ctx)))
+
+	// the overridden min deposit is enough to start the voting period
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartTime.Add(votingPeriod), proposal.VotingEndTime)
+}
+
 type invalidProposalRoute struct{ types.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -83,6 +115,74 @@ func TestSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestSubmitMsgsProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	newSend := func(from sdk.AccAddress) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(from, addrs[0], coins),
+		}
+	}
+	unroutable := newSend(govAddr)
+	unroutable.MethodName = "/cosmos.bank.v1beta1.Msg/Unknown"
+
+	testCases := []struct {
+		msgs        []sdk.ServiceMsg
+		expectedErr error
+	}{
+		{[]sdk.ServiceMsg{newSend(govAddr)}, nil},
+		{[]sdk.ServiceMsg{newSend(govAddr), newSend(govAddr)}, nil},
+		{nil, types.ErrInvalidProposalContent},
+		{[]sdk.ServiceMsg{newSend(govAddr), newSend(addrs[0])}, types.ErrInvalidSigner},
+		{[]sdk.ServiceMsg{unroutable}, sdkerrors.ErrUnknownRequest},
+	}
+
+	for i, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitMsgsProposal(ctx, tc.msgs)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(err, tc.expectedErr), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+			continue
+		}
+		require.NoError(t, err, "tc #%d", i)
+		require.True(t, proposal.IsMsgsProposal())
+		require.Nil(t, proposal.GetContent())
+
+		gotProposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok)
+		msgs, err := gotProposal.GetMsgs()
+		require.NoError(t, err)
+		require.Equal(t, tc.msgs, msgs)
+	}
+}
+
+func TestExecLegacyContent(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+
+	msg, err := types.NewMsgExecLegacyContent(TestProposal, addrs[0])
+	require.NoError(t, err)
+	_, err = msgServer.ExecLegacyContent(sdk.WrapSDKContext(ctx), msg)
+	require.True(t, errors.Is(err, types.ErrInvalidSigner), err)
+
+	msg, err = types.NewMsgExecLegacyContent(TestProposal, govAddr)
+	require.NoError(t, err)
+	_, err = msgServer.ExecLegacyContent(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	msg, err = types.NewMsgExecLegacyContent(&invalidProposalRoute{}, govAddr)
+	require.NoError(t, err)
+	_, err = msgServer.ExecLegacyContent(sdk.WrapSDKContext(ctx), msg)
+	require.True(t, errors.Is(err, types.ErrNoProposalHandlerExists), err)
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -100,6 +100,7 @@ func TestMigrate(t *testing.T) {
 		"min_deposit": []
 	},
 	"deposits": [],
+	"proposal_type_params": [],
 	"proposals": [
 		{
 			"content": {
@@ -114,6 +115,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"message_results": [],
+			"messages": [],
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -141,6 +144,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"message_results": [],
+			"messages": [],
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -161,6 +166,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"message_results": [],
+			"messages": [],
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -186,6 +193,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"message_results": [],
+			"messages": [],
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -213,6 +222,8 @@ func TestMigrate(t *testing.T) {
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"message_results": [],
+			"messages": [],
 			"proposal_id": "0",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
@@ -54,6 +54,7 @@ func TestMigrateJSON(t *testing.T) {
 		"min_deposit": []
 	},
 	"deposits": [],
+	"proposal_type_params": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
@@ -34,7 +34,8 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		proposalTypeParamsEqual(data.ProposalTypeParams, other.ProposalTypeParams)
 }
 
 // Empty returns true if a GenesisState is empty
@@ -61,7 +62,21 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
-	return nil
+	return ValidateProposalTypeParams(data.ProposalTypeParams)
+}
+
+// proposalTypeParamsEqual returns true if two lists of params overrides are
+// equal.
+func proposalTypeParamsEqual(params, other []ProposalTypeParams) bool {
+	if len(params) != len(other) {
+		return false
+	}
+	for i := range params {
+		if !params[i].Equal(&other[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -39,6 +39,9 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// proposal_type_params defines the params overriding the global ones for
+	// proposals of given types.
+	ProposalTypeParams []ProposalTypeParams `protobuf:"bytes,8,rep,name=proposal_type_params,json=proposalTypeParams,proto3" json:"proposal_type_params" yaml:"proposal_type_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +126,13 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetProposalTypeParams() []ProposalTypeParams {
+	if m != nil {
+		return m.ProposalTypeParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
 }
@@ -130,34 +140,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x9a, 0x94, 0xf6, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x9e, 0xb2,
-	0x60, 0xab, 0x65, 0x43, 0x62, 0xb1, 0x90, 0x50, 0x07, 0xa4, 0x62, 0x10, 0x03, 0x4b, 0x74, 0x89,
-	0x4f, 0x87, 0x85, 0xdd, 0x67, 0xf9, 0x1d, 0x16, 0xf9, 0x16, 0x7c, 0x0e, 0x3e, 0x49, 0xc7, 0x8e,
-	0x4c, 0x01, 0x25, 0x13, 0x6b, 0x3f, 0x01, 0xf2, 0xdd, 0x19, 0x1c, 0x61, 0x3a, 0x25, 0x7e, 0xf7,
-	0xbf, 0xdf, 0xef, 0xdd, 0xd3, 0x23, 0xde, 0x0a, 0x30, 0x07, 0x0c, 0x05, 0x54, 0x61, 0x75, 0xba,
-	0xe4, 0x92, 0x9d, 0x86, 0x82, 0x5f, 0x72, 0x4c, 0x31, 0x28, 0x4a, 0x90, 0x40, 0xa9, 0x4e, 0x04,
-	0x02, 0xaa, 0xc0, 0x24, 0x4e, 0x26, 0x02, 0x04, 0xa8, 0xe3, 0xb0, 0xfe, 0xa7, 0x93, 0x27, 0xb3,
-	0x2e, 0x16, 0x54, 0xfa, 0xd4, 0xff, 0xd5, 0x27, 0xa3, 0x57, 0x9a, 0xfc, 0x56, 0x32, 0xc9, 0xe9,
-	0x1b, 0x32, 0x41, 0xc9, 0x4a, 0x99, 0x5e, 0x8a, 0x45, 0x51, 0x42, 0x01, 0xc8, 0xb2, 0x45, 0x9a,
-	0xd8, 0x96, 0x67, 0xcd, 0xfb, 0x91, 0x7b, 0xb3, 0x71, 0xa7, 0x6b, 0x96, 0x67, 0xcf, 0xfd, 0xae,
-	0x94, 0x1f, 0xd3, 0xa6, 0x7c, 0x61, 0xaa, 0xe7, 0x09, 0x3d, 0x27, 0x47, 0x09, 0x2f, 0x00, 0x53,
-	0x89, 0xf6, 0x1d, 0xef, 0x60, 0x3e, 0x3c, 0x9b, 0x06, 0xff, 0xb6, 0x1f, 0xbc, 0xd4, 0x99, 0xe8,
-	0xfe, 0xd5, 0xc6, 0xed, 0x7d, 0xfb, 0xe1, 0x1e, 0x99, 0x02, 0xc6, 0x7f, 0xae, 0xd3, 0x17, 0x64,
-	0x50, 0x81, 0xe4, 0x68, 0x1f, 0x28, 0x8e, 0xdd, 0xc5, 0x79, 0x0f, 0x92, 0x47, 0x63, 0x03, 0x19,
-	0xd4, 0x5f, 0x18, 0xeb, 0x5b, 0xf4, 0x35, 0x39, 0x6e, 0xba, 0x45, 0xbb, 0xaf, 0x10, 0xb3, 0x2e,
-	0x44, 0xd3, 0x7c, 0xf4, 0xc0, 0x60, 0x8e, 0x9b, 0x0a, 0xc6, 0x7f, 0x09, 0x54, 0x90, 0x7b, 0xa6,
-	0xb3, 0x45, 0xc1, 0x4a, 0x96, 0xa3, 0x3d, 0xf0, 0xac, 0xf9, 0xf0, 0xec, 0xc9, 0x2d, 0xcf, 0xbb,
-	0x50, 0xc1, 0xe8, 0x71, 0x0d, 0xbe, 0xd9, 0xb8, 0x8f, 0xf4, 0x30, 0xf7, 0x31, 0x7e, 0x3c, 0x4e,
-	0xda, 0x69, 0xba, 0x22, 0xe3, 0x0a, 0xf4, 0xb0, 0xb5, 0xe7, 0x50, 0x79, 0xbc, 0xff, 0x3c, 0xbf,
-	0x1e, 0xbf, 0xd6, 0xcc, 0x8c, 0x66, 0xa2, 0x35, 0x7b, 0x10, 0x3f, 0x1e, 0x55, 0xad, 0x2c, 0x5d,
-	0x90, 0x91, 0x64, 0x59, 0xb6, 0x6e, 0x1c, 0x77, 0x95, 0xc3, 0xed, 0x72, 0xbc, 0xab, 0x73, 0x46,
-	0x31, 0x35, 0x8a, 0x87, 0x5a, 0xd1, 0x46, 0xf8, 0xf1, 0x50, 0xb6, 0x92, 0xd1, 0xd5, 0xd6, 0xb1,
-	0xae, 0xb7, 0x8e, 0xf5, 0x73, 0xeb, 0x58, 0x5f, 0x77, 0x4e, 0xef, 0x7a, 0xe7, 0xf4, 0xbe, 0xef,
-	0x9c, 0xde, 0x87, 0xb9, 0x48, 0xe5, 0xc7, 0xcf, 0xcb, 0x60, 0x05, 0x79, 0x68, 0xd6, 0x55, 0xff,
-	0x3c, 0xc5, 0xe4, 0x53, 0xf8, 0x45, 0xed, 0xae, 0x5c, 0x17, 0x1c, 0x97, 0x87, 0x6a, 0x6d, 0x9f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0x87, 0x63, 0xda, 0x94, 0xf4, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x46, 0x42,
+	0x59, 0xb0, 0xd5, 0xb2, 0x21, 0xb1, 0x58, 0x48, 0xa8, 0x03, 0x52, 0x31, 0x15, 0x03, 0x4b, 0x74,
+	0x89, 0x4f, 0x87, 0x45, 0xd2, 0xf7, 0x94, 0xf7, 0xb0, 0xc8, 0xc0, 0x77, 0xe0, 0x73, 0xf0, 0x49,
+	0x3a, 0x76, 0x64, 0x0a, 0x28, 0x59, 0x98, 0xfb, 0x09, 0x90, 0xef, 0xce, 0x6d, 0xaa, 0xba, 0x99,
+	0x12, 0xbf, 0xf7, 0xbb, 0xe7, 0x79, 0xef, 0x1f, 0x09, 0x26, 0x80, 0x33, 0xc0, 0x58, 0x40, 0x11,
+	0x17, 0x87, 0x63, 0xae, 0xd8, 0x61, 0x2c, 0xf8, 0x19, 0xc7, 0x1c, 0x23, 0x39, 0x07, 0x05, 0x94,
+	0x9a, 0x44, 0x24, 0xa0, 0x88, 0x6c, 0xe2, 0xa0, 0x27, 0x40, 0x80, 0x1e, 0x8e, 0xcb, 0x7f, 0x26,
+	0x79, 0x30, 0xa8, 0x63, 0x41, 0x61, 0x46, 0xc3, 0x7f, 0x4d, 0xd2, 0x79, 0x67, 0xc8, 0x1f, 0x15,
+	0x53, 0x9c, 0x7e, 0x20, 0x3d, 0x54, 0x6c, 0xae, 0xf2, 0x33, 0x31, 0x92, 0x73, 0x90, 0x80, 0x6c,
+	0x3a, 0xca, 0x33, 0xd7, 0x09, 0x9c, 0xe1, 0x6e, 0xe2, 0x5f, 0x2e, 0xfd, 0xfe, 0x82, 0xcd, 0xa6,
+	0xaf, 0xc3, 0xba, 0x54, 0x98, 0xd2, 0xaa, 0x7c, 0x62, 0xab, 0xc7, 0x19, 0x3d, 0x26, 0xad, 0x8c,
+	0x4b, 0xc0, 0x5c, 0xa1, 0x7b, 0x2f, 0xd8, 0x19, 0xb6, 0x8f, 0xfa, 0xd1, 0xed, 0xf6, 0xa3, 0xb7,
+	0x26, 0x93, 0x3c, 0x3c, 0x5f, 0xfa, 0x8d, 0x5f, 0x7f, 0xfc, 0x96, 0x2d, 0x60, 0x7a, 0x35, 0x9d,
+	0xbe, 0x21, 0xcd, 0x02, 0x14, 0x47, 0x77, 0x47, 0x73, 0xdc, 0x3a, 0xce, 0x27, 0x50, 0x3c, 0xe9,
+	0x5a, 0x48, 0xb3, 0xfc, 0xc2, 0xd4, 0xcc, 0xa2, 0xef, 0xc9, 0x7e, 0xd5, 0x2d, 0xba, 0xbb, 0x1a,
+	0x31, 0xa8, 0x43, 0x54, 0xcd, 0x27, 0x8f, 0x2c, 0x66, 0xbf, 0xaa, 0x60, 0x7a, 0x4d, 0xa0, 0x82,
+	0x3c, 0xb0, 0x9d, 0x8d, 0x24, 0x9b, 0xb3, 0x19, 0xba, 0xcd, 0xc0, 0x19, 0xb6, 0x8f, 0x9e, 0x6d,
+	0x59, 0xde, 0x89, 0x0e, 0x26, 0x4f, 0x4b, 0xf0, 0xe5, 0xd2, 0x7f, 0x62, 0x36, 0xf3, 0x26, 0x26,
+	0x4c, 0xbb, 0xd9, 0x66, 0x9a, 0x4e, 0x48, 0xb7, 0x00, 0xb3, 0xd9, 0xc6, 0xb3, 0xa7, 0x3d, 0xc1,
+	0x1d, 0xcb, 0x2f, 0xb7, 0xdf, 0x68, 0x06, 0x56, 0xd3, 0x33, 0x9a, 0x1b, 0x90, 0x30, 0xed, 0x14,
+	0x1b, 0x59, 0x3a, 0x22, 0x1d, 0xc5, 0xa6, 0xd3, 0x45, 0xe5, 0xb8, 0xaf, 0x1d, 0x7e, 0x9d, 0xe3,
+	0xb4, 0xcc, 0x59, 0x45, 0xdf, 0x2a, 0x1e, 0x1b, 0xc5, 0x26, 0x22, 0x4c, 0xdb, 0xea, 0x3a, 0x49,
+	0x7f, 0x90, 0xde, 0xd5, 0x5d, 0x51, 0x0b, 0xc9, 0x2b, 0x51, 0x4b, 0x1f, 0xc4, 0x8b, 0x6d, 0x07,
+	0x71, 0xba, 0x90, 0xdc, 0xfa, 0x9e, 0x5b, 0x9f, 0xbd, 0x86, 0x75, 0xc4, 0x30, 0xa5, 0xf2, 0xf6,
+	0xc4, 0xe4, 0x7c, 0xe5, 0x39, 0x17, 0x2b, 0xcf, 0xf9, 0xbb, 0xf2, 0x9c, 0x9f, 0x6b, 0xaf, 0x71,
+	0xb1, 0xf6, 0x1a, 0xbf, 0xd7, 0x5e, 0xe3, 0xf3, 0x50, 0xe4, 0xea, 0xcb, 0xb7, 0x71, 0x34, 0x81,
+	0x59, 0x6c, 0x5f, 0x8b, 0xf9, 0x79, 0x89, 0xd9, 0xd7, 0xf8, 0xbb, 0x7e, 0x3a, 0x25, 0x1e, 0xc7,
+	0x7b, 0xfa, 0xd5, 0xbc, 0xfa, 0x3f, 0x00, 0x24, 0x21, 0xfa, 0x35, 0xa1, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +192,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalTypeParams) > 0 {
+		for iNdEx := len(m.ProposalTypeParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTypeParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -304,6 +330,12 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProposalTypeParams) > 0 {
+		for _, e := range m.ProposalTypeParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -562,6 +594,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypeParams = append(m.ProposalTypeParams, ProposalTypeParams{})
+			if err := m.ProposalTypeParams[len(m.ProposalTypeParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -249,6 +250,12 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// messages are the sdk.Msgs executed with the gov module account as signer
+	// when the proposal passes. A proposal has either a content or messages.
+	Messages []*types1.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// message_results holds the data returned by each of the messages once the
+	// proposal has been executed successfully.
+	MessageResults [][]byte `protobuf:"bytes,11,rep,name=message_results,json=messageResults,proto3" json:"message_results,omitempty" yaml:"message_results"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -486,6 +493,60 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// ProposalTypeParams defines governance params overriding the global deposit,
+// voting and tally params for the proposals of a given type. Unset fields fall
+// back to the global params.
+type ProposalTypeParams struct {
+	// proposal_type is the type of the content of a proposal, e.g.
+	// "SoftwareUpgrade", or the type URL of a proposal message, e.g.
+	// "/cosmos.bank.v1beta1.Msg/Send".
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty" yaml:"proposal_type"`
+	//  Minimum deposit for a proposal of this type to enter voting period.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty" yaml:"min_deposit"`
+	//  Length of the voting period of a proposal of this type.
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+}
+
+func (m *ProposalTypeParams) Reset()      { *m = ProposalTypeParams{} }
+func (*ProposalTypeParams) ProtoMessage() {}
+func (*ProposalTypeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
+}
+func (m *ProposalTypeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTypeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTypeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTypeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTypeParams.Merge(m, src)
+}
+func (m *ProposalTypeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTypeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTypeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTypeParams proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -498,103 +559,112 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1beta1.TallyParams")
+	proto.RegisterType((*ProposalTypeParams)(nil), "cosmos.gov.v1beta1.ProposalTypeParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x51, 0x68, 0xdb, 0x56,
-	0x17, 0xb6, 0x6c, 0xc7, 0x89, 0xaf, 0x9d, 0x44, 0xbd, 0x49, 0x13, 0xc7, 0x7f, 0x7f, 0xc9, 0xbf,
-	0xfe, 0x51, 0x42, 0x69, 0x9d, 0x36, 0x1b, 0x1b, 0x4b, 0x61, 0x9b, 0x15, 0x2b, 0xab, 0x4b, 0xb1,
-	0x8d, 0xac, 0x3a, 0xb4, 0x7b, 0x10, 0x8a, 0x7d, 0xeb, 0x68, 0xb3, 0x74, 0x3d, 0xeb, 0x3a, 0x4d,
-	0xd8, 0xcb, 0x1e, 0x8b, 0x07, 0xa3, 0x8f, 0x85, 0x61, 0x28, 0x8c, 0xbd, 0xec, 0x79, 0x7b, 0xdd,
-	0x73, 0x18, 0x83, 0x95, 0x3d, 0x95, 0x0d, 0xdc, 0x35, 0x85, 0x51, 0xf2, 0x98, 0x87, 0x3d, 0x0f,
-	0xe9, 0x5e, 0xc5, 0xb2, 0x1d, 0x96, 0x79, 0x4f, 0xd1, 0x3d, 0xf7, 0x7c, 0xdf, 0x77, 0xce, 0xf1,
-	0x39, 0x47, 0x0a, 0xb8, 0x54, 0xc3, 0x8e, 0x85, 0x9d, 0xb5, 0x06, 0xde, 0x5b, 0xdb, 0xbb, 0xb1,
-	0x83, 0x88, 0x71, 0xc3, 0x7d, 0xce, 0xb6, 0xda, 0x98, 0x60, 0x08, 0xe9, 0x6d, 0xd6, 0xb5, 0xb0,
-	0xdb, 0xb4, 0xc0, 0x10, 0x3b, 0x86, 0x83, 0x4e, 0x21, 0x35, 0x6c, 0xda, 0x14, 0x93, 0x5e, 0x6c,
-	0xe0, 0x06, 0xf6, 0x1e, 0xd7, 0xdc, 0x27, 0x66, 0x5d, 0xa1, 0x28, 0x9d, 0x5e, 0x30, 0x5a, 0x7a,
-	0x25, 0x36, 0x30, 0x6e, 0x34, 0xd1, 0x9a, 0x77, 0xda, 0xe9, 0x3c, 0x58, 0x23, 0xa6, 0x85, 0x1c,
-	0x62, 0x58, 0x2d, 0x1f, 0x3b, 0xea, 0x60, 0xd8, 0x07, 0xec, 0x4a, 0x18, 0xbd, 0xaa, 0x77, 0xda,
-	0x06, 0x31, 0x31, 0x0b, 0x46, 0xfa, 0x86, 0x03, 0x70, 0x1b, 0x99, 0x8d, 0x5d, 0x82, 0xea, 0x55,
-	0x4c, 0x50, 0xa9, 0xe5, 0x5e, 0xc2, 0xb7, 0x41, 0x0c, 0x7b, 0x4f, 0x29, 0x2e, 0xc3, 0xad, 0xce,
-	0xad, 0x0b, 0xd9, 0xf1, 0x44, 0xb3, 0x03, 0x7f, 0x95, 0x79, 0xc3, 0x6d, 0x10, 0x7b, 0xe8, 0xb1,
-	0xa5, 0xc2, 0x19, 0x6e, 0x35, 0x2e, 0xbf, 0x7f, 0xd8, 0x17, 0x43, 0xbf, 0xf6, 0xc5, 0xcb, 0x0d,
-	0x93, 0xec, 0x76, 0x76, 0xb2, 0x35, 0x6c, 0xb1, 0xdc, 0xd8, 0x9f, 0x6b, 0x4e, 0xfd, 0x93, 0x35,
-	0x72, 0xd0, 0x42, 0x4e, 0x36, 0x8f, 0x6a, 0x27, 0x7d, 0x71, 0xf6, 0xc0, 0xb0, 0x9a, 0x1b, 0x12,
-	0x65, 0x91, 0x54, 0x46, 0x27, 0x6d, 0x83, 0xa4, 0x86, 0xf6, 0x49, 0xb9, 0x8d, 0x5b, 0xd8, 0x31,
-	0x9a, 0x70, 0x11, 0x4c, 0x11, 0x93, 0x34, 0x91, 0x17, 0x5f, 0x5c, 0xa5, 0x07, 0x98, 0x01, 0x89,
-	0x3a, 0x72, 0x6a, 0x6d, 0x93, 0xc6, 0xee, 0xc5, 0xa0, 0x06, 0x4d, 0x1b, 0xf3, 0xaf, 0x9f, 0x8a,
-	0xdc, 0x2f, 0xdf, 0x5d, 0x9b, 0xde, 0xc4, 0x36, 0x41, 0x36, 0x91, 0x7e, 0xe6, 0xc0, 0x74, 0x1e,
-	0xb5, 0xb0, 0x63, 0x12, 0xf8, 0x0e, 0x48, 0xb4, 0x98, 0x80, 0x6e, 0xd6, 0x3d, 0xea, 0xa8, 0xbc,
-	0x74, 0xd2, 0x17, 0x21, 0x0d, 0x2a, 0x70, 0x29, 0xa9, 0xc0, 0x3f, 0x15, 0xea, 0xf0, 0x12, 0x88,
-	0xd7, 0x29, 0x07, 0x6e, 0x33, 0xd5, 0x81, 0x01, 0xd6, 0x40, 0xcc, 0xb0, 0x70, 0xc7, 0x26, 0xa9,
-	0x48, 0x26, 0xb2, 0x9a, 0x58, 0x5f, 0xf1, 0x8b, 0xe9, 0x76, 0xc8, 0x69, 0x35, 0x37, 0xb1, 0x69,
-	0xcb, 0xd7, 0xdd, 0x7a, 0x7d, 0xfb, 0x42, 0x5c, 0xfd, 0x07, 0xf5, 0x72, 0x01, 0x8e, 0xca, 0xa8,
-	0x37, 0x66, 0x1e, 0x3d, 0x15, 0x43, 0xaf, 0x9f, 0x8a, 0x21, 0xe9, 0xcf, 0x18, 0x98, 0x39, 0xad,
-	0xd3, 0x5b, 0x67, 0xa5, 0xb4, 0x70, 0xdc, 0x17, 0xc3, 0x66, 0xfd, 0xa4, 0x2f, 0xc6, 0x69, 0x62,
-	0xa3, 0xf9, 0xdc, 0x04, 0xd3, 0x35, 0x5a, 0x1f, 0x2f, 0x9b, 0xc4, 0xfa, 0x62, 0x96, 0xf6, 0x51,
-	0xd6, 0xef, 0xa3, 0x6c, 0xce, 0x3e, 0x90, 0x13, 0x3f, 0x0e, 0x0a, 0xa9, 0xfa, 0x08, 0x58, 0x05,
-	0x31, 0x87, 0x18, 0xa4, 0xe3, 0xa4, 0x22, 0x5e, 0xef, 0x48, 0x67, 0xf5, 0x8e, 0x1f, 0x60, 0xc5,
-	0xf3, 0x94, 0xd3, 0x27, 0x7d, 0x71, 0x69, 0xa4, 0xc8, 0x94, 0x44, 0x52, 0x19, 0x1b, 0x6c, 0x01,
-	0xf8, 0xc0, 0xb4, 0x8d, 0xa6, 0x4e, 0x8c, 0x66, 0xf3, 0x40, 0x6f, 0x23, 0xa7, 0xd3, 0x24, 0xa9,
-	0xa8, 0x17, 0x9f, 0x78, 0x96, 0x86, 0xe6, 0xfa, 0xa9, 0x9e, 0x9b, 0xfc, 0x3f, 0xb7, 0xb0, 0x27,
-	0x7d, 0x71, 0x85, 0x8a, 0x8c, 0x13, 0x49, 0x2a, 0xef, 0x19, 0x03, 0x20, 0xf8, 0x11, 0x48, 0x38,
-	0x9d, 0x1d, 0xcb, 0x24, 0xba, 0x3b, 0x71, 0xa9, 0x29, 0x4f, 0x2a, 0x3d, 0x56, 0x0a, 0xcd, 0x1f,
-	0x47, 0x59, 0x60, 0x2a, 0xac, 0x5f, 0x02, 0x60, 0xe9, 0xf1, 0x0b, 0x91, 0x53, 0x01, 0xb5, 0xb8,
-	0x00, 0x68, 0x02, 0x9e, 0xb5, 0x88, 0x8e, 0xec, 0x3a, 0x55, 0x88, 0x9d, 0xab, 0xf0, 0x7f, 0xa6,
-	0xb0, 0x4c, 0x15, 0x46, 0x19, 0xa8, 0xcc, 0x1c, 0x33, 0x2b, 0x76, 0xdd, 0x93, 0x7a, 0xc4, 0x81,
-	0x59, 0x82, 0x89, 0xd1, 0xd4, 0xd9, 0x45, 0x6a, 0xfa, 0xbc, 0x46, 0xbc, 0xc5, 0x74, 0x16, 0xa9,
-	0xce, 0x10, 0x5a, 0x9a, 0xa8, 0x41, 0x93, 0x1e, 0xd6, 0x1f, 0xb1, 0x26, 0xb8, 0xb0, 0x87, 0x89,
-	0x69, 0x37, 0xdc, 0x9f, 0xb7, 0xcd, 0x0a, 0x3b, 0x73, 0x6e, 0xda, 0x6f, 0xb0, 0x70, 0x52, 0x34,
-	0x9c, 0x31, 0x0a, 0x9a, 0xf7, 0x3c, 0xb5, 0x57, 0x5c, 0xb3, 0x97, 0xf8, 0x03, 0xc0, 0x4c, 0x83,
-	0x12, 0xc7, 0xcf, 0xd5, 0x92, 0x98, 0xd6, 0xd2, 0x90, 0xd6, 0x70, 0x85, 0x67, 0xa9, 0x95, 0x15,
-	0x78, 0x23, 0xea, 0x6e, 0x15, 0xe9, 0x30, 0x0c, 0x12, 0xc1, 0xf6, 0xf9, 0x00, 0x44, 0x0e, 0x90,
-	0x43, 0x37, 0x94, 0x9c, 0x9d, 0x60, 0x13, 0x16, 0x6c, 0xa2, 0xba, 0x50, 0x78, 0x0b, 0x4c, 0x1b,
-	0x3b, 0x0e, 0x31, 0x4c, 0xb6, 0xcb, 0x26, 0x66, 0xf1, 0xe1, 0xf0, 0x3d, 0x10, 0xb6, 0xb1, 0x37,
-	0x90, 0x93, 0x93, 0x84, 0x6d, 0x0c, 0x1b, 0x20, 0x69, 0x63, 0xfd, 0xa1, 0x49, 0x76, 0xf5, 0x3d,
-	0x44, 0xb0, 0x37, 0x76, 0x71, 0x59, 0x99, 0x8c, 0xe9, 0xa4, 0x2f, 0x2e, 0xd0, 0xa2, 0x06, 0xb9,
-	0x24, 0x15, 0xd8, 0x78, 0xdb, 0x24, 0xbb, 0x55, 0x44, 0x30, 0x2b, 0xe5, 0xf7, 0x1c, 0x88, 0xba,
-	0xaf, 0x97, 0x7f, 0xbf, 0x92, 0x17, 0xc1, 0xd4, 0x1e, 0x26, 0xc8, 0x5f, 0xc7, 0xf4, 0x00, 0xb7,
-	0xc0, 0x34, 0x7d, 0x53, 0x39, 0xa9, 0xa8, 0x37, 0x02, 0x97, 0xcf, 0x5a, 0x1c, 0xe3, 0x2f, 0x44,
-	0x39, 0xea, 0x66, 0xaa, 0xfa, 0xe0, 0x8d, 0x99, 0x27, 0x6c, 0xdb, 0xde, 0x8e, 0xce, 0x44, 0xf8,
-	0xa8, 0xff, 0xfe, 0x93, 0x7e, 0x08, 0x83, 0x59, 0xd6, 0xea, 0x65, 0xa3, 0x6d, 0x58, 0x0e, 0xfc,
-	0x8a, 0x03, 0x09, 0xcb, 0xb4, 0x4f, 0x27, 0x8f, 0x3b, 0x6f, 0xf2, 0x74, 0x57, 0xe9, 0xb8, 0x2f,
-	0x5e, 0x0c, 0xa0, 0xae, 0x62, 0xcb, 0x24, 0xc8, 0x6a, 0x91, 0x83, 0x41, 0xe6, 0x81, 0xeb, 0xc9,
-	0x06, 0x12, 0x58, 0xa6, 0xed, 0x8f, 0xe3, 0x97, 0x1c, 0x80, 0x96, 0xb1, 0xef, 0x13, 0xe9, 0x2d,
-	0xd4, 0x36, 0x71, 0x9d, 0x2d, 0xfd, 0x95, 0xb1, 0x21, 0xc9, 0xb3, 0x8f, 0x07, 0xfa, 0xc3, 0x1f,
-	0xf7, 0xc5, 0x4b, 0xe3, 0xe0, 0xa1, 0x58, 0xd9, 0xba, 0x1d, 0xf7, 0x92, 0x9e, 0xb8, 0x63, 0xc4,
-	0x5b, 0xc6, 0xbe, 0x5f, 0x2e, 0x6a, 0xfe, 0x82, 0x03, 0xc9, 0xaa, 0x37, 0x5b, 0xac, 0x7e, 0x9f,
-	0x01, 0x36, 0x6b, 0x7e, 0x6c, 0xdc, 0x79, 0xb1, 0xdd, 0x64, 0xb1, 0x2d, 0x0f, 0xe1, 0x86, 0xc2,
-	0x5a, 0x1c, 0x1a, 0xed, 0x60, 0x44, 0x49, 0x6a, 0x63, 0xd1, 0xfc, 0xe6, 0x4f, 0x34, 0x0b, 0xe6,
-	0x3e, 0x88, 0x7d, 0xda, 0xc1, 0xed, 0x8e, 0xe5, 0x45, 0x91, 0x94, 0xe5, 0xc9, 0x3e, 0x6f, 0x8e,
-	0xfb, 0x22, 0x4f, 0xf1, 0x83, 0x68, 0x54, 0xc6, 0x08, 0x6b, 0x20, 0x4e, 0x76, 0xdb, 0xc8, 0xd9,
-	0xc5, 0x4d, 0xfa, 0x03, 0x24, 0x27, 0x1a, 0x2f, 0x4a, 0xbf, 0x70, 0x4a, 0x11, 0x50, 0x18, 0xf0,
-	0xc2, 0x2e, 0x07, 0xe6, 0xdc, 0x99, 0xd3, 0x07, 0x52, 0x11, 0x4f, 0xaa, 0x36, 0xb1, 0x54, 0x6a,
-	0x98, 0x67, 0xa8, 0xbe, 0x17, 0x59, 0x7d, 0x87, 0x3c, 0x24, 0x75, 0xd6, 0x35, 0x68, 0xfe, 0xf9,
-	0xca, 0x1f, 0x1c, 0x00, 0x81, 0x6f, 0xce, 0xab, 0x60, 0xb9, 0x5a, 0xd2, 0x14, 0xbd, 0x54, 0xd6,
-	0x0a, 0xa5, 0xa2, 0x7e, 0xb7, 0x58, 0x29, 0x2b, 0x9b, 0x85, 0xad, 0x82, 0x92, 0xe7, 0x43, 0xe9,
-	0xf9, 0x6e, 0x2f, 0x93, 0xa0, 0x8e, 0x8a, 0x2b, 0x02, 0x25, 0x30, 0x1f, 0xf4, 0xbe, 0xa7, 0x54,
-	0x78, 0x2e, 0x3d, 0xdb, 0xed, 0x65, 0xe2, 0xd4, 0xeb, 0x1e, 0x72, 0xe0, 0x15, 0xb0, 0x10, 0xf4,
-	0xc9, 0xc9, 0x15, 0x2d, 0x57, 0x28, 0xf2, 0xe1, 0xf4, 0x85, 0x6e, 0x2f, 0x33, 0x4b, 0xfd, 0x72,
-	0x6c, 0x41, 0x66, 0xc0, 0x5c, 0xd0, 0xb7, 0x58, 0xe2, 0x23, 0xe9, 0x64, 0xb7, 0x97, 0x99, 0xa1,
-	0x6e, 0x45, 0x0c, 0xd7, 0x41, 0x6a, 0xd8, 0x43, 0xdf, 0x2e, 0x68, 0xb7, 0xf4, 0xaa, 0xa2, 0x95,
-	0xf8, 0x68, 0x7a, 0xb1, 0xdb, 0xcb, 0xf0, 0xbe, 0xaf, 0xbf, 0xcd, 0xd2, 0xd1, 0x47, 0x5f, 0x0b,
-	0xa1, 0x2b, 0x3f, 0x85, 0xc1, 0xdc, 0xf0, 0x07, 0x0f, 0xcc, 0x82, 0xff, 0x94, 0xd5, 0x52, 0xb9,
-	0x54, 0xc9, 0xdd, 0xd1, 0x2b, 0x5a, 0x4e, 0xbb, 0x5b, 0x19, 0x49, 0xd8, 0x4b, 0x85, 0x3a, 0x17,
-	0xcd, 0x26, 0xbc, 0x09, 0x84, 0x51, 0xff, 0xbc, 0x52, 0x2e, 0x55, 0x0a, 0x9a, 0x5e, 0x56, 0xd4,
-	0x42, 0x29, 0xcf, 0x73, 0xe9, 0xe5, 0x6e, 0x2f, 0xb3, 0x40, 0x21, 0x43, 0x43, 0x05, 0xdf, 0x05,
-	0xff, 0x1d, 0x05, 0x57, 0x4b, 0x5a, 0xa1, 0xf8, 0xa1, 0x8f, 0x0d, 0xa7, 0x97, 0xba, 0xbd, 0x0c,
-	0xa4, 0xd8, 0x6a, 0x60, 0x02, 0xe0, 0x55, 0xb0, 0x34, 0x0a, 0x2d, 0xe7, 0x2a, 0x15, 0x25, 0xcf,
-	0x47, 0xd2, 0x7c, 0xb7, 0x97, 0x49, 0x52, 0x4c, 0xd9, 0x70, 0x1c, 0x54, 0x87, 0xd7, 0x41, 0x6a,
-	0xd4, 0x5b, 0x55, 0x6e, 0x2b, 0x9b, 0x9a, 0x92, 0xe7, 0xa3, 0x69, 0xd8, 0xed, 0x65, 0xe6, 0xa8,
-	0xbf, 0x8a, 0x3e, 0x46, 0x35, 0x82, 0xce, 0xe4, 0xdf, 0xca, 0x15, 0xee, 0x28, 0x79, 0x7e, 0x2a,
-	0xc8, 0xbf, 0x65, 0x98, 0x4d, 0x54, 0xa7, 0xe5, 0x94, 0x8b, 0x87, 0x2f, 0x85, 0xd0, 0xf3, 0x97,
-	0x42, 0xe8, 0xf3, 0x23, 0x21, 0x74, 0x78, 0x24, 0x70, 0xcf, 0x8e, 0x04, 0xee, 0xf7, 0x23, 0x81,
-	0x7b, 0xfc, 0x4a, 0x08, 0x3d, 0x7b, 0x25, 0x84, 0x9e, 0xbf, 0x12, 0x42, 0xf7, 0xff, 0x7e, 0x21,
-	0xee, 0x7b, 0xff, 0xd0, 0x79, 0xfd, 0xbc, 0x13, 0xf3, 0x76, 0xc8, 0x9b, 0x7f, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0x51, 0x93, 0x54, 0x90, 0xeb, 0x0d, 0x00, 0x00,
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x41, 0x6b, 0x1b, 0xdb,
+	0x15, 0xd6, 0x48, 0xb2, 0x6c, 0x5d, 0x49, 0xf6, 0xe4, 0xda, 0xb1, 0xc7, 0x6a, 0xaa, 0x99, 0x4e,
+	0x4b, 0x30, 0x21, 0x91, 0x13, 0xb7, 0x34, 0xd4, 0xa1, 0x69, 0x25, 0x6b, 0xdc, 0x28, 0x04, 0x49,
+	0x8c, 0x14, 0x99, 0xa4, 0x8b, 0x61, 0x2c, 0xdd, 0xc8, 0xd3, 0x6a, 0xe6, 0xaa, 0x9a, 0x2b, 0xc7,
+	0xa2, 0x9b, 0xae, 0x4a, 0x50, 0xa1, 0x64, 0x19, 0x28, 0x82, 0x40, 0xe9, 0xa6, 0xcb, 0xd2, 0x6e,
+	0xbb, 0x36, 0xa5, 0xd0, 0xd0, 0x55, 0x78, 0x0f, 0x94, 0x17, 0x07, 0x1e, 0xc1, 0xf0, 0x36, 0xfe,
+	0x05, 0x8f, 0x99, 0x7b, 0x47, 0x1a, 0x49, 0x7e, 0xcf, 0x4f, 0x21, 0x3c, 0xde, 0x2a, 0x33, 0xe7,
+	0x9e, 0xef, 0xfb, 0xce, 0x39, 0x73, 0xce, 0xb9, 0x72, 0xc0, 0x95, 0x1a, 0xb6, 0x4d, 0x6c, 0x6f,
+	0x36, 0xf0, 0xe1, 0xe6, 0xe1, 0xad, 0x7d, 0x44, 0xf4, 0x5b, 0xce, 0x73, 0xba, 0xd5, 0xc6, 0x04,
+	0x43, 0x48, 0x4f, 0xd3, 0x8e, 0x85, 0x9d, 0x26, 0x53, 0x0c, 0xb1, 0xaf, 0xdb, 0x68, 0x08, 0xa9,
+	0x61, 0xc3, 0xa2, 0x98, 0xe4, 0x4a, 0x03, 0x37, 0xb0, 0xfb, 0xb8, 0xe9, 0x3c, 0x31, 0xeb, 0x3a,
+	0x45, 0x69, 0xf4, 0x80, 0xd1, 0xd2, 0x23, 0xb1, 0x81, 0x71, 0xa3, 0x89, 0x36, 0xdd, 0xb7, 0xfd,
+	0xce, 0x93, 0x4d, 0x62, 0x98, 0xc8, 0x26, 0xba, 0xd9, 0xf2, 0xb0, 0x93, 0x0e, 0xba, 0xd5, 0x65,
+	0x47, 0xa9, 0xc9, 0xa3, 0x7a, 0xa7, 0xad, 0x13, 0x03, 0xb3, 0x60, 0xe4, 0xbf, 0x71, 0x00, 0xee,
+	0x21, 0xa3, 0x71, 0x40, 0x50, 0xbd, 0x8a, 0x09, 0x2a, 0xb6, 0x9c, 0x43, 0xf8, 0x53, 0x10, 0xc1,
+	0xee, 0x93, 0xc0, 0x49, 0xdc, 0xc6, 0xe2, 0x56, 0x2a, 0x3d, 0x9d, 0x68, 0x7a, 0xe4, 0xaf, 0x32,
+	0x6f, 0xb8, 0x07, 0x22, 0x4f, 0x5d, 0x36, 0x21, 0x28, 0x71, 0x1b, 0xd1, 0xec, 0x2f, 0x8e, 0x07,
+	0x62, 0xe0, 0x93, 0x81, 0x78, 0xb5, 0x61, 0x90, 0x83, 0xce, 0x7e, 0xba, 0x86, 0x4d, 0x96, 0x1b,
+	0xfb, 0xe7, 0x86, 0x5d, 0xff, 0xed, 0x26, 0xe9, 0xb6, 0x90, 0x9d, 0xce, 0xa1, 0xda, 0xd9, 0x40,
+	0x4c, 0x74, 0x75, 0xb3, 0xb9, 0x2d, 0x53, 0x16, 0x59, 0x65, 0x74, 0xf2, 0x1e, 0x88, 0x57, 0xd0,
+	0x11, 0x29, 0xb5, 0x71, 0x0b, 0xdb, 0x7a, 0x13, 0xae, 0x80, 0x39, 0x62, 0x90, 0x26, 0x72, 0xe3,
+	0x8b, 0xaa, 0xf4, 0x05, 0x4a, 0x20, 0x56, 0x47, 0x76, 0xad, 0x6d, 0xd0, 0xd8, 0xdd, 0x18, 0x54,
+	0xbf, 0x69, 0x7b, 0xe9, 0xfd, 0x4b, 0x91, 0xfb, 0xff, 0x3f, 0x6f, 0xcc, 0xef, 0x60, 0x8b, 0x20,
+	0x8b, 0xc8, 0xff, 0xe3, 0xc0, 0x7c, 0x0e, 0xb5, 0xb0, 0x6d, 0x10, 0x78, 0x1b, 0xc4, 0x5a, 0x4c,
+	0x40, 0x33, 0xea, 0x2e, 0x75, 0x38, 0xbb, 0x7a, 0x36, 0x10, 0x21, 0x0d, 0xca, 0x77, 0x28, 0xab,
+	0xc0, 0x7b, 0xcb, 0xd7, 0xe1, 0x15, 0x10, 0xad, 0x53, 0x0e, 0xdc, 0x66, 0xaa, 0x23, 0x03, 0xac,
+	0x81, 0x88, 0x6e, 0xe2, 0x8e, 0x45, 0x84, 0x90, 0x14, 0xda, 0x88, 0x6d, 0xad, 0x7b, 0xc5, 0x74,
+	0x3a, 0x64, 0x58, 0xcd, 0x1d, 0x6c, 0x58, 0xd9, 0x9b, 0x4e, 0xbd, 0xfe, 0xfe, 0x46, 0xdc, 0xf8,
+	0x06, 0xf5, 0x72, 0x00, 0xb6, 0xca, 0xa8, 0xb7, 0x17, 0x9e, 0xbd, 0x14, 0x03, 0xef, 0x5f, 0x8a,
+	0x01, 0xf9, 0x8b, 0x79, 0xb0, 0x30, 0xac, 0xd3, 0x4f, 0xce, 0x4b, 0x69, 0xf9, 0x74, 0x20, 0x06,
+	0x8d, 0xfa, 0xd9, 0x40, 0x8c, 0xd2, 0xc4, 0x26, 0xf3, 0xb9, 0x03, 0xe6, 0x6b, 0xb4, 0x3e, 0x6e,
+	0x36, 0xb1, 0xad, 0x95, 0x34, 0xed, 0xa3, 0xb4, 0xd7, 0x47, 0xe9, 0x8c, 0xd5, 0xcd, 0xc6, 0xfe,
+	0x33, 0x2a, 0xa4, 0xea, 0x21, 0x60, 0x15, 0x44, 0x6c, 0xa2, 0x93, 0x8e, 0x2d, 0x84, 0xdc, 0xde,
+	0x91, 0xcf, 0xeb, 0x1d, 0x2f, 0xc0, 0xb2, 0xeb, 0x99, 0x4d, 0x9e, 0x0d, 0xc4, 0xd5, 0x89, 0x22,
+	0x53, 0x12, 0x59, 0x65, 0x6c, 0xb0, 0x05, 0xe0, 0x13, 0xc3, 0xd2, 0x9b, 0x1a, 0xd1, 0x9b, 0xcd,
+	0xae, 0xd6, 0x46, 0x76, 0xa7, 0x49, 0x84, 0xb0, 0x1b, 0x9f, 0x78, 0x9e, 0x46, 0xc5, 0xf1, 0x53,
+	0x5d, 0xb7, 0xec, 0x0f, 0x9c, 0xc2, 0x9e, 0x0d, 0xc4, 0x75, 0x2a, 0x32, 0x4d, 0x24, 0xab, 0xbc,
+	0x6b, 0xf4, 0x81, 0xe0, 0xaf, 0x41, 0xcc, 0xee, 0xec, 0x9b, 0x06, 0xd1, 0x9c, 0x89, 0x13, 0xe6,
+	0x5c, 0xa9, 0xe4, 0x54, 0x29, 0x2a, 0xde, 0x38, 0x66, 0x53, 0x4c, 0x85, 0xf5, 0x8b, 0x0f, 0x2c,
+	0x3f, 0x7f, 0x23, 0x72, 0x2a, 0xa0, 0x16, 0x07, 0x00, 0x0d, 0xc0, 0xb3, 0x16, 0xd1, 0x90, 0x55,
+	0xa7, 0x0a, 0x91, 0x0b, 0x15, 0x7e, 0xc8, 0x14, 0xd6, 0xa8, 0xc2, 0x24, 0x03, 0x95, 0x59, 0x64,
+	0x66, 0xc5, 0xaa, 0xbb, 0x52, 0xcf, 0x38, 0x90, 0x20, 0x98, 0xe8, 0x4d, 0x8d, 0x1d, 0x08, 0xf3,
+	0x17, 0x35, 0xe2, 0x3d, 0xa6, 0xb3, 0x42, 0x75, 0xc6, 0xd0, 0xf2, 0x4c, 0x0d, 0x1a, 0x77, 0xb1,
+	0xde, 0x88, 0x35, 0xc1, 0xa5, 0x43, 0x4c, 0x0c, 0xab, 0xe1, 0x7c, 0xde, 0x36, 0x2b, 0xec, 0xc2,
+	0x85, 0x69, 0xff, 0x88, 0x85, 0x23, 0xd0, 0x70, 0xa6, 0x28, 0x68, 0xde, 0x4b, 0xd4, 0x5e, 0x76,
+	0xcc, 0x6e, 0xe2, 0x4f, 0x00, 0x33, 0x8d, 0x4a, 0x1c, 0xbd, 0x50, 0x4b, 0x66, 0x5a, 0xab, 0x63,
+	0x5a, 0xe3, 0x15, 0x4e, 0x50, 0xab, 0x57, 0xe0, 0x9b, 0x60, 0xc1, 0x44, 0xb6, 0xad, 0x37, 0x90,
+	0x2d, 0x00, 0x29, 0xf4, 0x55, 0x03, 0xa3, 0x0e, 0xbd, 0xe0, 0x0e, 0x58, 0x62, 0xcf, 0xac, 0xff,
+	0x6c, 0x21, 0x26, 0x85, 0x36, 0xe2, 0xfe, 0x49, 0x98, 0x70, 0x90, 0xd5, 0x45, 0x66, 0xa1, 0xed,
+	0x69, 0x6f, 0x87, 0x9d, 0x65, 0x26, 0x1f, 0x07, 0x41, 0xcc, 0xdf, 0xb5, 0xbf, 0x04, 0xa1, 0x2e,
+	0xb2, 0xe9, 0x62, 0xcc, 0xa6, 0x67, 0x58, 0xc0, 0x79, 0x8b, 0xa8, 0x0e, 0x14, 0xde, 0x03, 0xf3,
+	0xfa, 0xbe, 0x4d, 0x74, 0x83, 0xad, 0xd0, 0x99, 0x59, 0x3c, 0x38, 0xbc, 0x0b, 0x82, 0x16, 0x16,
+	0x42, 0x1f, 0x44, 0x12, 0xb4, 0x30, 0x6c, 0x80, 0xb8, 0x85, 0xb5, 0xa7, 0x06, 0x39, 0xd0, 0x0e,
+	0x11, 0xc1, 0xee, 0xb4, 0x47, 0xb3, 0xca, 0x6c, 0x4c, 0x67, 0x03, 0x71, 0x99, 0x56, 0xd4, 0xcf,
+	0x25, 0xab, 0xc0, 0xc2, 0x7b, 0x06, 0x39, 0xa8, 0x22, 0x82, 0x59, 0x29, 0xff, 0xc5, 0x81, 0xb0,
+	0x73, 0xab, 0x7d, 0xf8, 0x4d, 0xb0, 0x02, 0xe6, 0x0e, 0x31, 0x41, 0xde, 0x2d, 0x40, 0x5f, 0xe0,
+	0x2e, 0x98, 0xa7, 0x17, 0xa4, 0x2d, 0x84, 0xdd, 0xf6, 0xb8, 0x7a, 0xde, 0xbe, 0x9a, 0xbe, 0x87,
+	0xb3, 0x61, 0x27, 0x53, 0xd5, 0x03, 0x6f, 0x2f, 0xbc, 0x60, 0x4b, 0xfe, 0x7e, 0x78, 0x21, 0xc4,
+	0x87, 0xbd, 0x6b, 0x57, 0xfe, 0x77, 0x10, 0x24, 0xd8, 0x84, 0x95, 0xf4, 0xb6, 0x6e, 0xda, 0xf0,
+	0x2f, 0x1c, 0x88, 0x99, 0x86, 0x35, 0x1c, 0x78, 0xee, 0xa2, 0x81, 0xd7, 0x1c, 0xa5, 0xd3, 0x81,
+	0x78, 0xd9, 0x87, 0xba, 0x8e, 0x4d, 0x83, 0x20, 0xb3, 0x45, 0xba, 0xa3, 0xcc, 0x7d, 0xc7, 0xb3,
+	0xed, 0x01, 0x60, 0x1a, 0x96, 0xb7, 0x05, 0xfe, 0xcc, 0x01, 0x68, 0xea, 0x47, 0x1e, 0x91, 0xd6,
+	0x42, 0x6d, 0x03, 0xd7, 0xd9, 0x5d, 0xb3, 0x3e, 0x35, 0x3a, 0x39, 0xf6, 0x9b, 0x85, 0x7e, 0xf8,
+	0xd3, 0x81, 0x78, 0x65, 0x1a, 0x3c, 0x16, 0x2b, 0xdb, 0xf2, 0xd3, 0x5e, 0xf2, 0x0b, 0x67, 0x7a,
+	0x79, 0x53, 0x3f, 0xf2, 0xca, 0x45, 0xcd, 0x7f, 0xe2, 0x40, 0xbc, 0xea, 0x8e, 0x34, 0xab, 0xdf,
+	0xef, 0x01, 0x1b, 0x71, 0x2f, 0x36, 0xee, 0xa2, 0xd8, 0xee, 0xb0, 0xd8, 0xd6, 0xc6, 0x70, 0x63,
+	0x61, 0xad, 0x8c, 0x6d, 0x14, 0x7f, 0x44, 0x71, 0x6a, 0x63, 0xd1, 0x7c, 0xea, 0x4d, 0x34, 0x0b,
+	0xe6, 0x31, 0x88, 0xfc, 0xae, 0x83, 0xdb, 0x1d, 0xd3, 0x8d, 0x22, 0x9e, 0xcd, 0xce, 0xf6, 0xab,
+	0xea, 0x74, 0x20, 0xf2, 0x14, 0x3f, 0x8a, 0x46, 0x65, 0x8c, 0xb0, 0x06, 0xa2, 0xe4, 0xa0, 0x8d,
+	0xec, 0x03, 0xdc, 0xa4, 0x1f, 0x20, 0x9e, 0x55, 0x66, 0xa6, 0x5f, 0x1e, 0x52, 0xf8, 0x14, 0x46,
+	0xbc, 0xb0, 0xc7, 0x81, 0x45, 0x67, 0xe6, 0xb4, 0x91, 0x54, 0xc8, 0x95, 0xaa, 0xcd, 0x2c, 0x25,
+	0x8c, 0xf3, 0x8c, 0xd5, 0xf7, 0x32, 0xab, 0xef, 0x98, 0x87, 0xac, 0x26, 0x1c, 0x43, 0x65, 0xf8,
+	0xfe, 0x8f, 0x39, 0x00, 0xbd, 0x9f, 0x1f, 0x95, 0x6e, 0x0b, 0xb1, 0x22, 0xff, 0x1c, 0x24, 0x86,
+	0x53, 0xed, 0x08, 0xb2, 0x05, 0x2a, 0x8c, 0xbe, 0xdb, 0xd8, 0xb1, 0xac, 0xc6, 0x5b, 0x3e, 0x92,
+	0xa9, 0x81, 0x0b, 0x7e, 0xa7, 0x06, 0xee, 0xe9, 0x64, 0x3b, 0x87, 0x2e, 0x6a, 0xe7, 0xdb, 0x1f,
+	0xa5, 0x95, 0x9d, 0x1f, 0x83, 0xac, 0x75, 0xc3, 0xee, 0x07, 0xbf, 0xfb, 0x91, 0xda, 0x56, 0xf3,
+	0xb7, 0xed, 0x9c, 0x4b, 0x9d, 0xf9, 0xa8, 0x2d, 0xfb, 0xc7, 0xe9, 0x96, 0x8d, 0xb8, 0x32, 0xda,
+	0xb7, 0xdb, 0xae, 0xf4, 0x66, 0xba, 0xf6, 0x39, 0x07, 0x80, 0xef, 0xef, 0xb3, 0xeb, 0x60, 0xad,
+	0x5a, 0xac, 0x28, 0x5a, 0xb1, 0x54, 0xc9, 0x17, 0x0b, 0xda, 0xc3, 0x42, 0xb9, 0xa4, 0xec, 0xe4,
+	0x77, 0xf3, 0x4a, 0x8e, 0x0f, 0x24, 0x97, 0x7a, 0x7d, 0x29, 0x46, 0x1d, 0x15, 0x47, 0x0a, 0xca,
+	0x60, 0xc9, 0xef, 0xfd, 0x48, 0x29, 0xf3, 0x5c, 0x32, 0xd1, 0xeb, 0x4b, 0x51, 0xea, 0xf5, 0x08,
+	0xd9, 0xf0, 0x1a, 0x58, 0xf6, 0xfb, 0x64, 0xb2, 0xe5, 0x4a, 0x26, 0x5f, 0xe0, 0x83, 0xc9, 0x4b,
+	0xbd, 0xbe, 0x94, 0xa0, 0x7e, 0x19, 0x76, 0xab, 0x4b, 0x60, 0xd1, 0xef, 0x5b, 0x28, 0xf2, 0xa1,
+	0x64, 0xbc, 0xd7, 0x97, 0x16, 0xa8, 0x5b, 0x01, 0xc3, 0x2d, 0x20, 0x8c, 0x7b, 0x68, 0x7b, 0xf9,
+	0xca, 0x3d, 0xad, 0xaa, 0x54, 0x8a, 0x7c, 0x38, 0xb9, 0xd2, 0xeb, 0x4b, 0xbc, 0xe7, 0xeb, 0x5d,
+	0xc1, 0xc9, 0xf0, 0xb3, 0xbf, 0xa6, 0x02, 0xd7, 0xfe, 0x1b, 0x04, 0x8b, 0xe3, 0x7f, 0x1c, 0xc0,
+	0x34, 0xf8, 0x5e, 0x49, 0x2d, 0x96, 0x8a, 0xe5, 0xcc, 0x03, 0xad, 0x5c, 0xc9, 0x54, 0x1e, 0x96,
+	0x27, 0x12, 0x76, 0x53, 0xa1, 0xce, 0x05, 0xa3, 0x09, 0xef, 0x80, 0xd4, 0xa4, 0x7f, 0x4e, 0x29,
+	0x15, 0xcb, 0xf9, 0x8a, 0x56, 0x52, 0xd4, 0x7c, 0x31, 0xc7, 0x73, 0xc9, 0xb5, 0x5e, 0x5f, 0x5a,
+	0xa6, 0x90, 0xb1, 0x9b, 0x00, 0xfe, 0x0c, 0x7c, 0x7f, 0x12, 0x5c, 0x2d, 0x56, 0xf2, 0x85, 0x5f,
+	0x79, 0xd8, 0x60, 0x72, 0xb5, 0xd7, 0x97, 0x20, 0xc5, 0x56, 0xfd, 0xbd, 0x7e, 0x1d, 0xac, 0x4e,
+	0x42, 0x4b, 0x99, 0x72, 0x59, 0xc9, 0xf1, 0xa1, 0x24, 0xdf, 0xeb, 0x4b, 0x71, 0x8a, 0x29, 0xe9,
+	0xb6, 0x8d, 0xea, 0xf0, 0x26, 0x10, 0x26, 0xbd, 0x55, 0xe5, 0xbe, 0xb2, 0x53, 0x51, 0x72, 0x7c,
+	0x38, 0x09, 0x7b, 0x7d, 0x69, 0x91, 0xfa, 0xab, 0xe8, 0x37, 0xa8, 0x46, 0xd0, 0xb9, 0xfc, 0xbb,
+	0x99, 0xfc, 0x03, 0x25, 0xc7, 0xcf, 0xf9, 0xf9, 0x77, 0x75, 0xa3, 0x89, 0xea, 0xb4, 0x9c, 0xd9,
+	0xc2, 0xf1, 0xdb, 0x54, 0xe0, 0xf5, 0xdb, 0x54, 0xe0, 0x0f, 0x27, 0xa9, 0xc0, 0xf1, 0x49, 0x8a,
+	0x7b, 0x75, 0x92, 0xe2, 0x3e, 0x3b, 0x49, 0x71, 0xcf, 0xdf, 0xa5, 0x02, 0xaf, 0xde, 0xa5, 0x02,
+	0xaf, 0xdf, 0xa5, 0x02, 0x8f, 0xbf, 0x7e, 0xa9, 0x1c, 0xb9, 0xff, 0xf9, 0xe1, 0x76, 0xf5, 0x7e,
+	0xc4, 0xdd, 0x14, 0x3f, 0xfe, 0x72, 0x00, 0xce, 0x91, 0x20, 0xb6, 0x17, 0x11, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -675,6 +745,22 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if len(this.MessageResults) != len(that1.MessageResults) {
+		return false
+	}
+	for i := range this.MessageResults {
+		if !bytes.Equal(this.MessageResults[i], that1.MessageResults[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -710,6 +796,68 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ProposalTypeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposalTypeParams)
+	if !ok {
+		that2, ok := that.(ProposalTypeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalType != that1.ProposalType {
+		return false
+	}
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
+		return false
+	}
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
+		}
+	}
+	if this.VotingPeriod != nil && that1.VotingPeriod != nil {
+		if *this.VotingPeriod != *that1.VotingPeriod {
+			return false
+		}
+	} else if this.VotingPeriod != nil {
+		return false
+	} else if that1.VotingPeriod != nil {
+		return false
+	}
+	if that1.Quorum == nil {
+		if this.Quorum != nil {
+			return false
+		}
+	} else if !this.Quorum.Equal(*that1.Quorum) {
+		return false
+	}
+	if that1.Threshold == nil {
+		if this.Threshold != nil {
+			return false
+		}
+	} else if !this.Threshold.Equal(*that1.Threshold) {
+		return false
+	}
+	if that1.VetoThreshold == nil {
+		if this.VetoThreshold != nil {
+			return false
+		}
+	} else if !this.VetoThreshold.Equal(*that1.VetoThreshold) {
+		return false
+	}
+	return true
+}
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -854,6 +1002,29 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageResults) > 0 {
+		for iNdEx := len(m.MessageResults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MessageResults[iNdEx])
+			copy(dAtA[i:], m.MessageResults[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.MessageResults[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -1176,6 +1347,96 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTypeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTypeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTypeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VetoThreshold != nil {
+		{
+			size := m.VetoThreshold.Size()
+			i -= size
+			if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Threshold != nil {
+		{
+			size := m.Threshold.Size()
+			i -= size
+			if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Quorum != nil {
+		{
+			size := m.Quorum.Size()
+			i -= size
+			if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1272,6 +1533,18 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MessageResults) > 0 {
+		for _, b := range m.MessageResults {
+			l = len(b)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1357,6 +1630,41 @@ func (m *TallyParams) Size() (n int) {
 	return n
 }
 
+func (m *ProposalTypeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Threshold != nil {
+		l = m.Threshold.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VetoThreshold != nil {
+		l = m.VetoThreshold.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2017,6 +2325,72 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageResults", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageResults = append(m.MessageResults, make([]byte, postIndex-iNdEx))
+			copy(m.MessageResults[len(m.MessageResults)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2708,6 +3082,263 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalTypeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTypeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTypeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Quorum = &v
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Threshold = &v
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VetoThreshold = &v
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
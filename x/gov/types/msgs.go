@@ -24,11 +24,17 @@ const (
 	TypeSvcMsgVote           = "/cosmos.gov.v1beta1.Msg/Vote"
 	TypeSvcMsgVoteWeighted   = "/cosmos.gov.v1beta1.Msg/VoteWeighted"
 	TypeSvcMsgSubmitProposal = "/cosmos.gov.v1beta1.Msg/SubmitProposal"
+
+	// TypeSvcMsgExecLegacyContent is the method name of the
+	// MsgExecLegacyContent service msg.
+	TypeSvcMsgExecLegacyContent = "/cosmos.gov.v1beta1.Msg/ExecLegacyContent"
 )
 
 var (
 	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
 	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_          sdk.MsgRequest                = &MsgExecLegacyContent{}
+	_          types.UnpackInterfacesMessage = &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -45,6 +51,20 @@ func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sd
 	return m, nil
 }
 
+// NewMsgSubmitMsgsProposal creates a new MsgSubmitProposal executing the given
+// msgs with the gov module account as signer if the proposal passes.
+//nolint:interfacer
+func NewMsgSubmitMsgsProposal(msgs []sdk.ServiceMsg, initialDeposit sdk.Coins, proposer sdk.AccAddress) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer.String(),
+	}
+	if err := m.SetMsgs(msgs); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (m *MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return m.InitialDeposit }
 
 func (m *MsgSubmitProposal) GetProposer() sdk.AccAddress {
@@ -53,6 +73,9 @@ func (m *MsgSubmitProposal) GetProposer() sdk.AccAddress {
 }
 
 func (m *MsgSubmitProposal) GetContent() Content {
+	if m.Content == nil {
+		return nil
+	}
 	content, ok := m.Content.GetCachedValue().(Content)
 	if !ok {
 		return nil
@@ -81,6 +104,21 @@ func (m *MsgSubmitProposal) SetContent(content Content) error {
 	return nil
 }
 
+// SetMsgs packs the given service msgs into the msg.
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.ServiceMsg) error {
+	anys, err := packServiceMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
+// GetMsgs returns the unpacked service msgs of the proposal.
+func (m MsgSubmitProposal) GetMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(m.Messages)
+}
+
 // Route implements Msg
 func (m MsgSubmitProposal) Route() string { return RouterKey }
 
@@ -99,6 +137,17 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
 	}
 
+	if len(m.Messages) > 0 {
+		if m.Content != nil {
+			return sdkerrors.Wrap(ErrInvalidProposalContent, "content and messages are mutually exclusive")
+		}
+		msgs, err := m.GetMsgs()
+		if err != nil {
+			return err
+		}
+		return ValidateProposalMsgs(msgs)
+	}
+
 	content := m.GetContent()
 	if content == nil {
 		return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
@@ -134,7 +183,10 @@ func (m MsgSubmitProposal) String() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+		return err
+	}
+	return unpackServiceMsgAnys(unpacker, m.Messages)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -288,3 +340,66 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgExecLegacyContent creates a new MsgExecLegacyContent wrapping the given
+// legacy proposal content.
+//nolint:interfacer
+func NewMsgExecLegacyContent(content Content, authority sdk.AccAddress) (*MsgExecLegacyContent, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", content)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExecLegacyContent{
+		Content:   any,
+		Authority: authority.String(),
+	}, nil
+}
+
+// GetContent returns the wrapped legacy proposal content.
+func (m MsgExecLegacyContent) GetContent() Content {
+	if m.Content == nil {
+		return nil
+	}
+	content, ok := m.Content.GetCachedValue().(Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgExecLegacyContent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Authority)
+	}
+	content := m.GetContent()
+	if content == nil {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
+	}
+	if !IsValidProposalType(content.ProposalType()) {
+		return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
+	}
+	return content.ValidateBasic()
+}
+
+// GetSigners implements MsgRequest.GetSigners, the authority must sign the msg.
+func (m MsgExecLegacyContent) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// String implements the Stringer interface
+func (m MsgExecLegacyContent) String() string {
+	out, _ := yaml.Marshal(m)
+	return string(out)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgExecLegacyContent) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var content Content
+	return unpacker.UnpackAny(m.Content, &content)
+}
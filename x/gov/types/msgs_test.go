@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var (
@@ -59,6 +61,62 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitMsgsProposal(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	send := func(from sdk.AccAddress) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(from, addrs[1], coinsPos),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		msgs       []sdk.ServiceMsg
+		content    Content
+		expectPass bool
+	}{
+		{"signed by gov account", []sdk.ServiceMsg{send(govAddr)}, nil, true},
+		{"signed by another account", []sdk.ServiceMsg{send(govAddr), send(addrs[0])}, nil, false},
+		{"invalid msg", []sdk.ServiceMsg{send(sdk.AccAddress{})}, nil, false},
+		{"with content", []sdk.ServiceMsg{send(govAddr)}, NewTextProposal("Test Proposal", "the purpose of this proposal is to test"), false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgSubmitMsgsProposal(tc.msgs, coinsPos, addrs[0])
+			require.NoError(t, err)
+			if tc.content != nil {
+				require.NoError(t, msg.SetContent(tc.content))
+			}
+
+			msgs, err := msg.GetMsgs()
+			require.NoError(t, err)
+			require.Equal(t, tc.msgs, msgs)
+
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic())
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgExecLegacyContent(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+
+	msg, err := NewMsgExecLegacyContent(NewTextProposal("Test Proposal", "the purpose of this proposal is to test"), govAddr)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{govAddr}, msg.GetSigners())
+
+	msg, err = NewMsgExecLegacyContent(NewTextProposal("", "the purpose of this proposal is to test"), govAddr)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
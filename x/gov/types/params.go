@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -28,6 +29,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyProposalTypeParams = []byte("proposaltypeparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -36,6 +39,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyProposalTypeParams, []ProposalTypeParams{}, validateProposalTypeParams),
 	)
 }
 
@@ -171,11 +175,163 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
+// String implements stringer interface
+func (ptp ProposalTypeParams) String() string {
+	out, _ := yaml.Marshal(ptp)
+	return string(out)
+}
+
+// ValidateBasic checks that the overrides are within the same ranges as the
+// global params.
+func (ptp ProposalTypeParams) ValidateBasic() error {
+	if strings.TrimSpace(ptp.ProposalType) == "" {
+		return fmt.Errorf("proposal type cannot be blank")
+	}
+	if len(ptp.MinDeposit) > 0 && !ptp.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit of %s: %s", ptp.ProposalType, ptp.MinDeposit)
+	}
+	if ptp.VotingPeriod != nil && *ptp.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period of %s must be positive: %s", ptp.ProposalType, ptp.VotingPeriod)
+	}
+	if ptp.Quorum != nil && (ptp.Quorum.IsNegative() || ptp.Quorum.GT(sdk.OneDec())) {
+		return fmt.Errorf("quorum of %s must be between 0 and 1: %s", ptp.ProposalType, ptp.Quorum)
+	}
+	if ptp.Threshold != nil && (!ptp.Threshold.IsPositive() || ptp.Threshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("vote threshold of %s must be positive and at most 1: %s", ptp.ProposalType, ptp.Threshold)
+	}
+	if ptp.VetoThreshold != nil && (!ptp.VetoThreshold.IsPositive() || ptp.VetoThreshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("veto threshold of %s must be positive and at most 1: %s", ptp.ProposalType, ptp.VetoThreshold)
+	}
+
+	return nil
+}
+
+// ValidateProposalTypeParams validates a list of params overrides, checking
+// that no proposal type is overridden twice.
+func ValidateProposalTypeParams(params []ProposalTypeParams) error {
+	seen := make(map[string]bool, len(params))
+	for _, ptp := range params {
+		if err := ptp.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[ptp.ProposalType] {
+			return fmt.Errorf("duplicate params for proposal type %s", ptp.ProposalType)
+		}
+		seen[ptp.ProposalType] = true
+	}
+
+	return nil
+}
+
+func validateProposalTypeParams(i interface{}) error {
+	v, ok := i.([]ProposalTypeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateProposalTypeParams(v)
+}
+
+// ProposalParams holds the deposit, voting and tally params applying to a
+// given proposal, once its params overrides are applied to the global params.
+type ProposalParams struct {
+	MinDeposit   sdk.Coins     `json:"min_deposit" yaml:"min_deposit"`
+	VotingPeriod time.Duration `json:"voting_period" yaml:"voting_period"`
+	TallyParams  TallyParams   `json:"tally_params" yaml:"tally_params"`
+}
+
+// ResolveProposalParams returns the params applying to a proposal of the given
+// types. The overrides of a type replace the fields they set in the global
+// params. When a proposal has several types, i.e. several messages, the
+// strictest params of all its types apply: the highest minimum deposit,
+// quorum and threshold, the longest voting period and the lowest veto
+// threshold.
+func ResolveProposalParams(
+	proposalTypes []string, dp DepositParams, vp VotingParams, tp TallyParams, overrides []ProposalTypeParams,
+) ProposalParams {
+	global := ProposalParams{
+		MinDeposit:   dp.MinDeposit,
+		VotingPeriod: vp.VotingPeriod,
+		TallyParams:  tp,
+	}
+	if len(proposalTypes) == 0 {
+		return global
+	}
+
+	byType := make(map[string]ProposalTypeParams, len(overrides))
+	for _, ptp := range overrides {
+		byType[ptp.ProposalType] = ptp
+	}
+
+	var resolved ProposalParams
+	for i, proposalType := range proposalTypes {
+		params := global
+		if ptp, ok := byType[proposalType]; ok {
+			params = ptp.apply(global)
+		}
+		if i == 0 {
+			resolved = params
+			continue
+		}
+		resolved = resolved.strictest(params)
+	}
+
+	return resolved
+}
+
+// apply returns the given params with the fields set by the overrides replaced.
+func (ptp ProposalTypeParams) apply(params ProposalParams) ProposalParams {
+	if len(ptp.MinDeposit) > 0 {
+		params.MinDeposit = ptp.MinDeposit
+	}
+	if ptp.VotingPeriod != nil {
+		params.VotingPeriod = *ptp.VotingPeriod
+	}
+	if ptp.Quorum != nil {
+		params.TallyParams.Quorum = *ptp.Quorum
+	}
+	if ptp.Threshold != nil {
+		params.TallyParams.Threshold = *ptp.Threshold
+	}
+	if ptp.VetoThreshold != nil {
+		params.TallyParams.VetoThreshold = *ptp.VetoThreshold
+	}
+
+	return params
+}
+
+// strictest combines two params, keeping the strictest value of each field.
+func (pp ProposalParams) strictest(other ProposalParams) ProposalParams {
+	minDeposit := pp.MinDeposit
+	for _, coin := range other.MinDeposit {
+		if amount := minDeposit.AmountOf(coin.Denom); amount.LT(coin.Amount) {
+			minDeposit = minDeposit.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))).Add(coin)
+		}
+	}
+
+	votingPeriod := pp.VotingPeriod
+	if other.VotingPeriod > votingPeriod {
+		votingPeriod = other.VotingPeriod
+	}
+
+	return ProposalParams{
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		TallyParams: NewTallyParams(
+			sdk.MaxDec(pp.TallyParams.Quorum, other.TallyParams.Quorum),
+			sdk.MaxDec(pp.TallyParams.Threshold, other.TallyParams.Threshold),
+			sdk.MinDec(pp.TallyParams.VetoThreshold, other.TallyParams.VetoThreshold),
+		),
+	}
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
 	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+
+	ProposalTypeParams []ProposalTypeParams `json:"proposal_type_params,omitempty" yaml:"proposal_type_params,omitempty"`
 }
 
 func (gp Params) String() string {
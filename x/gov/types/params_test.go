@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateProposalTypeParams(t *testing.T) {
+	period := time.Hour
+	negativePeriod := -time.Hour
+	half := sdk.NewDecWithPrec(5, 1)
+	zero := sdk.ZeroDec()
+	two := sdk.NewDec(2)
+
+	tests := []struct {
+		name       string
+		params     []ProposalTypeParams
+		expectPass bool
+	}{
+		{"empty", nil, true},
+		{"valid", []ProposalTypeParams{{ProposalType: ProposalTypeText, MinDeposit: coinsPos, VotingPeriod: &period, Quorum: &half, Threshold: &half, VetoThreshold: &half}}, true},
+		{"only quorum", []ProposalTypeParams{{ProposalType: ProposalTypeText, Quorum: &zero}}, true},
+		{"blank type", []ProposalTypeParams{{ProposalType: " ", Quorum: &half}}, false},
+		{"duplicate type", []ProposalTypeParams{{ProposalType: ProposalTypeText}, {ProposalType: ProposalTypeText}}, false},
+		{"negative voting period", []ProposalTypeParams{{ProposalType: ProposalTypeText, VotingPeriod: &negativePeriod}}, false},
+		{"quorum too large", []ProposalTypeParams{{ProposalType: ProposalTypeText, Quorum: &two}}, false},
+		{"zero threshold", []ProposalTypeParams{{ProposalType: ProposalTypeText, Threshold: &zero}}, false},
+		{"veto threshold too large", []ProposalTypeParams{{ProposalType: ProposalTypeText, VetoThreshold: &two}}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProposalTypeParams(tc.params)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestResolveProposalParams(t *testing.T) {
+	dp := DefaultDepositParams()
+	vp := DefaultVotingParams()
+	tp := DefaultTallyParams()
+
+	expedited := time.Hour
+	strictQuorum := sdk.NewDecWithPrec(5, 1)
+	lowVeto := sdk.NewDecWithPrec(1, 1)
+	bigDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens.MulRaw(10)))
+	overrides := []ProposalTypeParams{
+		{ProposalType: "SoftwareUpgrade", VotingPeriod: &expedited},
+		{ProposalType: "CommunityPoolSpend", MinDeposit: bigDeposit, Quorum: &strictQuorum, VetoThreshold: &lowVeto},
+	}
+
+	global := ResolveProposalParams(nil, dp, vp, tp, overrides)
+	require.Equal(t, dp.MinDeposit, global.MinDeposit)
+	require.Equal(t, vp.VotingPeriod, global.VotingPeriod)
+	require.True(t, tp.Equal(global.TallyParams))
+
+	noOverride := ResolveProposalParams([]string{ProposalTypeText}, dp, vp, tp, overrides)
+	require.Equal(t, global, noOverride)
+
+	upgrade := ResolveProposalParams([]string{"SoftwareUpgrade"}, dp, vp, tp, overrides)
+	require.Equal(t, expedited, upgrade.VotingPeriod)
+	require.Equal(t, dp.MinDeposit, upgrade.MinDeposit)
+	require.True(t, tp.Equal(upgrade.TallyParams))
+
+	spend := ResolveProposalParams([]string{"CommunityPoolSpend"}, dp, vp, tp, overrides)
+	require.Equal(t, vp.VotingPeriod, spend.VotingPeriod)
+	require.Equal(t, bigDeposit, spend.MinDeposit)
+	require.True(t, NewTallyParams(strictQuorum, tp.Threshold, lowVeto).Equal(spend.TallyParams))
+
+	// the strictest params of all the types apply
+	both := ResolveProposalParams([]string{"SoftwareUpgrade", "CommunityPoolSpend"}, dp, vp, tp, overrides)
+	require.Equal(t, vp.VotingPeriod, both.VotingPeriod)
+	require.Equal(t, bigDeposit, both.MinDeposit)
+	require.True(t, NewTallyParams(strictQuorum, tp.Threshold, lowVeto).Equal(both.TallyParams))
+
+	// deposits in different denoms are all required
+	otherDenom := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	overrides = append(overrides, ProposalTypeParams{ProposalType: ProposalTypeText, MinDeposit: otherDenom})
+	mixed := ResolveProposalParams([]string{ProposalTypeText, "CommunityPoolSpend"}, dp, vp, tp, overrides)
+	require.Equal(t, bigDeposit.Add(otherDenom...), mixed.MinDeposit)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // DefaultStartingProposalID is 1
@@ -42,14 +43,37 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 	return p, nil
 }
 
+// NewMsgsProposal creates a new Proposal instance executing the given msgs
+// with the gov module account as signer when it passes.
+func NewMsgsProposal(msgs []sdk.ServiceMsg, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+	anys, err := packServiceMsgs(msgs)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	return Proposal{
+		ProposalId:       id,
+		Status:           StatusDepositPeriod,
+		FinalTallyResult: EmptyTallyResult(),
+		TotalDeposit:     sdk.NewCoins(),
+		SubmitTime:       submitTime,
+		DepositEndTime:   depositEndTime,
+		Messages:         anys,
+	}, nil
+}
+
 // String implements stringer interface
 func (p Proposal) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
 
-// GetContent returns the proposal Content
+// GetContent returns the proposal Content, or nil if the proposal is made of
+// messages.
 func (p Proposal) GetContent() Content {
+	if p.Content == nil {
+		return nil
+	}
 	content, ok := p.Content.GetCachedValue().(Content)
 	if !ok {
 		return nil
@@ -57,6 +81,34 @@ func (p Proposal) GetContent() Content {
 	return content
 }
 
+// GetMsgs returns the unpacked service msgs of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(p.Messages)
+}
+
+// IsMsgsProposal returns true if the proposal executes messages instead of a
+// legacy Content.
+func (p Proposal) IsMsgsProposal() bool {
+	return len(p.Messages) > 0
+}
+
+// ParamsTypes returns the types used to look up the params overrides applying
+// to the proposal: the type URLs of its messages, or the type of its content.
+func (p Proposal) ParamsTypes() []string {
+	if p.IsMsgsProposal() {
+		types := make([]string, len(p.Messages))
+		for i, any := range p.Messages {
+			types[i] = any.TypeUrl
+		}
+		return types
+	}
+
+	if proposalType := p.ProposalType(); proposalType != "" {
+		return []string{proposalType}
+	}
+	return nil
+}
+
 func (p Proposal) ProposalType() string {
 	content := p.GetContent()
 	if content == nil {
@@ -84,7 +136,10 @@ func (p Proposal) GetTitle() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+		return err
+	}
+	return unpackServiceMsgAnys(unpacker, p.Messages)
 }
 
 // Proposals is an array of proposal
@@ -261,3 +316,62 @@ func ProposalHandler(_ sdk.Context, c Content) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov proposal type: %s", c.ProposalType())
 	}
 }
+
+// ValidateProposalMsgs performs a basic validation of the msgs of a proposal,
+// which must all be signed by the gov module account only.
+func ValidateProposalMsgs(msgs []sdk.ServiceMsg) error {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(ErrInvalidSigner, "msg %d %s", i, msg.MethodName)
+		}
+	}
+	return nil
+}
+
+// packServiceMsgs packs service msgs into Anys whose type URL is the method
+// name of the msg, as done for the messages of a transaction.
+func packServiceMsgs(msgs []sdk.ServiceMsg) ([]*types.Any, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		if msg.Request == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "request of msg %s is empty", msg.MethodName)
+		}
+		any, err := types.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+// unpackServiceMsgs returns the service msgs cached in the given Anys.
+func unpackServiceMsgs(anys []*types.Any) ([]sdk.ServiceMsg, error) {
+	msgs := make([]sdk.ServiceMsg, len(anys))
+	for i, any := range anys {
+		req, ok := any.GetCachedValue().(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %s which is not a sdk.MsgRequest", any.TypeUrl)
+		}
+		msgs[i] = sdk.ServiceMsg{
+			MethodName: any.TypeUrl,
+			Request:    req,
+		}
+	}
+	return msgs, nil
+}
+
+func unpackServiceMsgAnys(unpacker types.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var req sdk.MsgRequest
+		if err := unpacker.UnpackAny(any, &req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -421,7 +421,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "proposal_type".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -473,6 +473,9 @@ type QueryParamsResponse struct {
 	DepositParams DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
 	// tally_params defines the parameters related to tally.
 	TallyParams TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+	// proposal_type_params defines the params overriding the global ones for
+	// proposals of given types.
+	ProposalTypeParams []ProposalTypeParams `protobuf:"bytes,4,rep,name=proposal_type_params,json=proposalTypeParams,proto3" json:"proposal_type_params"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -529,6 +532,13 @@ func (m *QueryParamsResponse) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *QueryParamsResponse) GetProposalTypeParams() []ProposalTypeParams {
+	if m != nil {
+		return m.ProposalTypeParams
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -839,68 +849,69 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x4b, 0x9c, 0xd6, 0x9e, 0xb4, 0x01, 0x86, 0x00, 0xd6, 0x12, 0xec, 0xb0, 0xa2, 0xad,
-	0x49, 0xa9, 0x97, 0x24, 0x05, 0xd4, 0x16, 0x50, 0x89, 0x50, 0x5b, 0x54, 0x09, 0x15, 0xa7, 0x02,
-	0x89, 0x03, 0xd1, 0xa6, 0x5e, 0x2d, 0x2b, 0x1c, 0xbf, 0xad, 0xdf, 0xb3, 0x45, 0x14, 0x22, 0x24,
-	0x4e, 0x20, 0x2e, 0xa0, 0x22, 0x6e, 0x88, 0x4a, 0x95, 0xf8, 0x2d, 0x3d, 0x56, 0x82, 0x03, 0x27,
-	0x84, 0x12, 0x0e, 0x88, 0xdf, 0xc0, 0x01, 0xed, 0x7b, 0xf3, 0xd6, 0xbb, 0xce, 0x3a, 0xbb, 0x29,
-	0x55, 0x4f, 0xb1, 0xe7, 0x7d, 0x33, 0xf3, 0x7d, 0x33, 0xf3, 0xe6, 0xc5, 0x50, 0xbf, 0xc5, 0xc5,
-	0x16, 0x17, 0x8e, 0xcf, 0x87, 0xce, 0x70, 0x79, 0xd3, 0x93, 0xee, 0xb2, 0x73, 0x7b, 0xe0, 0xf5,
-	0xb7, 0x5b, 0x61, 0x9f, 0x4b, 0x8e, 0xa8, 0xcf, 0x5b, 0x3e, 0x1f, 0xb6, 0xe8, 0xdc, 0x5a, 0x22,
-	0x9f, 0x4d, 0x57, 0x78, 0x1a, 0x1c, 0xbb, 0x86, 0xae, 0x1f, 0xf4, 0x5c, 0x19, 0xf0, 0x9e, 0xf6,
-	0xb7, 0xe6, 0x7d, 0xee, 0x73, 0xf5, 0xd1, 0x89, 0x3e, 0x91, 0x75, 0xc1, 0xe7, 0xdc, 0xef, 0x7a,
-	0x8e, 0x1b, 0x06, 0x8e, 0xdb, 0xeb, 0x71, 0xa9, 0x5c, 0x84, 0x39, 0xcd, 0xe0, 0x14, 0xe5, 0x57,
-	0xa7, 0xf6, 0x1b, 0x30, 0xff, 0x41, 0x94, 0xf3, 0x46, 0x9f, 0x87, 0x5c, 0xb8, 0xdd, 0xb6, 0x77,
-	0x7b, 0xe0, 0x09, 0x89, 0x0d, 0x98, 0x0d, 0xc9, 0xb4, 0x11, 0x74, 0x6a, 0x6c, 0x91, 0x35, 0xcb,
-	0x6d, 0x30, 0xa6, 0xf7, 0x3a, 0xf6, 0x47, 0xf0, 0xcc, 0x98, 0xa3, 0x08, 0x79, 0x4f, 0x78, 0xf8,
-	0x36, 0x54, 0x0c, 0x4c, 0xb9, 0xcd, 0xae, 0x2c, 0xb4, 0x0e, 0xca, 0x6e, 0x19, 0xbf, 0xb5, 0xf2,
-	0xfd, 0x3f, 0x1a, 0xa5, 0x76, 0xec, 0x63, 0xff, 0xc3, 0xc6, 0x22, 0x0b, 0xc3, 0xe9, 0x3a, 0x3c,
-	0x11, 0x73, 0x12, 0xd2, 0x95, 0x03, 0xa1, 0x12, 0xcc, 0xad, 0xd8, 0x87, 0x25, 0x58, 0x57, 0xc8,
-	0xf6, 0x5c, 0x98, 0xfa, 0x8e, 0xf3, 0x30, 0x33, 0xe4, 0xd2, 0xeb, 0xd7, 0xa6, 0x16, 0x59, 0xb3,
-	0xda, 0xd6, 0x5f, 0x70, 0x01, 0xaa, 0x1d, 0x2f, 0xe4, 0x22, 0x90, 0xbc, 0x5f, 0x9b, 0x56, 0x27,
-	0x23, 0x03, 0x5e, 0x01, 0x18, 0xb5, 0xa4, 0x56, 0x56, 0xe2, 0x4e, 0x9b, 0xdc, 0x51, 0xff, 0x5a,
-	0xba, 0xd9, 0x31, 0x05, 0xd7, 0xf7, 0x88, 0x7c, 0x3b, 0xe1, 0x79, 0xb1, 0xf2, 0xf5, 0xdd, 0x46,
-	0xe9, 0xef, 0xbb, 0x8d, 0x92, 0x7d, 0x8f, 0xc1, 0xb3, 0xe3, 0x62, 0xa9, 0x8e, 0x97, 0xa1, 0x6a,
-	0x28, 0x47, 0x3a, 0xa7, 0x0b, 0x16, 0x72, 0xe4, 0x84, 0x57, 0x53, 0x74, 0xa7, 0x14, 0xdd, 0x33,
-	0xb9, 0x74, 0x75, 0xfa, 0x24, 0x5f, 0x7b, 0x1d, 0x9e, 0x54, 0x24, 0x3f, 0xe4, 0xd2, 0x2b, 0x3a,
-	0x20, 0xd9, 0x05, 0x4e, 0x48, 0xbf, 0x0a, 0x4f, 0x25, 0x82, 0x92, 0xe8, 0x15, 0x28, 0x47, 0x38,
-	0x1a, 0x9c, 0x5a, 0x96, 0xde, 0x08, 0x4f, 0x5a, 0x15, 0xd6, 0xfe, 0x22, 0x11, 0x48, 0x14, 0xa6,
-	0x77, 0x25, 0xa3, 0x38, 0x0f, 0xd1, 0x4b, 0xfb, 0x0e, 0x03, 0x4c, 0xa6, 0x27, 0x21, 0xe7, 0xb5,
-	0x7a, 0xd3, 0xb9, 0x3c, 0x25, 0x1a, 0xfc, 0xe8, 0x3a, 0xf6, 0x1a, 0x91, 0xba, 0xe1, 0xf6, 0xdd,
-	0xad, 0x54, 0x51, 0x94, 0x61, 0x43, 0x6e, 0x87, 0xba, 0xc8, 0xd5, 0xc8, 0x2d, 0x32, 0xdd, 0xdc,
-	0x0e, 0x3d, 0xfb, 0x5f, 0x06, 0x4f, 0xa7, 0xfc, 0x48, 0xcd, 0x75, 0x38, 0x39, 0xe4, 0x32, 0xe8,
-	0xf9, 0x1b, 0x1a, 0x4c, 0xfd, 0x59, 0x9c, 0xa0, 0x2a, 0xe8, 0xf9, 0x3a, 0x00, 0xa9, 0x3b, 0x31,
-	0x4c, 0xd8, 0xf0, 0x7d, 0x98, 0xa3, 0x2b, 0x65, 0xa2, 0x69, 0xa1, 0x2f, 0x66, 0x45, 0x7b, 0x57,
-	0x23, 0x53, 0xe1, 0x4e, 0x76, 0x92, 0x46, 0xbc, 0x06, 0x27, 0xa4, 0xdb, 0xed, 0x6e, 0x9b, 0x68,
-	0xd3, 0x2a, 0x5a, 0x23, 0x2b, 0xda, 0xcd, 0x08, 0x97, 0x8a, 0x35, 0x2b, 0x47, 0x26, 0xfb, 0x13,
-	0x52, 0x4f, 0x49, 0x0b, 0xcf, 0x52, 0x6a, 0x6b, 0x4c, 0x8d, 0x6d, 0x8d, 0xc4, 0xc8, 0xaf, 0xd3,
-	0xb2, 0x8d, 0xe3, 0x53, 0x79, 0x2f, 0xc1, 0x71, 0x82, 0x53, 0x61, 0x9f, 0x3f, 0xa4, 0x14, 0x44,
-	0xdc, 0x78, 0xd8, 0x5f, 0xa6, 0x83, 0x3e, 0xfe, 0x1b, 0xf0, 0xb3, 0x59, 0xd8, 0x23, 0x06, 0xa4,
-	0xeb, 0x2d, 0xa8, 0x10, 0x4b, 0x73, 0x0f, 0x0a, 0x08, 0x8b, 0x5d, 0x1e, 0xdd, 0x6d, 0xb8, 0x08,
-	0xcf, 0x29, 0x82, 0xaa, 0xfd, 0x6d, 0x4f, 0x0c, 0xba, 0xf2, 0x08, 0xef, 0x5c, 0xed, 0xa0, 0x6f,
-	0xdc, 0xb7, 0x19, 0x35, 0x3e, 0xd4, 0xb5, 0xc9, 0x23, 0xa7, 0xfd, 0xcc, 0x5d, 0x57, 0x3e, 0x2b,
-	0xbf, 0x55, 0x61, 0x46, 0x45, 0xc6, 0x1f, 0x18, 0x54, 0xcc, 0x16, 0xc7, 0x66, 0x56, 0x90, 0xac,
-	0x27, 0xda, 0x7a, 0xb9, 0x00, 0x52, 0x13, 0xb5, 0x57, 0xbf, 0xfa, 0xf5, 0xaf, 0x3b, 0x53, 0xe7,
-	0xf0, 0xac, 0x93, 0xf1, 0xcf, 0x40, 0xfc, 0x60, 0x38, 0x3b, 0x89, 0x52, 0xec, 0xe2, 0x37, 0x0c,
-	0xaa, 0xf1, 0xb3, 0x84, 0xf9, 0xd9, 0xcc, 0xe4, 0x59, 0x4b, 0x45, 0xa0, 0xc4, 0xec, 0x94, 0x62,
-	0xd6, 0xc0, 0x17, 0x0e, 0x65, 0x86, 0x3f, 0x32, 0x28, 0x47, 0xeb, 0x12, 0x5f, 0x9a, 0x18, 0x3b,
-	0xf1, 0x38, 0x59, 0xa7, 0x72, 0x50, 0x94, 0xfc, 0x1d, 0x95, 0xfc, 0x12, 0x5e, 0x38, 0x42, 0x59,
-	0x1c, 0xb5, 0xa9, 0x9d, 0x1d, 0xf5, 0x9c, 0xed, 0xe2, 0xf7, 0x0c, 0x66, 0xd4, 0xe6, 0xc7, 0xc3,
-	0x73, 0xc6, 0xc5, 0x39, 0x9d, 0x07, 0x23, 0x6e, 0x17, 0x14, 0xb7, 0x55, 0x5c, 0x3e, 0x32, 0x37,
-	0xfc, 0x96, 0xc1, 0x31, 0xda, 0x8d, 0x93, 0xb3, 0xa5, 0x5e, 0x06, 0xeb, 0x4c, 0x2e, 0x8e, 0x68,
-	0xbd, 0xaa, 0x68, 0x2d, 0x61, 0x33, 0x93, 0x96, 0xc2, 0x3a, 0x3b, 0x89, 0x47, 0x66, 0x17, 0x7f,
-	0x61, 0x70, 0x9c, 0x6e, 0x38, 0x4e, 0x4e, 0x93, 0x5e, 0xb9, 0x56, 0x33, 0x1f, 0x48, 0x84, 0xae,
-	0x29, 0x42, 0x6b, 0x78, 0xf9, 0x28, 0x75, 0x32, 0x2b, 0xc6, 0xd9, 0x89, 0xd7, 0xf4, 0x2e, 0xfe,
-	0xc4, 0xa0, 0x62, 0x56, 0x18, 0xe6, 0x12, 0x10, 0xf9, 0xd7, 0x70, 0x7c, 0x1f, 0xda, 0x6f, 0x2a,
-	0xae, 0xaf, 0xe3, 0xf9, 0x87, 0xe1, 0x8a, 0xf7, 0x18, 0xcc, 0x26, 0xb6, 0x09, 0x9e, 0x9d, 0x98,
-	0xf8, 0xe0, 0x9e, 0xb3, 0x5e, 0x29, 0x06, 0xfe, 0x3f, 0xc3, 0xa7, 0xd6, 0xda, 0xda, 0xda, 0xfd,
-	0xbd, 0x3a, 0x7b, 0xb0, 0x57, 0x67, 0x7f, 0xee, 0xd5, 0xd9, 0x77, 0xfb, 0xf5, 0xd2, 0x83, 0xfd,
-	0x7a, 0xe9, 0xf7, 0xfd, 0x7a, 0xe9, 0xe3, 0xa6, 0x1f, 0xc8, 0x4f, 0x07, 0x9b, 0xad, 0x5b, 0x7c,
-	0xcb, 0x84, 0xd5, 0x7f, 0xce, 0x89, 0xce, 0x67, 0xce, 0xe7, 0x2a, 0x47, 0x34, 0x32, 0x62, 0xf3,
-	0x98, 0xfa, 0x6d, 0xb2, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x86, 0xe2, 0x1a, 0x4f,
-	0x0d, 0x00, 0x00,
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x4e, 0x6b, 0xbf, 0xb4, 0x01, 0x1e, 0x06, 0xac, 0x25, 0xd8, 0x61, 0x45, 0x5b,
+	0x93, 0x52, 0x2f, 0x49, 0x0a, 0xa8, 0x2d, 0xa0, 0x12, 0xa1, 0xb6, 0xa8, 0x12, 0x2a, 0x9b, 0x0a,
+	0x24, 0x0e, 0x8d, 0x36, 0xf5, 0x6a, 0x59, 0xe1, 0x78, 0xb6, 0x9e, 0xb1, 0x45, 0x14, 0x2c, 0x24,
+	0x4e, 0x20, 0x2e, 0xa0, 0x22, 0x6e, 0x88, 0x4a, 0x95, 0xf8, 0x5b, 0x7a, 0xac, 0x04, 0x07, 0x0e,
+	0x08, 0xa1, 0x84, 0x03, 0xe2, 0xaf, 0x40, 0x3b, 0x3f, 0xd6, 0xbb, 0xf6, 0xda, 0xbb, 0x2e, 0x15,
+	0xa7, 0xd8, 0x6f, 0xbe, 0xf7, 0xbd, 0xef, 0xfd, 0x98, 0x37, 0x0e, 0xd4, 0x6f, 0x53, 0xb6, 0x47,
+	0x99, 0xe5, 0xd1, 0x81, 0x35, 0x58, 0xdf, 0x75, 0xb9, 0xb3, 0x6e, 0xdd, 0xe9, 0xbb, 0xbd, 0xfd,
+	0x56, 0xd0, 0xa3, 0x9c, 0x22, 0xca, 0xf3, 0x96, 0x47, 0x07, 0x2d, 0x75, 0x6e, 0xac, 0x29, 0x9f,
+	0x5d, 0x87, 0xb9, 0x12, 0x1c, 0xb9, 0x06, 0x8e, 0xe7, 0x77, 0x1d, 0xee, 0xd3, 0xae, 0xf4, 0x37,
+	0xaa, 0x1e, 0xf5, 0xa8, 0xf8, 0x68, 0x85, 0x9f, 0x94, 0x75, 0xc5, 0xa3, 0xd4, 0xeb, 0xb8, 0x96,
+	0x13, 0xf8, 0x96, 0xd3, 0xed, 0x52, 0x2e, 0x5c, 0x98, 0x3e, 0x4d, 0xd1, 0x14, 0xc6, 0x17, 0xa7,
+	0xe6, 0x1b, 0x50, 0xfd, 0x20, 0x8c, 0x79, 0xa3, 0x47, 0x03, 0xca, 0x9c, 0x8e, 0xed, 0xde, 0xe9,
+	0xbb, 0x8c, 0x63, 0x03, 0x96, 0x02, 0x65, 0xda, 0xf1, 0xdb, 0x35, 0xb2, 0x4a, 0x9a, 0x25, 0x1b,
+	0xb4, 0xe9, 0xbd, 0xb6, 0xf9, 0x11, 0x3c, 0x33, 0xe6, 0xc8, 0x02, 0xda, 0x65, 0x2e, 0xbe, 0x0d,
+	0x65, 0x0d, 0x13, 0x6e, 0x4b, 0x1b, 0x2b, 0xad, 0xc9, 0xb4, 0x5b, 0xda, 0x6f, 0xab, 0xf4, 0xe0,
+	0x8f, 0x46, 0xc1, 0x8e, 0x7c, 0xcc, 0x7f, 0xc8, 0x18, 0x33, 0xd3, 0x9a, 0xae, 0xc3, 0x13, 0x91,
+	0x26, 0xc6, 0x1d, 0xde, 0x67, 0x22, 0xc0, 0xf2, 0x86, 0x39, 0x2b, 0xc0, 0xb6, 0x40, 0xda, 0xcb,
+	0x41, 0xe2, 0x3b, 0x56, 0x61, 0x71, 0x40, 0xb9, 0xdb, 0xab, 0x15, 0x57, 0x49, 0xb3, 0x62, 0xcb,
+	0x2f, 0xb8, 0x02, 0x95, 0xb6, 0x1b, 0x50, 0xe6, 0x73, 0xda, 0xab, 0x2d, 0x88, 0x93, 0x91, 0x01,
+	0xaf, 0x00, 0x8c, 0x5a, 0x52, 0x2b, 0x89, 0xe4, 0x4e, 0xeb, 0xd8, 0x61, 0xff, 0x5a, 0xb2, 0xd9,
+	0x91, 0x04, 0xc7, 0x73, 0x95, 0x78, 0x3b, 0xe6, 0x79, 0xb1, 0xfc, 0xd5, 0xbd, 0x46, 0xe1, 0xef,
+	0x7b, 0x8d, 0x82, 0x79, 0x9f, 0xc0, 0xb3, 0xe3, 0xc9, 0xaa, 0x3a, 0x5e, 0x86, 0x8a, 0x96, 0x1c,
+	0xe6, 0xb9, 0x90, 0xb3, 0x90, 0x23, 0x27, 0xbc, 0x9a, 0x90, 0x5b, 0x14, 0x72, 0xcf, 0x64, 0xca,
+	0x95, 0xe1, 0xe3, 0x7a, 0xcd, 0x6d, 0x78, 0x52, 0x88, 0xfc, 0x90, 0x72, 0x37, 0xef, 0x80, 0xa4,
+	0x17, 0x38, 0x96, 0xfa, 0x55, 0x78, 0x2a, 0x46, 0xaa, 0x92, 0xde, 0x80, 0x52, 0x88, 0x53, 0x83,
+	0x53, 0x4b, 0xcb, 0x37, 0xc4, 0xab, 0x5c, 0x05, 0xd6, 0xfc, 0x3c, 0x46, 0xc4, 0x72, 0xcb, 0xbb,
+	0x92, 0x52, 0x9c, 0x47, 0xe8, 0xa5, 0x79, 0x97, 0x00, 0xc6, 0xc3, 0xab, 0x44, 0xce, 0xcb, 0xec,
+	0x75, 0xe7, 0xb2, 0x32, 0x91, 0xe0, 0xc7, 0xd7, 0xb1, 0xd7, 0x94, 0xa8, 0x1b, 0x4e, 0xcf, 0xd9,
+	0x4b, 0x14, 0x45, 0x18, 0x76, 0xf8, 0x7e, 0x20, 0x8b, 0x5c, 0xb1, 0x41, 0x9a, 0x6e, 0xee, 0x07,
+	0xae, 0xf9, 0x7b, 0x11, 0x9e, 0x4e, 0xf8, 0xa9, 0x6c, 0xae, 0xc3, 0xc9, 0x01, 0xe5, 0x7e, 0xd7,
+	0xdb, 0x91, 0x60, 0xd5, 0x9f, 0xd5, 0x29, 0x59, 0xf9, 0x5d, 0x4f, 0x12, 0xa8, 0xec, 0x4e, 0x0c,
+	0x62, 0x36, 0x7c, 0x1f, 0x96, 0xd5, 0x95, 0xd2, 0x6c, 0x32, 0xd1, 0x17, 0xd3, 0xd8, 0xde, 0x95,
+	0xc8, 0x04, 0xdd, 0xc9, 0x76, 0xdc, 0x88, 0xd7, 0xe0, 0x04, 0x77, 0x3a, 0x9d, 0x7d, 0xcd, 0xb6,
+	0x20, 0xd8, 0x1a, 0x69, 0x6c, 0x37, 0x43, 0x5c, 0x82, 0x6b, 0x89, 0x8f, 0x4c, 0x78, 0x0b, 0xaa,
+	0xd1, 0xd0, 0x84, 0x15, 0xd2, 0x8c, 0xa5, 0xd5, 0x85, 0xf8, 0x74, 0xa4, 0xdd, 0xbe, 0xb0, 0x7c,
+	0x09, 0x62, 0x0c, 0x26, 0x4e, 0xcc, 0x5b, 0xaa, 0xba, 0x2a, 0xa9, 0xdc, 0xb3, 0x9a, 0xd8, 0x4a,
+	0xc5, 0xb1, 0xad, 0x14, 0xbb, 0x52, 0xdb, 0x50, 0x4d, 0xf2, 0xab, 0xf6, 0x5d, 0x82, 0xe3, 0x0a,
+	0xae, 0x1a, 0xf7, 0xfc, 0x8c, 0x52, 0x2b, 0xfd, 0xda, 0xc3, 0xfc, 0x22, 0x49, 0xfa, 0xff, 0xdf,
+	0xb0, 0x9f, 0xf4, 0x83, 0x30, 0x52, 0xa0, 0xf2, 0x7a, 0x0b, 0xca, 0x4a, 0xa5, 0xbe, 0x67, 0x39,
+	0x12, 0x8b, 0x5c, 0x1e, 0xdf, 0x6d, 0xbb, 0x08, 0xcf, 0x09, 0x81, 0x62, 0xbc, 0x6c, 0x97, 0xf5,
+	0x3b, 0x7c, 0x8e, 0x77, 0xb4, 0x36, 0xe9, 0x1b, 0xf5, 0x6d, 0x51, 0x8c, 0x67, 0x8d, 0x64, 0x8c,
+	0xb4, 0xf4, 0xd3, 0xbb, 0x44, 0xf8, 0x6c, 0xfc, 0x5a, 0x81, 0x45, 0xc1, 0x8c, 0xdf, 0x13, 0x28,
+	0xeb, 0x39, 0xc5, 0x66, 0x1a, 0x49, 0xda, 0x4f, 0x00, 0xe3, 0xe5, 0x1c, 0x48, 0x29, 0xd4, 0xdc,
+	0xfc, 0xf2, 0x97, 0xbf, 0xee, 0x16, 0xcf, 0xe1, 0x59, 0x2b, 0xe5, 0xc7, 0x46, 0xf4, 0x20, 0x59,
+	0x07, 0xb1, 0x52, 0x0c, 0xf1, 0x6b, 0x02, 0x15, 0xcd, 0xc4, 0x30, 0x3b, 0x9a, 0x9e, 0x3c, 0x63,
+	0x2d, 0x0f, 0x54, 0x29, 0x3b, 0x25, 0x94, 0x35, 0xf0, 0x85, 0x99, 0xca, 0xf0, 0x07, 0x02, 0xa5,
+	0x70, 0x1d, 0xe3, 0x4b, 0x53, 0xb9, 0x63, 0x8f, 0x9f, 0x71, 0x2a, 0x03, 0xa5, 0x82, 0xbf, 0x23,
+	0x82, 0x5f, 0xc2, 0x0b, 0x73, 0x94, 0xc5, 0x12, 0x2f, 0x81, 0x75, 0x10, 0xfe, 0xe9, 0x0d, 0xf1,
+	0x3b, 0x02, 0x8b, 0x21, 0x27, 0xc3, 0xd9, 0x31, 0xa3, 0xe2, 0x9c, 0xce, 0x82, 0x29, 0x6d, 0x17,
+	0x84, 0xb6, 0x4d, 0x5c, 0x9f, 0x5b, 0x1b, 0x7e, 0x43, 0xe0, 0x98, 0xda, 0x98, 0xd3, 0xa3, 0x25,
+	0x5e, 0x1e, 0xe3, 0x4c, 0x26, 0x4e, 0xc9, 0x7a, 0x55, 0xc8, 0x5a, 0xc3, 0x66, 0xaa, 0x2c, 0x81,
+	0xb5, 0x0e, 0x62, 0x8f, 0xd8, 0x10, 0x7f, 0x26, 0x70, 0x5c, 0xdd, 0x70, 0x9c, 0x1e, 0x26, 0xb9,
+	0x72, 0x8d, 0x66, 0x36, 0x50, 0x09, 0xba, 0x26, 0x04, 0x6d, 0xe1, 0xe5, 0x79, 0xea, 0xa4, 0x57,
+	0x8c, 0x75, 0x10, 0xad, 0xe9, 0x21, 0xfe, 0x48, 0xa0, 0xac, 0xd8, 0x19, 0x66, 0x0a, 0x60, 0xd9,
+	0xd7, 0x70, 0x7c, 0x1f, 0x9a, 0x6f, 0x0a, 0xad, 0xaf, 0xe3, 0xf9, 0x47, 0xd1, 0x8a, 0xf7, 0x09,
+	0x2c, 0xc5, 0xb6, 0x09, 0x9e, 0x9d, 0x1a, 0x78, 0x72, 0xcf, 0x19, 0xaf, 0xe4, 0x03, 0xff, 0x97,
+	0xe1, 0x13, 0x6b, 0x6d, 0x6b, 0xeb, 0xc1, 0x61, 0x9d, 0x3c, 0x3c, 0xac, 0x93, 0x3f, 0x0f, 0xeb,
+	0xe4, 0xdb, 0xa3, 0x7a, 0xe1, 0xe1, 0x51, 0xbd, 0xf0, 0xdb, 0x51, 0xbd, 0xf0, 0x71, 0xd3, 0xf3,
+	0xf9, 0x27, 0xfd, 0xdd, 0xd6, 0x6d, 0xba, 0xa7, 0x69, 0xe5, 0x9f, 0x73, 0xac, 0xfd, 0xa9, 0xf5,
+	0x99, 0x88, 0x11, 0x8e, 0x0c, 0xdb, 0x3d, 0x26, 0xfe, 0xf7, 0xd9, 0xfc, 0x77, 0x00, 0x75, 0xee,
+	0xea, 0xbb, 0xaf, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1622,6 +1633,20 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalTypeParams) > 0 {
+		for iNdEx := len(m.ProposalTypeParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTypeParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2037,6 +2062,12 @@ func (m *QueryParamsResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposalTypeParams) > 0 {
+		for _, e := range m.ProposalTypeParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3191,6 +3222,40 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypeParams = append(m.ProposalTypeParams, ProposalTypeParams{})
+			if err := m.ProposalTypeParams[len(m.ProposalTypeParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
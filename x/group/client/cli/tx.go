@@ -0,0 +1,654 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// flags for the group module
+const (
+	FlagExec       = "exec"
+	FlagThreshold  = "threshold"
+	FlagPercentage = "percentage"
+	FlagTimeout    = "timeout"
+
+	// ExecTry is the value of FlagExec to try to execute a proposal immediately.
+	ExecTry = "try"
+)
+
+// GetTxCmd returns the transaction commands for the group module.
+func GetTxCmd() *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(
+		MsgCreateGroupCmd(),
+		MsgUpdateGroupMembersCmd(),
+		MsgUpdateGroupAdminCmd(),
+		MsgUpdateGroupMetadataCmd(),
+		MsgCreateGroupPolicyCmd(),
+		MsgUpdateGroupPolicyAdminCmd(),
+		MsgUpdateGroupPolicyDecisionPolicyCmd(),
+		MsgUpdateGroupPolicyMetadataCmd(),
+		MsgSubmitProposalCmd(),
+		MsgVoteCmd(),
+		MsgExecCmd(),
+	)
+
+	return groupTxCmd
+}
+
+// MsgCreateGroupCmd creates a CLI command for Msg/CreateGroup.
+func MsgCreateGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin] [metadata] [members-json-file]",
+		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group which is an aggregation of member accounts with associated weights and
+an administrator account. Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx group create-group [admin] [metadata] [members-json-file]
+
+Where members.json contains:
+
+{
+	"members": [
+		{
+			"address": "addr1",
+			"weight": "1",
+			"metadata": "some base64 metadata"
+		},
+		{
+			"address": "addr2",
+			"weight": "1",
+			"metadata": "some base64 metadata"
+		}
+	]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(clientCtx.GetFromAddress(), members, []byte(args[1]))
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreateGroup(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUpdateGroupMembersCmd creates a CLI command for Msg/UpdateGroupMembers.
+func MsgUpdateGroupMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json-file]",
+		Short: "Update a group's members. Set a member's weight to \"0\" to delete it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group's members, the members json file having the same format as for
+create-group. Set a member's weight to "0" to delete it. Note, the '--from' flag is ignored
+as it is implied from [admin].
+
+Example:
+$ %s tx group update-group-members [admin] [group-id] [members-json-file]
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(clientCtx.GetFromAddress(), groupID, members)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupMembers(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUpdateGroupAdminCmd creates a CLI command for Msg/UpdateGroupAdmin.
+func MsgUpdateGroupAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [admin] [group-id] [new-admin]",
+		Short: "Update a group's admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(clientCtx.GetFromAddress(), groupID, newAdmin)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupAdmin(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUpdateGroupMetadataCmd creates a CLI command for Msg/UpdateGroupMetadata.
+func MsgUpdateGroupMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [admin] [group-id] [metadata]",
+		Short: "Update a group's metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMetadata(clientCtx.GetFromAddress(), groupID, []byte(args[2]))
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupMetadata(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgCreateGroupPolicyCmd creates a CLI command for Msg/CreateGroupPolicy.
+func MsgCreateGroupPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [admin] [group-id] [metadata]",
+		Short: "Create a group policy which is an account associated with a group and a decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy which is an account associated with a group and a decision policy.
+The decision policy is set with either the --%s or the --%s flag. Note, the '--from' flag is
+ignored as it is implied from [admin].
+
+Example:
+$ %s tx group create-group-policy [admin] [group-id] [metadata] --%s 2 --%s 24h
+`, FlagThreshold, FlagPercentage, version.AppName, FlagThreshold, FlagTimeout),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupPolicy(clientCtx.GetFromAddress(), groupID, []byte(args[2]), policy)
+			if err != nil {
+				return err
+			}
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreateGroupPolicy(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	addDecisionPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUpdateGroupPolicyAdminCmd creates a CLI command for Msg/UpdateGroupPolicyAdmin.
+func MsgUpdateGroupPolicyAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-admin [admin] [group-policy-account] [new-admin]",
+		Short: "Update a group policy admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicyAdmin(clientCtx.GetFromAddress(), policy, newAdmin)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupPolicyAdmin(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUpdateGroupPolicyDecisionPolicyCmd creates a CLI command for Msg/UpdateGroupPolicyDecisionPolicy.
+func MsgUpdateGroupPolicyDecisionPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [admin] [group-policy-account]",
+		Short: "Update a group policy's decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group policy's decision policy, set with either the --%s or the --%s flag.
+The pending proposals of the group policy can't be executed anymore. Note, the '--from' flag is
+ignored as it is implied from [admin].
+
+Example:
+$ %s tx group update-group-policy-decision-policy [admin] [group-policy-account] --%s 0.5 --%s 24h
+`, FlagThreshold, FlagPercentage, version.AppName, FlagPercentage, FlagTimeout),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policyAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(clientCtx.GetFromAddress(), policyAddr, policy)
+			if err != nil {
+				return err
+			}
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupPolicyDecisionPolicy(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	addDecisionPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUpdateGroupPolicyMetadataCmd creates a CLI command for Msg/UpdateGroupPolicyMetadata.
+func MsgUpdateGroupPolicyMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-metadata [admin] [group-policy-account] [new-metadata]",
+		Short: "Update a group policy metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicyMetadata(clientCtx.GetFromAddress(), policy, []byte(args[2]))
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupPolicyMetadata(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgSubmitProposalCmd creates a CLI command for Msg/SubmitProposal.
+func MsgSubmitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [proposal-json-file]",
+		Short: "Submit a new proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a new proposal for a group policy to execute msgs signed by its account.
+The first proposer signs the transaction, the others must sign it too in a multi-signer setup.
+
+Example:
+$ %s tx group submit-proposal proposal.json
+
+Where proposal.json contains:
+
+{
+	"address": "cosmos1...",
+	"proposers": ["cosmos1...", "cosmos1..."],
+	"metadata": "some base64 metadata",
+	"msgs": [
+		{
+			"@type": "/cosmos.bank.v1beta1.Msg/Send",
+			"from_address": "cosmos1...",
+			"to_address": "cosmos1...",
+			"amount": [{"denom": "stake", "amount": "10"}]
+		}
+	]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg types.MsgSubmitProposal
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, &msg); err != nil {
+				return err
+			}
+			if len(msg.Proposers) == 0 {
+				return fmt.Errorf("proposal must have at least one proposer")
+			}
+
+			msg.Exec, err = parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			cmd.Flags().Set(flags.FlagFrom, msg.Proposers[0])
+			clientCtx, err = client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.SubmitProposal(cmd.Context(), &msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", fmt.Sprintf("Set to %q to try to execute the proposal immediately, the proposers voting yes", ExecTry))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgVoteCmd creates a CLI command for Msg/Vote.
+func MsgVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter] [choice] [metadata]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal. The choice is one of yes, no, abstain or veto. Note, the
+'--from' flag is ignored as it is implied from [voter].
+
+Example:
+$ %s tx group vote 1 [voter] yes "some metadata"
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[1])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			choice, err := parseChoice(args[2])
+			if err != nil {
+				return err
+			}
+
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(clientCtx.GetFromAddress(), proposalID, choice, []byte(args[3]), exec)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Vote(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", fmt.Sprintf("Set to %q to try to execute the proposal immediately after voting", ExecTry))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgExecCmd creates a CLI command for Msg/Exec.
+func MsgExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Short: "Execute a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(clientCtx.GetFromAddress(), proposalID)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Exec(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addDecisionPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagThreshold, "", "Minimum weighted sum of yes votes for a proposal to pass")
+	cmd.Flags().String(FlagPercentage, "", "Minimum percentage of the group's total weight voting yes for a proposal to pass, in (0, 1]")
+	cmd.Flags().Duration(FlagTimeout, 24*time.Hour, "Duration of the voting period of the proposals")
+}
+
+// parseDecisionPolicy returns the decision policy set by either the threshold or
+// the percentage flag.
+func parseDecisionPolicy(cmd *cobra.Command) (types.DecisionPolicy, error) {
+	threshold, _ := cmd.Flags().GetString(FlagThreshold)
+	percentage, _ := cmd.Flags().GetString(FlagPercentage)
+	timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case threshold != "" && percentage == "":
+		dec, err := sdk.NewDecFromStr(threshold)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold %s: %w", threshold, err)
+		}
+		return types.NewThresholdDecisionPolicy(dec, timeout), nil
+	case percentage != "" && threshold == "":
+		dec, err := sdk.NewDecFromStr(percentage)
+		if err != nil {
+			return nil, fmt.Errorf("invalid percentage %s: %w", percentage, err)
+		}
+		return types.NewPercentageDecisionPolicy(dec, timeout), nil
+	default:
+		return nil, fmt.Errorf("exactly one of --%s or --%s must be set", FlagThreshold, FlagPercentage)
+	}
+}
+
+// parseMembers reads the members of a members json file.
+func parseMembers(path string) ([]types.Member, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var members struct {
+		Members []types.Member `json:"members"`
+	}
+	if err := json.Unmarshal(bz, &members); err != nil {
+		return nil, fmt.Errorf("invalid members json file: %w", err)
+	}
+	return members.Members, nil
+}
+
+func parseChoice(choice string) (types.Choice, error) {
+	value, ok := types.Choice_value["CHOICE_"+strings.ToUpper(choice)]
+	if !ok || value == int32(types.ChoiceUnspecified) {
+		return types.ChoiceUnspecified, fmt.Errorf("invalid choice %s, expected one of yes, no, abstain or veto", choice)
+	}
+	return types.Choice(value), nil
+}
+
+func parseExec(cmd *cobra.Command) (types.Exec, error) {
+	exec, _ := cmd.Flags().GetString(FlagExec)
+	switch exec {
+	case "":
+		return types.ExecUnspecified, nil
+	case ExecTry:
+		return types.ExecTry, nil
+	default:
+		return types.ExecUnspecified, fmt.Errorf("invalid --%s value %s, expected %q", FlagExec, exec, ExecTry)
+	}
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
+	k.setSequence(ctx, types.GroupSeqKey, data.GroupSeq)
+	k.setSequence(ctx, types.GroupPolicySeqKey, data.GroupPolicySeq)
+	k.setSequence(ctx, types.ProposalSeqKey, data.ProposalSeq)
+
+	for _, groupInfo := range data.Groups {
+		if err := k.setGroupInfo(ctx, groupInfo); err != nil {
+			return err
+		}
+	}
+	for _, groupMember := range data.GroupMembers {
+		if err := k.setGroupMember(ctx, groupMember); err != nil {
+			return err
+		}
+	}
+	for _, policyInfo := range data.GroupPolicies {
+		if err := k.setGroupPolicyInfo(ctx, policyInfo); err != nil {
+			return err
+		}
+	}
+	for _, proposal := range data.Proposals {
+		if err := k.setProposal(ctx, proposal); err != nil {
+			return err
+		}
+	}
+	for _, vote := range data.Votes {
+		if err := k.setVote(ctx, vote); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := types.NewGenesisState()
+	genState.GroupSeq = k.getSequence(ctx, types.GroupSeqKey)
+	genState.GroupPolicySeq = k.getSequence(ctx, types.GroupPolicySeqKey)
+	genState.ProposalSeq = k.getSequence(ctx, types.ProposalSeqKey)
+
+	k.IterateGroups(ctx, func(groupInfo types.GroupInfo) bool {
+		genState.Groups = append(genState.Groups, groupInfo)
+		return false
+	})
+	k.IterateGroupMembers(ctx, func(groupMember types.GroupMember) bool {
+		genState.GroupMembers = append(genState.GroupMembers, groupMember)
+		return false
+	})
+	k.IterateGroupPolicies(ctx, func(policyInfo types.GroupPolicyInfo) bool {
+		genState.GroupPolicies = append(genState.GroupPolicies, policyInfo)
+		return false
+	})
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		genState.Proposals = append(genState.Proposals, proposal)
+		return false
+	})
+	k.IterateVotes(ctx, func(vote types.Vote) bool {
+		genState.Votes = append(genState.Votes, vote)
+		return false
+	})
+
+	return genState
+}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo implements the Query/GroupInfo gRPC method.
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	groupInfo, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &groupInfo}, nil
+}
+
+// GroupPolicyInfo implements the Query/GroupPolicyInfo gRPC method.
+func (k Keeper) GroupPolicyInfo(c context.Context, req *types.QueryGroupPolicyInfoRequest) (*types.QueryGroupPolicyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	policy, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPolicyInfoResponse{Info: &policyInfo}, nil
+}
+
+// GroupMembers implements the Query/GroupMembers gRPC method.
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var members []*types.GroupMember
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupMembersPrefix(req.GroupId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var member types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &member); err != nil {
+			return err
+		}
+		members = append(members, &member)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+// GroupsByAdmin implements the Query/GroupsByAdmin gRPC method.
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var groups []*types.GroupInfo
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupsByAdminPrefix(admin))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		groupInfo, err := k.GetGroupInfo(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &groupInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupsByAdminResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// GroupPoliciesByGroup implements the Query/GroupPoliciesByGroup gRPC method.
+func (k Keeper) GroupPoliciesByGroup(c context.Context, req *types.QueryGroupPoliciesByGroupRequest) (*types.QueryGroupPoliciesByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoliciesByGroupPrefix(req.GroupId))
+	policies, pageRes, err := k.paginateGroupPolicies(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPoliciesByGroupResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// GroupPoliciesByAdmin implements the Query/GroupPoliciesByAdmin gRPC method.
+func (k Keeper) GroupPoliciesByAdmin(c context.Context, req *types.QueryGroupPoliciesByAdminRequest) (*types.QueryGroupPoliciesByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoliciesByAdminPrefix(admin))
+	policies, pageRes, err := k.paginateGroupPolicies(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPoliciesByAdminResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// ProposalsByGroupPolicy implements the Query/ProposalsByGroupPolicy gRPC method.
+func (k Keeper) ProposalsByGroupPolicy(c context.Context, req *types.QueryProposalsByGroupPolicyRequest) (*types.QueryProposalsByGroupPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	policy, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var proposals []*types.Proposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalsByPolicyPrefix(policy))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		proposal, err := k.GetProposal(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalsByGroupPolicyResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// VoteByProposalVoter implements the Query/VoteByProposalVoter gRPC method.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, found := k.GetVote(ctx, req.ProposalId, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no vote found for voter %s on proposal %d", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &vote}, nil
+}
+
+// VotesByProposal implements the Query/VotesByProposal gRPC method.
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var votes []*types.Vote
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotesByProposalPrefix(req.ProposalId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVotesByProposalResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// VotesByVoter implements the Query/VotesByVoter gRPC method.
+func (k Keeper) VotesByVoter(c context.Context, req *types.QueryVotesByVoterRequest) (*types.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var votes []*types.Vote
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotesByVoterPrefix(voter))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		vote, found := k.GetVote(ctx, sdk.BigEndianToUint64(key), voter)
+		if !found {
+			return status.Errorf(codes.Internal, "vote of %s on proposal %d not found", req.Voter, sdk.BigEndianToUint64(key))
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVotesByVoterResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// paginateGroupPolicies returns the group policies of an index store whose keys
+// are the length prefixed group policy addresses.
+func (k Keeper) paginateGroupPolicies(ctx sdk.Context, store prefix.Store, pageReq *query.PageRequest) ([]*types.GroupPolicyInfo, *query.PageResponse, error) {
+	var policies []*types.GroupPolicyInfo
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		policyInfo, err := k.GetGroupPolicyInfo(ctx, sdk.AccAddress(key[1:]))
+		if err != nil {
+			return err
+		}
+		policies = append(policies, &policyInfo)
+		return nil
+	})
+	return policies, pageRes, err
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// maxPolicyAccountAttempts is the maximum number of group policy sequences tried
+// to derive the address of a new group policy account.
+const maxPolicyAccountAttempts = 10
+
+// Keeper manages the groups, group policies, proposals and votes of the group module.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryMarshaler
+	accKeeper types.AccountKeeper
+	router    *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new group Keeper instance. The router is used to execute
+// the msgs of the accepted proposals.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, accKeeper types.AccountKeeper, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		accKeeper: accKeeper,
+		router:    router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(seq))
+}
+
+// nextSequence increments the sequence stored at the given key and returns it.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSequence(ctx, key) + 1
+	k.setSequence(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the group with the given ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, error) {
+	var groupInfo types.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupKey(groupID))
+	if bz == nil {
+		return groupInfo, sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	err := k.cdc.UnmarshalBinaryBare(bz, &groupInfo)
+	return groupInfo, err
+}
+
+func (k Keeper) setGroupInfo(ctx sdk.Context, groupInfo types.GroupInfo) error {
+	admin, err := sdk.AccAddressFromBech32(groupInfo.Admin)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupKey(groupInfo.GroupId), k.cdc.MustMarshalBinaryBare(&groupInfo))
+	store.Set(types.GroupByAdminKey(admin, groupInfo.GroupId), []byte{})
+	return nil
+}
+
+// updateGroupAdmin moves the group to the index of its new admin.
+func (k Keeper) updateGroupAdmin(ctx sdk.Context, groupInfo *types.GroupInfo, newAdmin string) error {
+	admin, err := sdk.AccAddressFromBech32(groupInfo.Admin)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GroupByAdminKey(admin, groupInfo.GroupId))
+	groupInfo.Admin = newAdmin
+	return k.setGroupInfo(ctx, *groupInfo)
+}
+
+// IterateGroups iterates over all the groups until the callback returns true.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(groupInfo types.GroupInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var groupInfo types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &groupInfo)
+		if cb(groupInfo) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of a group with the given address.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (types.GroupMember, bool) {
+	var groupMember types.GroupMember
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupMemberKey(groupID, member))
+	if bz == nil {
+		return groupMember, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &groupMember)
+	return groupMember, true
+}
+
+func (k Keeper) setGroupMember(ctx sdk.Context, groupMember types.GroupMember) error {
+	member, err := sdk.AccAddressFromBech32(groupMember.Member.Address)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GroupMemberKey(groupMember.GroupId, member), k.cdc.MustMarshalBinaryBare(&groupMember))
+	return nil
+}
+
+func (k Keeper) deleteGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GroupMemberKey(groupID, member))
+}
+
+// IterateGroupMembers iterates over the members of all the groups until the
+// callback returns true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, cb func(groupMember types.GroupMember) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupMemberKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var groupMember types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &groupMember)
+		if cb(groupMember) {
+			break
+		}
+	}
+}
+
+// GetGroupPolicyInfo returns the group policy with the given account address.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, policy sdk.AccAddress) (types.GroupPolicyInfo, error) {
+	var policyInfo types.GroupPolicyInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupPolicyKey(policy))
+	if bz == nil {
+		return policyInfo, sdkerrors.Wrapf(types.ErrNotFound, "group policy %s", policy)
+	}
+	err := k.cdc.UnmarshalBinaryBare(bz, &policyInfo)
+	return policyInfo, err
+}
+
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, policyInfo types.GroupPolicyInfo) error {
+	policy, err := sdk.AccAddressFromBech32(policyInfo.Address)
+	if err != nil {
+		return err
+	}
+	admin, err := sdk.AccAddressFromBech32(policyInfo.Admin)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.MarshalBinaryBare(&policyInfo)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupPolicyKey(policy), bz)
+	store.Set(types.PolicyByGroupKey(policyInfo.GroupId, policy), []byte{})
+	store.Set(types.PolicyByAdminKey(admin, policy), []byte{})
+	return nil
+}
+
+// updateGroupPolicyAdmin moves the group policy to the index of its new admin.
+func (k Keeper) updateGroupPolicyAdmin(ctx sdk.Context, policyInfo *types.GroupPolicyInfo, newAdmin string) error {
+	policy, err := sdk.AccAddressFromBech32(policyInfo.Address)
+	if err != nil {
+		return err
+	}
+	admin, err := sdk.AccAddressFromBech32(policyInfo.Admin)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.PolicyByAdminKey(admin, policy))
+	policyInfo.Admin = newAdmin
+	return k.setGroupPolicyInfo(ctx, *policyInfo)
+}
+
+// IterateGroupPolicies iterates over all the group policies until the callback
+// returns true.
+func (k Keeper) IterateGroupPolicies(ctx sdk.Context, cb func(policyInfo types.GroupPolicyInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupPolicyKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var policyInfo types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &policyInfo)
+		if cb(policyInfo) {
+			break
+		}
+	}
+}
+
+// createGroupPolicyAccount creates the account of a new group policy. Its address
+// is derived from the module name and the next group policy sequence, so that
+// nobody holds its private key.
+func (k Keeper) createGroupPolicyAccount(ctx sdk.Context) (sdk.AccAddress, error) {
+	for i := 0; i < maxPolicyAccountAttempts; i++ {
+		seq := k.nextSequence(ctx, types.GroupPolicySeqKey)
+		addr := sdk.AccAddress(address.Module(types.ModuleName, sdk.Uint64ToBigEndian(seq)))
+		if k.accKeeper.GetAccount(ctx, addr) != nil {
+			continue
+		}
+
+		acc := k.accKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+		k.accKeeper.SetAccount(ctx, acc)
+		return addr, nil
+	}
+
+	return nil, sdkerrors.Wrap(types.ErrDuplicate, "could not derive an unused group policy account address")
+}
+
+// GetProposal returns the proposal with the given ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	var proposal types.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(proposalID))
+	if bz == nil {
+		return proposal, sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+	err := k.cdc.UnmarshalBinaryBare(bz, &proposal)
+	return proposal, err
+}
+
+func (k Keeper) setProposal(ctx sdk.Context, proposal types.Proposal) error {
+	policy, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.MarshalBinaryBare(&proposal)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.ProposalId), bz)
+	store.Set(types.ProposalByPolicyKey(policy, proposal.ProposalId), []byte{})
+	return nil
+}
+
+// IterateProposals iterates over all the proposals until the callback returns true.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote of a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, bool) {
+	var vote types.Vote
+	bz := ctx.KVStore(k.storeKey).Get(types.VoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+func (k Keeper) setVote(ctx sdk.Context, vote types.Vote) error {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VoteKey(vote.ProposalId, voter), k.cdc.MustMarshalBinaryBare(&vote))
+	store.Set(types.VoteByVoterKey(voter, vote.ProposalId), []byte{})
+	return nil
+}
+
+// IterateVotes iterates over all the votes until the callback returns true.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	s.msgServer = keeper.NewMsgServerImpl(app.GroupKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestCreateAndUpdateGroup() {
+	ctx, admin := s.ctx, s.addrs[0]
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup(admin, s.addrs[1], s.addrs[2])
+	s.Require().Equal(uint64(1), groupID)
+
+	info, err := s.queryClient.GroupInfo(goCtx, &types.QueryGroupInfoRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Equal(admin.String(), info.Info.Admin)
+	s.Require().Equal(uint64(1), info.Info.Version)
+	s.Require().Equal(sdk.NewDec(2), info.Info.TotalWeight)
+
+	members, err := s.queryClient.GroupMembers(goCtx, &types.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(members.Members, 2)
+
+	groups, err := s.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: admin.String()})
+	s.Require().NoError(err)
+	s.Require().Len(groups.Groups, 1)
+
+	s.T().Log("only the admin can update the group")
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(s.addrs[1], groupID, []types.Member{
+		{Address: s.addrs[3].String(), Weight: sdk.OneDec()},
+	}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.T().Log("add, update and remove members")
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(admin, groupID, []types.Member{
+		{Address: s.addrs[1].String(), Weight: sdk.ZeroDec()},
+		{Address: s.addrs[2].String(), Weight: sdk.NewDec(3)},
+		{Address: s.addrs[3].String(), Weight: sdk.OneDec()},
+	}))
+	s.Require().NoError(err)
+
+	groupInfo, err := s.app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), groupInfo.Version)
+	s.Require().Equal(sdk.NewDec(4), groupInfo.TotalWeight)
+	_, found := s.app.GroupKeeper.GetGroupMember(ctx, groupID, s.addrs[1])
+	s.Require().False(found)
+
+	s.T().Log("removing an unknown member fails")
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(admin, groupID, []types.Member{
+		{Address: s.addrs[1].String(), Weight: sdk.ZeroDec()},
+	}))
+	s.Require().ErrorIs(err, types.ErrNotFound)
+
+	s.T().Log("update the admin")
+	_, err = s.msgServer.UpdateGroupAdmin(goCtx, types.NewMsgUpdateGroupAdmin(admin, groupID, s.addrs[1]))
+	s.Require().NoError(err)
+	groups, err = s.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: admin.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(groups.Groups)
+	groups, err = s.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(groups.Groups, 1)
+}
+
+func (s *TestSuite) TestCreateAndUpdateGroupPolicy() {
+	ctx, admin := s.ctx, s.addrs[0]
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup(admin, s.addrs[1], s.addrs[2])
+
+	s.T().Log("only the group admin can create a group policy")
+	msg, err := types.NewMsgCreateGroupPolicy(s.addrs[1], groupID, nil, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateGroupPolicy(goCtx, msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	policyAddr := s.createGroupPolicy(admin, groupID, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(ctx, policyAddr))
+	otherPolicyAddr := s.createGroupPolicy(admin, groupID, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	s.Require().NotEqual(policyAddr, otherPolicyAddr)
+
+	policies, err := s.queryClient.GroupPoliciesByGroup(goCtx, &types.QueryGroupPoliciesByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(policies.GroupPolicies, 2)
+
+	s.T().Log("update the decision policy")
+	updateMsg, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(admin, policyAddr, types.NewPercentageDecisionPolicy(sdk.MustNewDecFromStr("0.5"), time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupPolicyDecisionPolicy(goCtx, updateMsg)
+	s.Require().NoError(err)
+
+	info, err := s.queryClient.GroupPolicyInfo(goCtx, &types.QueryGroupPolicyInfoRequest{Address: policyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), info.Info.Version)
+	policy, err := info.Info.GetDecisionPolicy()
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewPercentageDecisionPolicy(sdk.MustNewDecFromStr("0.5"), time.Hour), policy)
+
+	s.T().Log("update the group policy admin")
+	_, err = s.msgServer.UpdateGroupPolicyAdmin(goCtx, types.NewMsgUpdateGroupPolicyAdmin(admin, policyAddr, s.addrs[3]))
+	s.Require().NoError(err)
+	byAdmin, err := s.queryClient.GroupPoliciesByAdmin(goCtx, &types.QueryGroupPoliciesByAdminRequest{Admin: s.addrs[3].String()})
+	s.Require().NoError(err)
+	s.Require().Len(byAdmin.GroupPolicies, 1)
+	s.Require().Equal(policyAddr.String(), byAdmin.GroupPolicies[0].Address)
+	byAdmin, err = s.queryClient.GroupPoliciesByAdmin(goCtx, &types.QueryGroupPoliciesByAdminRequest{Admin: admin.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byAdmin.GroupPolicies, 1)
+	s.Require().Equal(otherPolicyAddr.String(), byAdmin.GroupPolicies[0].Address)
+}
+
+func (s *TestSuite) TestProposalExecution() {
+	ctx, admin, recipient := s.ctx, s.addrs[0], s.addrs[3]
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup(admin, s.addrs[1], s.addrs[2])
+	policyAddr := s.createGroupPolicy(admin, groupID, types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, admin, policyAddr, coins))
+
+	s.T().Log("proposers must be members of the group")
+	_, err := s.submitProposal(policyAddr, admin, s.newSend(policyAddr, recipient, coins), types.ExecUnspecified)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.T().Log("msgs must be signed by the group policy account")
+	_, err = s.submitProposal(policyAddr, s.addrs[1], s.newSend(admin, recipient, coins), types.ExecUnspecified)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[1], s.newSend(policyAddr, recipient, coins), types.ExecTry)
+	s.Require().NoError(err)
+	proposal, err := s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusSubmitted, proposal.Status)
+	s.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	s.Require().Equal(sdk.OneDec(), proposal.VoteState.YesCount)
+
+	s.T().Log("executing before the threshold is reached does nothing")
+	_, err = s.msgServer.Exec(goCtx, types.NewMsgExec(admin, proposalID))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusSubmitted, proposal.Status)
+
+	s.T().Log("members can't vote twice")
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[1], proposalID, types.ChoiceNo, nil, types.ExecUnspecified))
+	s.Require().ErrorIs(err, types.ErrDuplicate)
+
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+
+	_, err = s.msgServer.Exec(goCtx, types.NewMsgExec(admin, proposalID))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, policyAddr).IsZero())
+
+	votes, err := s.queryClient.VotesByProposal(goCtx, &types.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votes.Votes, 2)
+	votesByVoter, err := s.queryClient.VotesByVoter(goCtx, &types.QueryVotesByVoterRequest{Voter: s.addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().Len(votesByVoter.Votes, 1)
+	proposals, err := s.queryClient.ProposalsByGroupPolicy(goCtx, &types.QueryProposalsByGroupPolicyRequest{Address: policyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(proposals.Proposals, 1)
+
+	s.T().Log("a failing execution is recorded, and can be retried")
+	proposalID, err = s.submitProposal(policyAddr, s.addrs[1], s.newSend(policyAddr, recipient, coins), types.ExecUnspecified)
+	s.Require().NoError(err)
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[1], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecTry))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultFailure, proposal.ExecutorResult)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, admin, policyAddr, coins))
+	_, err = s.msgServer.Exec(goCtx, types.NewMsgExec(admin, proposalID))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Require().Equal(coins.Add(coins...), s.app.BankKeeper.GetAllBalances(ctx, recipient).Sub(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30000000)))))
+}
+
+func (s *TestSuite) TestProposalRejectedAndAborted() {
+	ctx, admin := s.ctx, s.addrs[0]
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup(admin, s.addrs[1], s.addrs[2])
+	policyAddr := s.createGroupPolicy(admin, groupID, types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	send := s.newSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	s.T().Log("a proposal is rejected once the threshold can't be reached")
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[1], send, types.ExecUnspecified)
+	s.Require().NoError(err)
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[1], proposalID, types.ChoiceNo, nil, types.ExecUnspecified))
+	s.Require().NoError(err)
+	proposal, err := s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultRejected, proposal.Result)
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().ErrorIs(err, types.ErrInvalid)
+
+	s.T().Log("a proposal is rejected after the timeout")
+	proposalID, err = s.submitProposal(policyAddr, s.addrs[1], send, types.ExecTry)
+	s.Require().NoError(err)
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = s.msgServer.Vote(sdk.WrapSDKContext(expiredCtx), types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().ErrorIs(err, types.ErrExpired)
+	_, err = s.msgServer.Exec(sdk.WrapSDKContext(expiredCtx), types.NewMsgExec(admin, proposalID))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultRejected, proposal.Result)
+
+	s.T().Log("a proposal is aborted when the group is modified")
+	proposalID, err = s.submitProposal(policyAddr, s.addrs[1], send, types.ExecUnspecified)
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(admin, groupID, []types.Member{
+		{Address: s.addrs[3].String(), Weight: sdk.OneDec()},
+	}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().ErrorIs(err, types.ErrModified)
+	_, err = s.msgServer.Exec(goCtx, types.NewMsgExec(admin, proposalID))
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusAborted, proposal.Status)
+	_, err = s.msgServer.Exec(goCtx, types.NewMsgExec(admin, proposalID))
+	s.Require().ErrorIs(err, types.ErrInvalid)
+}
+
+func (s *TestSuite) TestExportImportGenesis() {
+	ctx, admin := s.ctx, s.addrs[0]
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup(admin, s.addrs[1], s.addrs[2])
+	policyAddr := s.createGroupPolicy(admin, groupID, types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[1], s.newSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), types.ExecTry)
+	s.Require().NoError(err)
+
+	genState := s.app.GroupKeeper.ExportGenesis(ctx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Equal(uint64(1), genState.GroupSeq)
+	s.Require().Len(genState.GroupMembers, 2)
+	s.Require().Len(genState.GroupPolicies, 1)
+	s.Require().Len(genState.Proposals, 1)
+	s.Require().Len(genState.Votes, 1)
+
+	bz, err := s.app.AppCodec().MarshalJSON(genState)
+	s.Require().NoError(err)
+	var imported types.GenesisState
+	s.Require().NoError(s.app.AppCodec().UnmarshalJSON(bz, &imported))
+
+	app := simapp.Setup(false)
+	newCtx := app.BaseApp.NewContext(false, tmproto.Header{Time: ctx.BlockTime()})
+	s.Require().NoError(app.GroupKeeper.InitGenesis(newCtx, &imported))
+	exported, err := app.AppCodec().MarshalJSON(app.GroupKeeper.ExportGenesis(newCtx))
+	s.Require().NoError(err)
+	s.Require().JSONEq(string(bz), string(exported))
+
+	s.T().Log("the imported proposal can still be voted on and executed")
+	_, err = keeper.NewMsgServerImpl(app.GroupKeeper).Vote(sdk.WrapSDKContext(newCtx), types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Vote(goCtx, types.NewMsgVote(s.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) createGroup(admin sdk.AccAddress, members ...sdk.AccAddress) uint64 {
+	groupMembers := make([]types.Member, len(members))
+	for i, member := range members {
+		groupMembers[i] = types.Member{Address: member.String(), Weight: sdk.OneDec()}
+	}
+	res, err := s.msgServer.CreateGroup(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateGroup(admin, groupMembers, nil))
+	s.Require().NoError(err)
+	return res.GroupId
+}
+
+func (s *TestSuite) createGroupPolicy(admin sdk.AccAddress, groupID uint64, policy types.DecisionPolicy) sdk.AccAddress {
+	msg, err := types.NewMsgCreateGroupPolicy(admin, groupID, nil, policy)
+	s.Require().NoError(err)
+	res, err := s.msgServer.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	return addr
+}
+
+func (s *TestSuite) submitProposal(policy, proposer sdk.AccAddress, msg sdk.ServiceMsg, exec types.Exec) (uint64, error) {
+	req, err := types.NewMsgSubmitProposal(policy, []string{proposer.String()}, []sdk.ServiceMsg{msg}, nil, exec)
+	s.Require().NoError(err)
+	res, err := s.msgServer.SubmitProposal(sdk.WrapSDKContext(s.ctx), req)
+	if err != nil {
+		return 0, err
+	}
+	return res.ProposalId, nil
+}
+
+func (s *TestSuite) newSend(from, to sdk.AccAddress, coins sdk.Coins) sdk.ServiceMsg {
+	return sdk.ServiceMsg{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(from, to, coins),
+	}
+}
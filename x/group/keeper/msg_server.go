@@ -0,0 +1,406 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) CreateGroup(goCtx context.Context, req *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalWeight := sdk.ZeroDec()
+	for _, member := range req.Members {
+		totalWeight = totalWeight.Add(member.Weight)
+	}
+
+	groupID := k.nextSequence(ctx, types.GroupSeqKey)
+	groupInfo := types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       req.Admin,
+		Metadata:    req.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight,
+	}
+	if err := k.setGroupInfo(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
+	for _, member := range req.Members {
+		groupMember := types.GroupMember{GroupId: groupID, Member: member}
+		if err := k.setGroupMember(ctx, groupMember); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(newGroupEvent(types.EventTypeCreateGroup, groupID))
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+func (k msgServer) UpdateGroupMembers(goCtx context.Context, req *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupInfo, err := k.getGroupInfoAsAdmin(ctx, req.GroupId, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, update := range req.MemberUpdates {
+		member, err := sdk.AccAddressFromBech32(update.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		previous, found := k.GetGroupMember(ctx, req.GroupId, member)
+		if found {
+			groupInfo.TotalWeight = groupInfo.TotalWeight.Sub(previous.Member.Weight)
+		}
+
+		if update.Weight.IsZero() {
+			if !found {
+				return nil, sdkerrors.Wrapf(types.ErrNotFound, "member %s of group %d", update.Address, req.GroupId)
+			}
+			k.deleteGroupMember(ctx, req.GroupId, member)
+			continue
+		}
+
+		groupInfo.TotalWeight = groupInfo.TotalWeight.Add(update.Weight)
+		if err := k.setGroupMember(ctx, types.GroupMember{GroupId: req.GroupId, Member: update}); err != nil {
+			return nil, err
+		}
+	}
+
+	// bumping the version makes the pending proposals of the group fail
+	groupInfo.Version++
+	if err := k.setGroupInfo(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupEvent(types.EventTypeUpdateGroup, req.GroupId))
+
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupAdmin(goCtx context.Context, req *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupInfo, err := k.getGroupInfoAsAdmin(ctx, req.GroupId, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.updateGroupAdmin(ctx, &groupInfo, req.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupEvent(types.EventTypeUpdateGroup, req.GroupId))
+
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupMetadata(goCtx context.Context, req *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupInfo, err := k.getGroupInfoAsAdmin(ctx, req.GroupId, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	groupInfo.Metadata = req.Metadata
+	if err := k.setGroupInfo(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupEvent(types.EventTypeUpdateGroup, req.GroupId))
+
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateGroupPolicy(goCtx context.Context, req *types.MsgCreateGroupPolicy) (*types.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	// only the admin of the group can create policies for it
+	if _, err := k.getGroupInfoAsAdmin(ctx, req.GroupId, req.Admin); err != nil {
+		return nil, err
+	}
+	decisionPolicy, err := req.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := k.createGroupPolicyAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policyInfo, err := types.NewGroupPolicyInfo(policy, req.GroupId, admin, req.Metadata, 1, decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.setGroupPolicyInfo(ctx, policyInfo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupPolicyEvent(types.EventTypeCreateGroupPolicy, policyInfo.Address))
+
+	return &types.MsgCreateGroupPolicyResponse{Address: policyInfo.Address}, nil
+}
+
+func (k msgServer) UpdateGroupPolicyAdmin(goCtx context.Context, req *types.MsgUpdateGroupPolicyAdmin) (*types.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policyInfo, err := k.getGroupPolicyInfoAsAdmin(ctx, req.Address, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.updateGroupPolicyAdmin(ctx, &policyInfo, req.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupPolicyEvent(types.EventTypeUpdateGroupPolicy, req.Address))
+
+	return &types.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *types.MsgUpdateGroupPolicyDecisionPolicy) (*types.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policyInfo, err := k.getGroupPolicyInfoAsAdmin(ctx, req.Address, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	decisionPolicy, err := req.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if err := policyInfo.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+
+	// bumping the version makes the pending proposals of the group policy fail
+	policyInfo.Version++
+	if err := k.setGroupPolicyInfo(ctx, policyInfo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupPolicyEvent(types.EventTypeUpdateGroupPolicy, req.Address))
+
+	return &types.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupPolicyMetadata(goCtx context.Context, req *types.MsgUpdateGroupPolicyMetadata) (*types.MsgUpdateGroupPolicyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policyInfo, err := k.getGroupPolicyInfoAsAdmin(ctx, req.Address, req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	policyInfo.Metadata = req.Metadata
+	if err := k.setGroupPolicyInfo(ctx, policyInfo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newGroupPolicyEvent(types.EventTypeUpdateGroupPolicy, req.Address))
+
+	return &types.MsgUpdateGroupPolicyMetadataResponse{}, nil
+}
+
+func (k msgServer) SubmitProposal(goCtx context.Context, req *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	groupInfo, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposer := range req.Proposers {
+		addr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return nil, err
+		}
+		if _, found := k.GetGroupMember(ctx, groupInfo.GroupId, addr); !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a member of group %d", proposer, groupInfo.GroupId)
+		}
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureMsgSigners(msgs, policy); err != nil {
+		return nil, err
+	}
+
+	decisionPolicy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID := k.nextSequence(ctx, types.ProposalSeqKey)
+	proposal := types.Proposal{
+		ProposalId:         proposalID,
+		Address:            req.Address,
+		Metadata:           req.Metadata,
+		Proposers:          req.Proposers,
+		SubmittedAt:        ctx.BlockTime(),
+		GroupVersion:       groupInfo.Version,
+		GroupPolicyVersion: policyInfo.Version,
+		Status:             types.ProposalStatusSubmitted,
+		Result:             types.ProposalResultUnfinalized,
+		VoteState:          types.NewTally(),
+		Timeout:            ctx.BlockTime().Add(decisionPolicy.GetTimeout()),
+		ExecutorResult:     types.ProposalExecutorResultNotRun,
+		Msgs:               req.Msgs,
+	}
+	if err := k.setProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, req.Address),
+		),
+	)
+
+	// try to execute the proposal immediately, the proposers voting yes
+	if req.Exec == types.ExecTry {
+		for _, proposer := range req.Proposers {
+			// the proposal is closed once the tally is final
+			if proposal.Status != types.ProposalStatusSubmitted {
+				break
+			}
+			vote := types.Vote{
+				ProposalId:  proposalID,
+				Voter:       proposer,
+				Choice:      types.ChoiceYes,
+				SubmittedAt: ctx.BlockTime(),
+			}
+			if err := k.vote(ctx, &proposal, vote); err != nil {
+				return nil, sdkerrors.Wrap(err, "the proposal was created but failed on vote")
+			}
+		}
+		if err := k.exec(ctx, &proposal); err != nil {
+			return nil, sdkerrors.Wrap(err, "the proposal was created but failed on exec")
+		}
+	}
+
+	return &types.MsgSubmitProposalResponse{ProposalId: proposalID}, nil
+}
+
+func (k msgServer) Vote(goCtx context.Context, req *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	vote := types.Vote{
+		ProposalId:  req.ProposalId,
+		Voter:       req.Voter,
+		Choice:      req.Choice,
+		Metadata:    req.Metadata,
+		SubmittedAt: ctx.BlockTime(),
+	}
+	if err := k.vote(ctx, &proposal, vote); err != nil {
+		return nil, err
+	}
+
+	if req.Exec == types.ExecTry {
+		if err := k.exec(ctx, &proposal); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (k msgServer) Exec(goCtx context.Context, req *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.exec(ctx, &proposal); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecResponse{}, nil
+}
+
+// getGroupInfoAsAdmin returns the group with the given ID, checking that it is
+// administered by the given admin.
+func (k Keeper) getGroupInfoAsAdmin(ctx sdk.Context, groupID uint64, admin string) (types.GroupInfo, error) {
+	groupInfo, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return groupInfo, err
+	}
+	if groupInfo.Admin != admin {
+		return groupInfo, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group %d", admin, groupID)
+	}
+	return groupInfo, nil
+}
+
+// getGroupPolicyInfoAsAdmin returns the group policy with the given address,
+// checking that it is administered by the given admin.
+func (k Keeper) getGroupPolicyInfoAsAdmin(ctx sdk.Context, address, admin string) (types.GroupPolicyInfo, error) {
+	policy, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.GroupPolicyInfo{}, err
+	}
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policy)
+	if err != nil {
+		return policyInfo, err
+	}
+	if policyInfo.Admin != admin {
+		return policyInfo, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group policy %s", admin, address)
+	}
+	return policyInfo, nil
+}
+
+func newGroupEvent(eventType string, groupID uint64) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprint(groupID)),
+	)
+}
+
+func newGroupPolicyEvent(eventType string, address string) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyAddress, address),
+	)
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// vote records the vote of a group member on a proposal, and closes the proposal
+// if the new tally is final for the decision policy.
+func (k Keeper) vote(ctx sdk.Context, proposal *types.Proposal, vote types.Vote) error {
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is not open for voting", proposal.ProposalId)
+	}
+	if !ctx.BlockTime().Before(proposal.Timeout) {
+		return sdkerrors.Wrapf(types.ErrExpired, "voting period of proposal %d has ended", proposal.ProposalId)
+	}
+
+	policyInfo, groupInfo, err := k.getProposalElectorate(ctx, *proposal)
+	if err != nil {
+		return err
+	}
+	if proposal.GroupPolicyVersion != policyInfo.Version {
+		return sdkerrors.Wrap(types.ErrModified, "group policy was modified")
+	}
+	if proposal.GroupVersion != groupInfo.Version {
+		return sdkerrors.Wrap(types.ErrModified, "group was modified")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return err
+	}
+	member, found := k.GetGroupMember(ctx, groupInfo.GroupId, voter)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a member of group %d", vote.Voter, groupInfo.GroupId)
+	}
+	if _, found := k.GetVote(ctx, proposal.ProposalId, voter); found {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "vote of %s on proposal %d", vote.Voter, proposal.ProposalId)
+	}
+
+	if err := proposal.VoteState.Add(vote.Choice, member.Member.Weight); err != nil {
+		return err
+	}
+	if err := k.setVote(ctx, vote); err != nil {
+		return err
+	}
+	if err := k.tally(ctx, proposal, policyInfo, groupInfo); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyVoter, vote.Voter),
+		),
+	)
+
+	return k.setProposal(ctx, *proposal)
+}
+
+// tally closes the proposal if the decision policy of its group policy gives a
+// final result. Once the timeout is reached, a proposal which was not accepted
+// is rejected.
+func (k Keeper) tally(ctx sdk.Context, proposal *types.Proposal, policyInfo types.GroupPolicyInfo, groupInfo types.GroupInfo) error {
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+	result, err := policy.Allow(proposal.VoteState, groupInfo.TotalWeight)
+	if err != nil {
+		return err
+	}
+	if !result.Final && !ctx.BlockTime().Before(proposal.Timeout) {
+		result = types.DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	if result.Final {
+		proposal.Status = types.ProposalStatusClosed
+		if result.Allow {
+			proposal.Result = types.ProposalResultAccepted
+		} else {
+			proposal.Result = types.ProposalResultRejected
+		}
+	}
+	return nil
+}
+
+// exec closes the proposal if its tally is final, and executes its msgs if it
+// was accepted and not successfully executed yet. A proposal whose group or
+// group policy was modified since submission is aborted.
+func (k Keeper) exec(ctx sdk.Context, proposal *types.Proposal) error {
+	if proposal.Status != types.ProposalStatusSubmitted && proposal.Status != types.ProposalStatusClosed {
+		return sdkerrors.Wrapf(types.ErrInvalid, "can't execute proposal %d with status %s", proposal.ProposalId, proposal.Status)
+	}
+
+	policyInfo, groupInfo, err := k.getProposalElectorate(ctx, *proposal)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status == types.ProposalStatusSubmitted {
+		if proposal.GroupPolicyVersion != policyInfo.Version || proposal.GroupVersion != groupInfo.Version {
+			proposal.Status = types.ProposalStatusAborted
+			return k.setProposal(ctx, *proposal)
+		}
+		if err := k.tally(ctx, proposal, policyInfo, groupInfo); err != nil {
+			return err
+		}
+	}
+
+	if proposal.Status == types.ProposalStatusClosed && proposal.Result == types.ProposalResultAccepted &&
+		proposal.ExecutorResult != types.ProposalExecutorResultSuccess {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.execMsgs(cacheCtx, *proposal, policyInfo); err != nil {
+			proposal.ExecutorResult = types.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposal.ProposalId, "err", err)
+		} else {
+			proposal.ExecutorResult = types.ProposalExecutorResultSuccess
+			writeCache()
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	return k.setProposal(ctx, *proposal)
+}
+
+// execMsgs executes the msgs of a proposal through the msg service router. Each
+// msg must be signed by the group policy account only.
+func (k Keeper) execMsgs(ctx sdk.Context, proposal types.Proposal, policyInfo types.GroupPolicyInfo) error {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+	policy, err := sdk.AccAddressFromBech32(policyInfo.Address)
+	if err != nil {
+		return err
+	}
+	if err := ensureMsgSigners(msgs, policy); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg.MethodName)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.MethodName)
+		}
+		if _, err := handler(ctx, msg.Request); err != nil {
+			return sdkerrors.Wrapf(err, "message %d %s", i, msg.MethodName)
+		}
+	}
+	return nil
+}
+
+// getProposalElectorate returns the group policy of a proposal and its group.
+func (k Keeper) getProposalElectorate(ctx sdk.Context, proposal types.Proposal) (types.GroupPolicyInfo, types.GroupInfo, error) {
+	policy, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policy)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	groupInfo, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	return policyInfo, groupInfo, nil
+}
+
+// ensureMsgSigners checks that the group policy account is the only signer of
+// every msg.
+func ensureMsgSigners(msgs []sdk.ServiceMsg, policy sdk.AccAddress) error {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(policy) {
+				return sdkerrors.Wrapf(types.ErrUnauthorized, "msg %s must be signed by the group policy account only", msg.MethodName)
+			}
+		}
+	}
+	return nil
+}
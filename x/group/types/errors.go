@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	ErrEmpty        = sdkerrors.Register(ModuleName, 2, "value is empty")
+	ErrDuplicate    = sdkerrors.Register(ModuleName, 3, "duplicate value")
+	ErrMaxLimit     = sdkerrors.Register(ModuleName, 4, "limit exceeded")
+	ErrType         = sdkerrors.Register(ModuleName, 5, "invalid type")
+	ErrInvalid      = sdkerrors.Register(ModuleName, 6, "invalid value")
+	ErrUnauthorized = sdkerrors.Register(ModuleName, 7, "unauthorized")
+	ErrModified     = sdkerrors.Register(ModuleName, 8, "modified")
+	ErrExpired      = sdkerrors.Register(ModuleName, 9, "expired")
+	ErrNotFound     = sdkerrors.Register(ModuleName, 10, "not found")
+)
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// DefaultGenesisState returns default state for group module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState()
+}
+
+// Validate performs a basic validation of the genesis state, checking the
+// entities and that they only reference existing groups and policies.
+func (s GenesisState) Validate() error {
+	groups := make(map[uint64]GroupInfo, len(s.Groups))
+	for _, g := range s.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group")
+		}
+		if g.GroupId > s.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d is greater than the group sequence", g.GroupId)
+		}
+		if _, ok := groups[g.GroupId]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group %d", g.GroupId)
+		}
+		groups[g.GroupId] = g
+	}
+
+	for _, m := range s.GroupMembers {
+		if _, ok := groups[m.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of member %s", m.GroupId, m.Member.Address)
+		}
+		if err := m.Member.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group member")
+		}
+	}
+
+	policies := make(map[string]bool, len(s.GroupPolicies))
+	for _, p := range s.GroupPolicies {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group policy")
+		}
+		if _, ok := groups[p.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of group policy %s", p.GroupId, p.Address)
+		}
+		if policies[p.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "group policy %s", p.Address)
+		}
+		policies[p.Address] = true
+	}
+
+	proposals := make(map[uint64]bool, len(s.Proposals))
+	for _, p := range s.Proposals {
+		if p.ProposalId == 0 || p.ProposalId > s.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d", p.ProposalId)
+		}
+		if !policies[p.Address] {
+			return sdkerrors.Wrapf(ErrNotFound, "group policy %s of proposal %d", p.Address, p.ProposalId)
+		}
+		if proposals[p.ProposalId] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal %d", p.ProposalId)
+		}
+		proposals[p.ProposalId] = true
+	}
+
+	for _, v := range s.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "vote")
+		}
+		if !proposals[v.ProposalId] {
+			return sdkerrors.Wrapf(ErrNotFound, "proposal %d of vote", v.ProposalId)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, p := range s.GroupPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range s.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "group"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x00: GroupSeq
+// - 0x01<groupID_Bytes>: GroupInfo
+// - 0x02<groupID_Bytes><memberAddressLen (1 Byte)><memberAddress_Bytes>: GroupMember
+// - 0x03<adminAddressLen (1 Byte)><adminAddress_Bytes><groupID_Bytes>: []byte{}
+//
+// - 0x10: GroupPolicySeq
+// - 0x11<policyAddressLen (1 Byte)><policyAddress_Bytes>: GroupPolicyInfo
+// - 0x12<groupID_Bytes><policyAddressLen (1 Byte)><policyAddress_Bytes>: []byte{}
+// - 0x13<adminAddressLen (1 Byte)><adminAddress_Bytes><policyAddressLen (1 Byte)><policyAddress_Bytes>: []byte{}
+//
+// - 0x20: ProposalSeq
+// - 0x21<proposalID_Bytes>: Proposal
+// - 0x22<policyAddressLen (1 Byte)><policyAddress_Bytes><proposalID_Bytes>: []byte{}
+//
+// - 0x30<proposalID_Bytes><voterAddressLen (1 Byte)><voterAddress_Bytes>: Vote
+// - 0x31<voterAddressLen (1 Byte)><voterAddress_Bytes><proposalID_Bytes>: []byte{}
+var (
+	GroupSeqKey            = []byte{0x00}
+	GroupKeyPrefix         = []byte{0x01}
+	GroupMemberKeyPrefix   = []byte{0x02}
+	GroupByAdminKeyPrefix  = []byte{0x03}
+	GroupPolicySeqKey      = []byte{0x10}
+	GroupPolicyKeyPrefix   = []byte{0x11}
+	PolicyByGroupKeyPrefix = []byte{0x12}
+	PolicyByAdminKeyPrefix = []byte{0x13}
+	ProposalSeqKey         = []byte{0x20}
+	ProposalKeyPrefix      = []byte{0x21}
+	ProposalByPolicyPrefix = []byte{0x22}
+	VoteKeyPrefix          = []byte{0x30}
+	VoteByVoterKeyPrefix   = []byte{0x31}
+)
+
+// GroupKey returns the key of the group with the given ID.
+func GroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupMembersPrefix returns the prefix to iterate over the members of a group.
+func GroupMembersPrefix(groupID uint64) []byte {
+	return append(GroupMemberKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupMemberKey returns the key of a group member.
+func GroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GroupMembersPrefix(groupID), address.MustLengthPrefix(member)...)
+}
+
+// GroupsByAdminPrefix returns the prefix to iterate over the groups of an admin.
+func GroupsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminKeyPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// GroupByAdminKey returns the index key of a group by its admin.
+func GroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GroupsByAdminPrefix(admin), sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupPolicyKey returns the key of the group policy with the given account address.
+func GroupPolicyKey(policy sdk.AccAddress) []byte {
+	return append(GroupPolicyKeyPrefix, address.MustLengthPrefix(policy)...)
+}
+
+// PoliciesByGroupPrefix returns the prefix to iterate over the policies of a group.
+func PoliciesByGroupPrefix(groupID uint64) []byte {
+	return append(PolicyByGroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// PolicyByGroupKey returns the index key of a group policy by its group.
+func PolicyByGroupKey(groupID uint64, policy sdk.AccAddress) []byte {
+	return append(PoliciesByGroupPrefix(groupID), address.MustLengthPrefix(policy)...)
+}
+
+// PoliciesByAdminPrefix returns the prefix to iterate over the group policies of an admin.
+func PoliciesByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(PolicyByAdminKeyPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// PolicyByAdminKey returns the index key of a group policy by its admin.
+func PolicyByAdminKey(admin sdk.AccAddress, policy sdk.AccAddress) []byte {
+	return append(PoliciesByAdminPrefix(admin), address.MustLengthPrefix(policy)...)
+}
+
+// ProposalKey returns the key of the proposal with the given ID.
+func ProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// ProposalsByPolicyPrefix returns the prefix to iterate over the proposals of a group policy.
+func ProposalsByPolicyPrefix(policy sdk.AccAddress) []byte {
+	return append(ProposalByPolicyPrefix, address.MustLengthPrefix(policy)...)
+}
+
+// ProposalByPolicyKey returns the index key of a proposal by its group policy.
+func ProposalByPolicyKey(policy sdk.AccAddress, proposalID uint64) []byte {
+	return append(ProposalsByPolicyPrefix(policy), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VotesByProposalPrefix returns the prefix to iterate over the votes of a proposal.
+func VotesByProposalPrefix(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteKey returns the key of the vote of a voter on a proposal.
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesByProposalPrefix(proposalID), address.MustLengthPrefix(voter)...)
+}
+
+// VotesByVoterPrefix returns the prefix to iterate over the votes of a voter.
+func VotesByVoterPrefix(voter sdk.AccAddress) []byte {
+	return append(VoteByVoterKeyPrefix, address.MustLengthPrefix(voter)...)
+}
+
+// VoteByVoterKey returns the index key of a vote by its voter.
+func VoteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return append(VotesByVoterPrefix(voter), sdk.Uint64ToBigEndian(proposalID)...)
+}
@@ -0,0 +1,437 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.MsgRequest = &MsgCreateGroup{}
+	_ sdk.MsgRequest = &MsgUpdateGroupMembers{}
+	_ sdk.MsgRequest = &MsgUpdateGroupAdmin{}
+	_ sdk.MsgRequest = &MsgUpdateGroupMetadata{}
+	_ sdk.MsgRequest = &MsgCreateGroupPolicy{}
+	_ sdk.MsgRequest = &MsgUpdateGroupPolicyAdmin{}
+	_ sdk.MsgRequest = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ sdk.MsgRequest = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.MsgRequest = &MsgSubmitProposal{}
+	_ sdk.MsgRequest = &MsgVote{}
+	_ sdk.MsgRequest = &MsgExec{}
+
+	_ types.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
+	_ types.UnpackInterfacesMessage = MsgUpdateGroupPolicyDecisionPolicy{}
+	_ types.UnpackInterfacesMessage = MsgSubmitProposal{}
+)
+
+// NewMsgCreateGroup creates a new MsgCreateGroup.
+//nolint:interfacer
+func NewMsgCreateGroup(admin sdk.AccAddress, members []Member, metadata []byte) *MsgCreateGroup {
+	return &MsgCreateGroup{
+		Admin:    admin.String(),
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgCreateGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	if err := validateMembers(m.Members); err != nil {
+		return err
+	}
+	for _, member := range m.Members {
+		if !member.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalid, "weight of member %s must be positive", member.Address)
+		}
+	}
+	return validateMetadata(m.Metadata, "group metadata")
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers.
+//nolint:interfacer
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, memberUpdates []Member) *MsgUpdateGroupMembers {
+	return &MsgUpdateGroupMembers{
+		Admin:         admin.String(),
+		GroupId:       groupID,
+		MemberUpdates: memberUpdates,
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgUpdateGroupMembers) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	if len(m.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+	return validateMembers(m.MemberUpdates)
+}
+
+// NewMsgUpdateGroupAdmin creates a new MsgUpdateGroupAdmin.
+//nolint:interfacer
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) *MsgUpdateGroupAdmin {
+	return &MsgUpdateGroupAdmin{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgUpdateGroupAdmin) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	return validateAdminUpdate(m.Admin, m.NewAdmin)
+}
+
+// NewMsgUpdateGroupMetadata creates a new MsgUpdateGroupMetadata.
+//nolint:interfacer
+func NewMsgUpdateGroupMetadata(admin sdk.AccAddress, groupID uint64, metadata []byte) *MsgUpdateGroupMetadata {
+	return &MsgUpdateGroupMetadata{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		Metadata: metadata,
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgUpdateGroupMetadata) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	return validateMetadata(m.Metadata, "group metadata")
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy.
+//nolint:interfacer
+func NewMsgCreateGroupPolicy(admin sdk.AccAddress, groupID uint64, metadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupPolicy, error) {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgCreateGroupPolicy{
+		Admin:          admin.String(),
+		GroupId:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the unpacked decision policy of the msg.
+func (m MsgCreateGroupPolicy) GetDecisionPolicy() (DecisionPolicy, error) {
+	return unpackDecisionPolicy(m.DecisionPolicy)
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgCreateGroupPolicy) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	policy, err := m.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+	return validateMetadata(m.Metadata, "group policy metadata")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateGroupPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgUpdateGroupPolicyAdmin creates a new MsgUpdateGroupPolicyAdmin.
+//nolint:interfacer
+func NewMsgUpdateGroupPolicyAdmin(admin, address, newAdmin sdk.AccAddress) *MsgUpdateGroupPolicyAdmin {
+	return &MsgUpdateGroupPolicyAdmin{
+		Admin:    admin.String(),
+		Address:  address.String(),
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgUpdateGroupPolicyAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgUpdateGroupPolicyAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid group policy address")
+	}
+	return validateAdminUpdate(m.Admin, m.NewAdmin)
+}
+
+// NewMsgUpdateGroupPolicyDecisionPolicy creates a new MsgUpdateGroupPolicyDecisionPolicy.
+//nolint:interfacer
+func NewMsgUpdateGroupPolicyDecisionPolicy(admin, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicy, error) {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupPolicyDecisionPolicy{
+		Admin:          admin.String(),
+		Address:        address.String(),
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the unpacked decision policy of the msg.
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetDecisionPolicy() (DecisionPolicy, error) {
+	return unpackDecisionPolicy(m.DecisionPolicy)
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid group policy address")
+	}
+	policy, err := m.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+	return policy.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgUpdateGroupPolicyDecisionPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgUpdateGroupPolicyMetadata creates a new MsgUpdateGroupPolicyMetadata.
+//nolint:interfacer
+func NewMsgUpdateGroupPolicyMetadata(admin, address sdk.AccAddress, metadata []byte) *MsgUpdateGroupPolicyMetadata {
+	return &MsgUpdateGroupPolicyMetadata{
+		Admin:    admin.String(),
+		Address:  address.String(),
+		Metadata: metadata,
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgUpdateGroupPolicyMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Admin)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgUpdateGroupPolicyMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid group policy address")
+	}
+	return validateMetadata(m.Metadata, "group policy metadata")
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+//nolint:interfacer
+func NewMsgSubmitProposal(address sdk.AccAddress, proposers []string, msgs []sdk.ServiceMsg, metadata []byte, exec Exec) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		Address:   address.String(),
+		Proposers: proposers,
+		Metadata:  metadata,
+		Exec:      exec,
+	}
+	if err := m.SetMsgs(msgs); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SetMsgs packs the given service msgs into the msg.
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.ServiceMsg) error {
+	anys, err := packServiceMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Msgs = anys
+	return nil
+}
+
+// GetMsgs returns the unpacked service msgs of the proposal.
+func (m MsgSubmitProposal) GetMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(m.Msgs)
+}
+
+// GetSigners implements MsgRequest.GetSigners, the proposers must sign the msg.
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(m.Proposers))
+	for i, proposer := range m.Proposers {
+		signers[i] = mustAccAddress(proposer)
+	}
+	return signers
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid group policy address")
+	}
+	if len(m.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	seen := make(map[string]bool, len(m.Proposers))
+	for _, proposer := range m.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address %s", proposer)
+		}
+		if seen[proposer] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposer %s", proposer)
+		}
+		seen[proposer] = true
+	}
+	if _, ok := Exec_name[int32(m.Exec)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "exec")
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+	return validateMetadata(m.Metadata, "proposal metadata")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackServiceMsgAnys(unpacker, m.Msgs)
+}
+
+// NewMsgVote creates a new MsgVote.
+//nolint:interfacer
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, choice Choice, metadata []byte, exec Exec) *MsgVote {
+	return &MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Choice:     choice,
+		Metadata:   metadata,
+		Exec:       exec,
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Voter)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgVote) ValidateBasic() error {
+	vote := Vote{
+		ProposalId: m.ProposalId,
+		Voter:      m.Voter,
+		Choice:     m.Choice,
+		Metadata:   m.Metadata,
+	}
+	if err := vote.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, ok := Exec_name[int32(m.Exec)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "exec")
+	}
+	return nil
+}
+
+// NewMsgExec creates a new MsgExec.
+//nolint:interfacer
+func NewMsgExec(signer sdk.AccAddress, proposalID uint64) *MsgExec {
+	return &MsgExec{
+		ProposalId: proposalID,
+		Signer:     signer.String(),
+	}
+}
+
+// GetSigners implements MsgRequest.GetSigners
+func (m MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Signer)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (m MsgExec) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer address")
+	}
+	return nil
+}
+
+func validateAdminUpdate(admin, newAdmin string) error {
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(newAdmin)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid new admin address")
+	}
+	if adminAddr.Equals(newAdminAddr) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+func mustAccAddress(addr string) sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return accAddr
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	admin   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	member1 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	member2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	specs := map[string]struct {
+		msg    *types.MsgCreateGroup
+		expErr bool
+	}{
+		"valid": {
+			msg: types.NewMsgCreateGroup(admin, []types.Member{
+				{Address: member1.String(), Weight: sdk.OneDec()},
+				{Address: member2.String(), Weight: sdk.NewDec(2)},
+			}, []byte("metadata")),
+		},
+		"without members": {
+			msg: types.NewMsgCreateGroup(admin, nil, nil),
+		},
+		"invalid admin": {
+			msg:    &types.MsgCreateGroup{Admin: "invalid"},
+			expErr: true,
+		},
+		"zero weight": {
+			msg:    types.NewMsgCreateGroup(admin, []types.Member{{Address: member1.String(), Weight: sdk.ZeroDec()}}, nil),
+			expErr: true,
+		},
+		"duplicate member": {
+			msg: types.NewMsgCreateGroup(admin, []types.Member{
+				{Address: member1.String(), Weight: sdk.OneDec()},
+				{Address: member1.String(), Weight: sdk.OneDec()},
+			}, nil),
+			expErr: true,
+		},
+		"metadata too long": {
+			msg:    types.NewMsgCreateGroup(admin, nil, make([]byte, types.MaxMetadataLength+1)),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.msg.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{admin}, spec.msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	msg := types.NewMsgUpdateGroupMembers(admin, 1, []types.Member{{Address: member1.String(), Weight: sdk.ZeroDec()}})
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgUpdateGroupMembers(admin, 1, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.NewMsgUpdateGroupMembers(admin, 0, []types.Member{{Address: member1.String(), Weight: sdk.OneDec()}})
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	msg, err := types.NewMsgCreateGroupPolicy(admin, 1, nil, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgCreateGroupPolicy(admin, 1, nil, types.NewThresholdDecisionPolicy(sdk.OneDec(), 0))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = &types.MsgCreateGroupPolicy{Admin: admin.String(), GroupId: 1}
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	policy := sdk.AccAddress([]byte("policy______________"))
+	send := sdk.ServiceMsg{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(policy, member1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+	}
+
+	msg, err := types.NewMsgSubmitProposal(policy, []string{member1.String(), member2.String()}, []sdk.ServiceMsg{send}, nil, types.ExecTry)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{member1, member2}, msg.GetSigners())
+
+	msgs, err := msg.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ServiceMsg{send}, msgs)
+
+	msg, err = types.NewMsgSubmitProposal(policy, nil, nil, nil, types.ExecUnspecified)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgSubmitProposal(policy, []string{member1.String(), member1.String()}, nil, nil, types.ExecUnspecified)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	invalidSend := sdk.ServiceMsg{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(policy, member1, nil),
+	}
+	msg, err = types.NewMsgSubmitProposal(policy, []string{member1.String()}, []sdk.ServiceMsg{invalidSend}, nil, types.ExecUnspecified)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgVote(t *testing.T) {
+	require.NoError(t, types.NewMsgVote(member1, 1, types.ChoiceYes, nil, types.ExecUnspecified).ValidateBasic())
+	require.Error(t, types.NewMsgVote(member1, 0, types.ChoiceYes, nil, types.ExecUnspecified).ValidateBasic())
+	require.Error(t, types.NewMsgVote(member1, 1, types.ChoiceUnspecified, nil, types.ExecUnspecified).ValidateBasic())
+	require.Error(t, types.NewMsgVote(member1, 1, types.Choice(10), nil, types.ExecUnspecified).ValidateBasic())
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicy is the persistent set of rules to determine the result of election on a proposal.
+type DecisionPolicy interface {
+	codec.ProtoMarshaler
+
+	// GetTimeout returns the duration after submission of a proposal during which
+	// votes and executions are allowed.
+	GetTimeout() time.Duration
+
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result and the group's total power.
+	Allow(tally Tally, totalPower sdk.Dec) (DecisionPolicyResult, error)
+
+	// ValidateBasic does a simple validation check of the policy.
+	ValidateBasic() error
+}
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not. If final, then
+	// votes are pruned, and the tally result is saved in the proposal's
+	// `vote_state` field.
+	Final bool
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy.
+func NewThresholdDecisionPolicy(threshold sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "threshold must be positive")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds
+// the threshold before the timeout. The threshold is capped at the total power
+// of the group.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower sdk.Dec) (DecisionPolicyResult, error) {
+	return allowThreshold(tally, totalPower, sdk.MinDec(p.Threshold, totalPower))
+}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy.
+func NewPercentageDecisionPolicy(percentage sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds
+// the given percentage of the total power of the group before the timeout.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower sdk.Dec) (DecisionPolicyResult, error) {
+	return allowThreshold(tally, totalPower, p.Percentage.Mul(totalPower))
+}
+
+// allowThreshold returns the result of a tally against an absolute threshold of
+// yes votes. The result is final once the threshold is reached, or once it can't
+// be reached anymore with the votes that are still undecided.
+func allowThreshold(tally Tally, totalPower, threshold sdk.Dec) (DecisionPolicyResult, error) {
+	if totalPower.IsNil() || !totalPower.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	if tally.YesCount.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalPower.Sub(tally.TotalCounts())
+	if undecided.IsNegative() {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(ErrInvalid, "tally counts exceed the total power")
+	}
+	if tally.YesCount.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	specs := map[string]struct {
+		threshold  string
+		tally      types.Tally
+		totalPower string
+		expResult  types.DecisionPolicyResult
+	}{
+		"accepted when yes votes reach the threshold": {
+			threshold:  "2",
+			tally:      tally("2", "0", "0", "0"),
+			totalPower: "3",
+			expResult:  types.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"not final while the threshold can be reached": {
+			threshold:  "2",
+			tally:      tally("1", "1", "0", "0"),
+			totalPower: "3",
+			expResult:  types.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"rejected once the threshold can't be reached": {
+			threshold:  "2",
+			tally:      tally("0", "1", "1", "0"),
+			totalPower: "3",
+			expResult:  types.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"threshold capped at the total power": {
+			threshold:  "10",
+			tally:      tally("3", "0", "0", "0"),
+			totalPower: "3",
+			expResult:  types.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"rejected with a zero total power": {
+			threshold:  "1",
+			tally:      types.NewTally(),
+			totalPower: "0",
+			expResult:  types.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := types.NewThresholdDecisionPolicy(sdk.MustNewDecFromStr(spec.threshold), time.Hour)
+			require.NoError(t, policy.ValidateBasic())
+			result, err := policy.Allow(spec.tally, sdk.MustNewDecFromStr(spec.totalPower))
+			require.NoError(t, err)
+			require.Equal(t, spec.expResult, result)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	policy := types.NewPercentageDecisionPolicy(sdk.MustNewDecFromStr("0.5"), time.Hour)
+	require.NoError(t, policy.ValidateBasic())
+
+	result, err := policy.Allow(tally("2", "0", "0", "0"), sdk.NewDec(4))
+	require.NoError(t, err)
+	require.Equal(t, types.DecisionPolicyResult{Allow: true, Final: true}, result)
+
+	result, err = policy.Allow(tally("1", "1", "0", "0"), sdk.NewDec(4))
+	require.NoError(t, err)
+	require.Equal(t, types.DecisionPolicyResult{Allow: false, Final: false}, result)
+
+	result, err = policy.Allow(tally("1", "2", "1", "0"), sdk.NewDec(4))
+	require.NoError(t, err)
+	require.Equal(t, types.DecisionPolicyResult{Allow: false, Final: true}, result)
+
+	_, err = policy.Allow(tally("1", "4", "0", "0"), sdk.NewDec(4))
+	require.Error(t, err)
+}
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	require.Error(t, types.NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy(sdk.OneDec(), 0).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.ZeroDec(), time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.MustNewDecFromStr("1.1"), time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.OneDec(), -time.Hour).ValidateBasic())
+	require.NoError(t, types.NewPercentageDecisionPolicy(sdk.OneDec(), time.Hour).ValidateBasic())
+}
+
+func tally(yes, no, abstain, veto string) types.Tally {
+	return types.Tally{
+		YesCount:     sdk.MustNewDecFromStr(yes),
+		NoCount:      sdk.MustNewDecFromStr(no),
+		AbstainCount: sdk.MustNewDecFromStr(abstain),
+		VetoCount:    sdk.MustNewDecFromStr(veto),
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &QueryGroupPolicyInfoResponse{}
+	_ types.UnpackInterfacesMessage = &QueryGroupPoliciesByGroupResponse{}
+	_ types.UnpackInterfacesMessage = &QueryGroupPoliciesByAdminResponse{}
+	_ types.UnpackInterfacesMessage = &QueryProposalResponse{}
+	_ types.UnpackInterfacesMessage = &QueryProposalsByGroupPolicyResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryGroupPolicyInfoResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if q.Info == nil {
+		return nil
+	}
+	return q.Info.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackGroupPolicies(unpacker, q.GroupPolicies)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryGroupPoliciesByAdminResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackGroupPolicies(unpacker, q.GroupPolicies)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryProposalResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if q.Proposal == nil {
+		return nil
+	}
+	return q.Proposal.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryProposalsByGroupPolicyResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, p := range q.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unpackGroupPolicies(unpacker types.AnyUnpacker, policies []*GroupPolicyInfo) error {
+	for _, p := range policies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMetadataLength is the maximum length of the metadata of groups, group
+// policies, members, proposals and votes.
+const MaxMetadataLength = 255
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &GroupPolicyInfo{}
+	_ codectypes.UnpackInterfacesMessage = &Proposal{}
+)
+
+// ValidateBasic performs a basic validation of a member. A zero weight is
+// allowed, as it is used to remove a member from a group.
+func (m Member) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address %s", m.Address)
+	}
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalid, "weight of member %s must not be negative", m.Address)
+	}
+	return validateMetadata(m.Metadata, "member metadata")
+}
+
+// validateMembers checks the members and that no member appears twice.
+func validateMembers(members []Member) error {
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if err := member.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[member.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s", member.Address)
+		}
+		seen[member.Address] = true
+	}
+	return nil
+}
+
+// validateMetadata checks that the metadata doesn't exceed MaxMetadataLength.
+func validateMetadata(metadata []byte, description string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMaxLimit, "%s length must be <= %d", description, MaxMetadataLength)
+	}
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the group info.
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "total weight must not be negative")
+	}
+	return validateMetadata(g.Metadata, "group metadata")
+}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo.
+//nolint:interfacer
+func NewGroupPolicyInfo(address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata []byte,
+	version uint64, decisionPolicy DecisionPolicy) (GroupPolicyInfo, error) {
+	p := GroupPolicyInfo{
+		Address:  address.String(),
+		GroupId:  groupID,
+		Admin:    admin.String(),
+		Metadata: metadata,
+		Version:  version,
+	}
+
+	err := p.SetDecisionPolicy(decisionPolicy)
+	return p, err
+}
+
+// SetDecisionPolicy packs the given decision policy into the group policy info.
+func (g *GroupPolicyInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the unpacked decision policy of the group policy.
+func (g GroupPolicyInfo) GetDecisionPolicy() (DecisionPolicy, error) {
+	return unpackDecisionPolicy(g.DecisionPolicy)
+}
+
+// ValidateBasic performs a basic validation of the group policy info.
+func (g GroupPolicyInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid group policy address")
+	}
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid admin address")
+	}
+	policy, err := g.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+	return validateMetadata(g.Metadata, "group policy metadata")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// NewTally returns a tally with all the counts set to zero.
+func NewTally() Tally {
+	return Tally{
+		YesCount:     sdk.ZeroDec(),
+		NoCount:      sdk.ZeroDec(),
+		AbstainCount: sdk.ZeroDec(),
+		VetoCount:    sdk.ZeroDec(),
+	}
+}
+
+// TotalCounts returns the sum of all the counts of the tally.
+func (t Tally) TotalCounts() sdk.Dec {
+	return t.YesCount.Add(t.NoCount).Add(t.AbstainCount).Add(t.VetoCount)
+}
+
+// Add adds the weight of a vote with the given choice to the tally.
+func (t *Tally) Add(choice Choice, weight sdk.Dec) error {
+	switch choice {
+	case ChoiceYes:
+		t.YesCount = t.YesCount.Add(weight)
+	case ChoiceNo:
+		t.NoCount = t.NoCount.Add(weight)
+	case ChoiceAbstain:
+		t.AbstainCount = t.AbstainCount.Add(weight)
+	case ChoiceVeto:
+		t.VetoCount = t.VetoCount.Add(weight)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", choice)
+	}
+	return nil
+}
+
+// SetMsgs packs the given service msgs into the proposal.
+func (p *Proposal) SetMsgs(msgs []sdk.ServiceMsg) error {
+	anys, err := packServiceMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Msgs = anys
+	return nil
+}
+
+// GetMsgs returns the unpacked service msgs of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(p.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackServiceMsgAnys(unpacker, p.Msgs)
+}
+
+// ValidateBasic performs a basic validation of a vote.
+func (v Vote) ValidateBasic() error {
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid voter address")
+	}
+	if v.Choice == ChoiceUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "choice")
+	}
+	if _, ok := Choice_name[int32(v.Choice)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+	return validateMetadata(v.Metadata, "vote metadata")
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", decisionPolicy)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
+
+func unpackDecisionPolicy(any *codectypes.Any) (DecisionPolicy, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	decisionPolicy, ok := any.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrType, "expected %T, got %T", (DecisionPolicy)(nil), any.GetCachedValue())
+	}
+	return decisionPolicy, nil
+}
+
+// packServiceMsgs packs service msgs into Anys whose type URL is the method
+// name of the msg, as done for the messages of a transaction.
+func packServiceMsgs(msgs []sdk.ServiceMsg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		if msg.Request == nil {
+			return nil, sdkerrors.Wrapf(ErrEmpty, "request of msg %s", msg.MethodName)
+		}
+		any, err := codectypes.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+// unpackServiceMsgs returns the service msgs cached in the given Anys.
+func unpackServiceMsgs(anys []*codectypes.Any) ([]sdk.ServiceMsg, error) {
+	msgs := make([]sdk.ServiceMsg, len(anys))
+	for i, any := range anys {
+		req, ok := any.GetCachedValue().(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %s which is not a sdk.MsgRequest", any.TypeUrl)
+		}
+		msgs[i] = sdk.ServiceMsg{
+			MethodName: any.TypeUrl,
+			Request:    req,
+		}
+	}
+	return msgs, nil
+}
+
+func unpackServiceMsgAnys(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var req sdk.MsgRequest
+		if err := unpacker.UnpackAny(any, &req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Flag names and values
+const (
+	FlagOwner   = "owner"
+	FlagClassID = "class-id"
+)
+
+// GetQueryCmd returns the cli query commands for the nft module.
+func GetQueryCmd() *cobra.Command {
+	nftQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nft module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftQueryCmd.AddCommand(
+		GetCmdQueryClass(),
+		GetCmdQueryClasses(),
+		GetCmdQueryNFT(),
+		GetCmdQueryNFTs(),
+		GetCmdQueryOwner(),
+		GetCmdQueryBalance(),
+		GetCmdQuerySupply(),
+	)
+
+	return nftQueryCmd
+}
+
+// GetCmdQueryClass returns cmd to query an nft class.
+func GetCmdQueryClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query an NFT class based on its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an NFT class based on its id.
+
+Example:
+$ %s query %s class <class-id>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Class(cmd.Context(), &types.QueryClassRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClasses returns cmd to query all nft classes.
+func GetCmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classes",
+		Args:  cobra.NoArgs,
+		Short: "query all NFT classes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all NFT classes.
+
+Example:
+$ %s query %s classes
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Classes(cmd.Context(), &types.QueryClassesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+
+	return cmd
+}
+
+// GetCmdQueryNFT returns cmd to query an nft.
+func GetCmdQueryNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft [class-id] [nft-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query an NFT based on its class and id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an NFT based on its class and id.
+
+Example:
+$ %s query %s nft <class-id> <nft-id>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NFT(cmd.Context(), &types.QueryNFTRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNFTs returns cmd to query the nfts of a class or of an owner.
+func GetCmdQueryNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts",
+		Args:  cobra.NoArgs,
+		Short: "query all NFTs of a given class or owner address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all NFTs of a given class or owner address, at least one of the two must be provided.
+
+Example:
+$ %s query %s nfts --owner=<owner> --class-id=<class-id>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+			if len(owner) > 0 {
+				if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+					return err
+				}
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+			if len(classID) > 0 {
+				if err := types.ValidateClassID(classID); err != nil {
+					return err
+				}
+			}
+
+			if len(owner) == 0 && len(classID) == 0 {
+				return fmt.Errorf("must provide at least one of --%s or --%s", FlagOwner, FlagClassID)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.NFTs(cmd.Context(), &types.QueryNFTsRequest{
+				ClassId:    classID,
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	cmd.Flags().String(FlagOwner, "", "The owner of the nfts")
+	cmd.Flags().String(FlagClassID, "", "The class-id of the nfts")
+
+	return cmd
+}
+
+// GetCmdQueryOwner returns cmd to query the owner of an nft.
+func GetCmdQueryOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner [class-id] [nft-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the owner of the NFT based on its class and id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the owner of the NFT based on its class and id.
+
+Example:
+$ %s query %s owner <class-id> <nft-id>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Owner(cmd.Context(), &types.QueryOwnerRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBalance returns cmd to query the number of nfts of a class owned by an account.
+func GetCmdQueryBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance [owner] [class-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the number of NFTs of a given class owned by the owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of NFTs of a given class owned by the owner.
+
+Example:
+$ %s query %s balance <owner> <class-id>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Balance(cmd.Context(), &types.QueryBalanceRequest{
+				ClassId: args[1],
+				Owner:   args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupply returns cmd to query the number of nfts of a class.
+func GetCmdQuerySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the number of nft based on the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of nft based on the class.
+
+Example:
+$ %s query %s supply <class-id>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Supply(cmd.Context(), &types.QuerySupplyRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// GetTxCmd returns the transaction commands for the nft module.
+func GetTxCmd() *cobra.Command {
+	nftTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "nft transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftTxCmd.AddCommand(
+		NewCmdSend(),
+	)
+
+	return nftTxCmd
+}
+
+// NewCmdSend returns a CLI command handler for sending an nft.
+func NewCmdSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [class-id] [nft-id] [receiver]",
+		Args:  cobra.ExactArgs(3),
+		Short: "transfer ownership of nft",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of an nft to another account.
+
+Example:
+$ %s tx %s send <class-id> <nft-id> <receiver> --from <sender>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSend(args[0], args[1], clientCtx.GetFromAddress(), receiver)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Send(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
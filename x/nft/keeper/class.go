@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// SaveClass defines a method for creating a new nft class
+func (k Keeper) SaveClass(ctx sdk.Context, class types.Class) error {
+	if err := class.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(types.ErrClassExists, class.Id)
+	}
+	return k.setClass(ctx, class)
+}
+
+// UpdateClass defines a method for updating an existing nft class
+func (k Keeper) UpdateClass(ctx sdk.Context, class types.Class) error {
+	if !k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, class.Id)
+	}
+	return k.setClass(ctx, class)
+}
+
+// GetClass defines a method for returning the class information of the specified id
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, bool) {
+	var class types.Class
+	bz := ctx.KVStore(k.storeKey).Get(types.StoreKeyForClass(classID))
+	if len(bz) == 0 {
+		return class, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &class)
+	return class, true
+}
+
+// GetClasses defines a method for returning all classes information
+func (k Keeper) GetClasses(ctx sdk.Context) (classes []*types.Class) {
+	k.IterateClasses(ctx, func(class types.Class) bool {
+		classes = append(classes, &class)
+		return false
+	})
+	return
+}
+
+// IterateClasses iterates over all the classes, calling cb on each of them
+// until it returns true.
+func (k Keeper) IterateClasses(ctx sdk.Context, cb func(class types.Class) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClassKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &class)
+		if cb(class) {
+			break
+		}
+	}
+}
+
+// HasClass determines whether the specified classID exist
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.StoreKeyForClass(classID))
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class types.Class) error {
+	bz, err := k.cdc.MarshalBinaryBare(&class)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.StoreKeyForClass(class.Id), bz)
+	return nil
+}
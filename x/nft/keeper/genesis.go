@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// InitGenesis initializes the nft module's genesis state from a given
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
+	for _, class := range data.Classes {
+		if err := k.SaveClass(ctx, *class); err != nil {
+			return err
+		}
+	}
+	for _, entry := range data.Entries {
+		owner, err := sdk.AccAddressFromBech32(entry.Owner)
+		if err != nil {
+			return err
+		}
+		for _, nft := range entry.Nfts {
+			if err := k.Mint(ctx, *nft, owner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	classes := k.GetClasses(ctx)
+
+	var entries []*types.Entry
+	entryByOwner := make(map[string]*types.Entry)
+	for _, class := range classes {
+		for _, nft := range k.GetNFTsOfClass(ctx, class.Id) {
+			nft := nft
+			owner := k.GetOwner(ctx, nft.ClassId, nft.Id).String()
+			entry, ok := entryByOwner[owner]
+			if !ok {
+				entry = &types.Entry{Owner: owner}
+				entryByOwner[owner] = entry
+				entries = append(entries, entry)
+			}
+			entry.Nfts = append(entry.Nfts, &nft)
+		}
+	}
+
+	return types.NewGenesisState(classes, entries)
+}
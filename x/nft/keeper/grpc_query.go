@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Balance implements the Query/Balance gRPC method.
+func (k Keeper) Balance(goCtx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryBalanceResponse{Amount: k.GetBalance(ctx, req.ClassId, owner)}, nil
+}
+
+// Owner implements the Query/Owner gRPC method.
+func (k Keeper) Owner(goCtx context.Context, req *types.QueryOwnerRequest) (*types.QueryOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateNFTID(req.Id); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := k.GetOwner(ctx, req.ClassId, req.Id)
+	if owner.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrNFTNotExists, "class: %s, id: %s", req.ClassId, req.Id)
+	}
+	return &types.QueryOwnerResponse{Owner: owner.String()}, nil
+}
+
+// Supply implements the Query/Supply gRPC method.
+func (k Keeper) Supply(goCtx context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySupplyResponse{Amount: k.GetTotalSupply(ctx, req.ClassId)}, nil
+}
+
+// NFTs implements the Query/NFTs gRPC method. It returns the NFTs of a class,
+// of an owner, or of a class owned by an owner.
+func (k Keeper) NFTs(goCtx context.Context, req *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ClassId) == 0 && len(req.Owner) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "must provide at least one of class id or owner")
+	}
+	if len(req.ClassId) > 0 {
+		if err := types.ValidateClassID(req.ClassId); err != nil {
+			return nil, err
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(k.storeKey)
+
+	var nfts []*types.NFT
+	var pageRes *query.PageResponse
+
+	if len(req.Owner) == 0 {
+		nftStore := prefix.NewStore(store, types.NFTsOfClassPrefix(req.ClassId))
+		var err error
+		pageRes, err = query.Paginate(nftStore, req.Pagination, func(_ []byte, value []byte) error {
+			var nft types.NFT
+			if err := k.cdc.UnmarshalBinaryBare(value, &nft); err != nil {
+				return err
+			}
+			nfts = append(nfts, &nft)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// the owner index keys are the length-prefixed class id followed by the nft id
+	indexPrefix := types.NFTsOfOwnerPrefix(owner)
+	if len(req.ClassId) > 0 {
+		indexPrefix = types.NFTsOfClassByOwnerPrefix(owner, req.ClassId)
+	}
+	indexStore := prefix.NewStore(store, indexPrefix)
+	pageRes, err = query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		classID, nftID := req.ClassId, string(key)
+		if len(req.ClassId) == 0 {
+			classID, nftID = types.ParseClassAndNFTID(key)
+		}
+		nft, has := k.GetNFT(ctx, classID, nftID)
+		if !has {
+			return sdkerrors.Wrapf(types.ErrNFTNotExists, "class: %s, id: %s", classID, nftID)
+		}
+		nfts = append(nfts, &nft)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+// NFT implements the Query/NFT gRPC method.
+func (k Keeper) NFT(goCtx context.Context, req *types.QueryNFTRequest) (*types.QueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateNFTID(req.Id); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nft, has := k.GetNFT(ctx, req.ClassId, req.Id)
+	if !has {
+		return nil, sdkerrors.Wrapf(types.ErrNFTNotExists, "class: %s, id: %s", req.ClassId, req.Id)
+	}
+	return &types.QueryNFTResponse{Nft: &nft}, nil
+}
+
+// Class implements the Query/Class gRPC method.
+func (k Keeper) Class(goCtx context.Context, req *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, has := k.GetClass(ctx, req.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(types.ErrClassNotExists, req.ClassId)
+	}
+	return &types.QueryClassResponse{Class: &class}, nil
+}
+
+// Classes implements the Query/Classes gRPC method.
+func (k Keeper) Classes(goCtx context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var classes []*types.Class
+	classStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKey)
+	pageRes, err := query.Paginate(classStore, req.Pagination, func(_ []byte, value []byte) error {
+		var class types.Class
+		if err := k.cdc.UnmarshalBinaryBare(value, &class); err != nil {
+			return err
+		}
+		classes = append(classes, &class)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
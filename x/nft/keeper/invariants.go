@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// RegisterInvariants registers the nft module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "owners", Owners(k))
+}
+
+// AllInvariants runs all invariants of the nft module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TotalSupply(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return Owners(k)(ctx)
+	}
+}
+
+// TotalSupply checks that the total supply of every class equals the number
+// of NFTs of the class.
+func TotalSupply(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateClasses(ctx, func(class types.Class) bool {
+			supply := k.GetTotalSupply(ctx, class.Id)
+			nfts := uint64(len(k.GetNFTsOfClass(ctx, class.Id)))
+			if supply != nfts {
+				count++
+				msg += fmt.Sprintf("\tclass %s has a total supply of %d but %d nfts\n", class.Id, supply, nfts)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-supply",
+			fmt.Sprintf("amount of classes with an invalid supply found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// Owners checks that every NFT has an owner and is indexed under that owner,
+// and that the owner index only holds NFTs of their current owner.
+func Owners(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		k.IterateClasses(ctx, func(class types.Class) bool {
+			for _, nft := range k.GetNFTsOfClass(ctx, class.Id) {
+				owner := k.GetOwner(ctx, nft.ClassId, nft.Id)
+				if owner.Empty() || !store.Has(types.NFTOfClassByOwnerStoreKey(owner, nft.ClassId, nft.Id)) {
+					count++
+					msg += fmt.Sprintf("\tnft %s of class %s has an invalid owner\n", nft.Id, nft.ClassId)
+				}
+			}
+			return false
+		})
+
+		iterator := sdk.KVStorePrefixIterator(store, types.NFTOfClassByOwnerKey)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			owner, classID, nftID := types.ParseNFTOfClassByOwnerKey(iterator.Key()[len(types.NFTOfClassByOwnerKey):])
+			if !owner.Equals(k.GetOwner(ctx, classID, nftID)) {
+				count++
+				msg += fmt.Sprintf("	nft %s of class %s is indexed under %s which doesn't own it\n", nftID, classID, owner)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "owners",
+			fmt.Sprintf("amount of nfts with an invalid owner found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Keeper of the nft store. Other modules, such as marketplaces, build on the
+// nft module through its exported methods: they define the classes and mint,
+// update, burn and transfer the NFTs under their own rules.
+type Keeper struct {
+	cdc      codec.BinaryMarshaler
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new nft Keeper instance
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	testClassID = "kitty"
+	testNFTID   = "kitty1"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.NFTKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestSaveClass() {
+	class := types.Class{
+		Id:          testClassID,
+		Name:        "Kitty",
+		Symbol:      "KT",
+		Description: "Crypto Kitty",
+		Uri:         "kitty.com",
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+	s.Require().ErrorIs(s.app.NFTKeeper.SaveClass(s.ctx, class), types.ErrClassExists)
+	s.Require().ErrorIs(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: "1kitty"}), types.ErrInvalidID)
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(class, actual)
+
+	class.Description = "Crypto Kitty 2"
+	s.Require().NoError(s.app.NFTKeeper.UpdateClass(s.ctx, class))
+	actual, _ = s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().EqualValues(class, actual)
+	s.Require().ErrorIs(s.app.NFTKeeper.UpdateClass(s.ctx, types.Class{Id: "puppy"}), types.ErrClassNotExists)
+
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: "puppy"}))
+	s.Require().Len(s.app.NFTKeeper.GetClasses(s.ctx), 2)
+}
+
+func (s *TestSuite) TestMintTransferBurn() {
+	owner, receiver := s.addrs[0], s.addrs[1]
+	token := types.NFT{ClassId: testClassID, Id: testNFTID, Uri: "kitty.com/1"}
+
+	s.Require().ErrorIs(s.app.NFTKeeper.Mint(s.ctx, token, owner), types.ErrClassNotExists)
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, token, owner))
+	s.Require().ErrorIs(s.app.NFTKeeper.Mint(s.ctx, token, owner), types.ErrNFTExists)
+
+	actual, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testNFTID)
+	s.Require().True(has)
+	s.Require().EqualValues(token, actual)
+	s.Require().Equal(owner, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testNFTID))
+	s.Require().Equal(uint64(1), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, owner))
+	s.Require().Equal(uint64(1), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+	s.Require().Equal([]types.NFT{token}, s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, owner))
+
+	token.Uri = "kitty.com/2"
+	s.Require().NoError(s.app.NFTKeeper.Update(s.ctx, token))
+	actual, _ = s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testNFTID)
+	s.Require().EqualValues(token, actual)
+
+	s.Require().NoError(s.app.NFTKeeper.Transfer(s.ctx, testClassID, testNFTID, receiver))
+	s.Require().Equal(receiver, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testNFTID))
+	s.Require().Equal(uint64(0), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, owner))
+	s.Require().Equal(uint64(1), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, receiver))
+	s.Require().Empty(s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, owner))
+
+	s.Require().NoError(s.app.NFTKeeper.Burn(s.ctx, testClassID, testNFTID))
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testNFTID))
+	s.Require().Empty(s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testNFTID))
+	s.Require().Equal(uint64(0), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, receiver))
+	s.Require().Equal(uint64(0), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+	s.Require().ErrorIs(s.app.NFTKeeper.Burn(s.ctx, testClassID, testNFTID), types.ErrNFTNotExists)
+
+	_, broken := keeper.AllInvariants(s.app.NFTKeeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *TestSuite) TestSend() {
+	owner, receiver := s.addrs[0], s.addrs[1]
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: testNFTID}, owner))
+
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := msgServer.Send(goCtx, types.NewMsgSend(testClassID, testNFTID, receiver, owner))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(testClassID, testNFTID, owner, receiver))
+	s.Require().NoError(err)
+	s.Require().Equal(receiver, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testNFTID))
+}
+
+func (s *TestSuite) TestQueries() {
+	owner, other := s.addrs[0], s.addrs[1]
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: "puppy"}))
+	for _, token := range []types.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+		{ClassId: "puppy", Id: "puppy1"},
+	} {
+		s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, token, owner))
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: "kitty3"}, other))
+
+	balance, err := s.queryClient.Balance(goCtx, &types.QueryBalanceRequest{ClassId: testClassID, Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), balance.Amount)
+
+	ownerRes, err := s.queryClient.Owner(goCtx, &types.QueryOwnerRequest{ClassId: testClassID, Id: "kitty3"})
+	s.Require().NoError(err)
+	s.Require().Equal(other.String(), ownerRes.Owner)
+	_, err = s.queryClient.Owner(goCtx, &types.QueryOwnerRequest{ClassId: testClassID, Id: "kitty4"})
+	s.Require().Error(err)
+
+	supply, err := s.queryClient.Supply(goCtx, &types.QuerySupplyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), supply.Amount)
+
+	nfts, err := s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Len(nfts.Nfts, 3)
+	nfts, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{ClassId: testClassID, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(nfts.Nfts, 2)
+	s.Require().Equal(uint64(3), nfts.Pagination.Total)
+	nfts, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(nfts.Nfts, 3)
+	nfts, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{ClassId: testClassID, Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.NFT{{ClassId: testClassID, Id: "kitty1"}, {ClassId: testClassID, Id: "kitty2"}}, nfts.Nfts)
+	_, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{})
+	s.Require().Error(err)
+
+	nft, err := s.queryClient.NFT(goCtx, &types.QueryNFTRequest{ClassId: "puppy", Id: "puppy1"})
+	s.Require().NoError(err)
+	s.Require().Equal("puppy1", nft.Nft.Id)
+
+	class, err := s.queryClient.Class(goCtx, &types.QueryClassRequest{ClassId: "puppy"})
+	s.Require().NoError(err)
+	s.Require().Equal("puppy", class.Class.Id)
+	classes, err := s.queryClient.Classes(goCtx, &types.QueryClassesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(classes.Classes, 2)
+}
+
+func (s *TestSuite) TestExportImportGenesis() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, types.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: "kitty1"}, s.addrs[0]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: "kitty2"}, s.addrs[1]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: "kitty3"}, s.addrs[0]))
+
+	genState := s.app.NFTKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(types.ValidateGenesis(*genState))
+	s.Require().Len(genState.Classes, 1)
+	s.Require().Len(genState.Entries, 2)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	s.Require().NoError(app.NFTKeeper.InitGenesis(ctx, genState))
+	s.Require().Equal(genState, app.NFTKeeper.ExportGenesis(ctx))
+	s.Require().Equal(uint64(3), app.NFTKeeper.GetTotalSupply(ctx, testClassID))
+	s.Require().Equal(uint64(2), app.NFTKeeper.GetBalance(ctx, testClassID, s.addrs[0]))
+}
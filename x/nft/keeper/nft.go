@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Mint defines a method for minting a new nft
+func (k Keeper) Mint(ctx sdk.Context, token types.NFT, receiver sdk.AccAddress) error {
+	if err := token.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.HasClass(ctx, token.ClassId) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, token.ClassId)
+	}
+	if k.HasNFT(ctx, token.ClassId, token.Id) {
+		return sdkerrors.Wrapf(types.ErrNFTExists, "class: %s, id: %s", token.ClassId, token.Id)
+	}
+
+	if err := k.setNFT(ctx, token); err != nil {
+		return err
+	}
+	k.setOwner(ctx, token.ClassId, token.Id, receiver)
+	k.setTotalSupply(ctx, token.ClassId, k.GetTotalSupply(ctx, token.ClassId)+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyClassID, token.ClassId),
+			sdk.NewAttribute(types.AttributeKeyID, token.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, receiver.String()),
+		),
+	)
+	return nil
+}
+
+// Burn defines a method for burning a nft from a specific account.
+func (k Keeper) Burn(ctx sdk.Context, classID, nftID string) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, classID)
+	}
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "class: %s, id: %s", classID, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StoreKeyForNFT(classID, nftID))
+	store.Delete(types.OwnerStoreKey(classID, nftID))
+	store.Delete(types.NFTOfClassByOwnerStoreKey(owner, classID, nftID))
+	k.setTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)-1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyID, nftID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	)
+	return nil
+}
+
+// Update defines a method for updating an exist nft
+func (k Keeper) Update(ctx sdk.Context, token types.NFT) error {
+	if !k.HasClass(ctx, token.ClassId) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, token.ClassId)
+	}
+	if !k.HasNFT(ctx, token.ClassId, token.Id) {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "class: %s, id: %s", token.ClassId, token.Id)
+	}
+	return k.setNFT(ctx, token)
+}
+
+// Transfer defines a method for sending a nft from one account to another account.
+func (k Keeper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, classID)
+	}
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "class: %s, id: %s", classID, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	ctx.KVStore(k.storeKey).Delete(types.NFTOfClassByOwnerStoreKey(owner, classID, nftID))
+	k.setOwner(ctx, classID, nftID, receiver)
+	return nil
+}
+
+// GetNFT returns the nft information of the specified classID and nftID
+func (k Keeper) GetNFT(ctx sdk.Context, classID, nftID string) (types.NFT, bool) {
+	var nft types.NFT
+	bz := ctx.KVStore(k.storeKey).Get(types.StoreKeyForNFT(classID, nftID))
+	if len(bz) == 0 {
+		return nft, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &nft)
+	return nft, true
+}
+
+// GetNFTsOfClassByOwner returns all nft information of the specified classID under the specified owner
+func (k Keeper) GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []types.NFT) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NFTsOfClassByOwnerPrefix(owner, classID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, _, nftID := types.ParseNFTOfClassByOwnerKey(iterator.Key()[len(types.NFTOfClassByOwnerKey):])
+		if nft, has := k.GetNFT(ctx, classID, nftID); has {
+			nfts = append(nfts, nft)
+		}
+	}
+	return nfts
+}
+
+// GetNFTsOfClass returns all nft information under the specified classID
+func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []types.NFT) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NFTsOfClassPrefix(classID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+	return nfts
+}
+
+// GetOwner returns the owner information of the specified nft
+func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.OwnerStoreKey(classID, nftID))
+}
+
+// GetBalance returns the balance information of the specified classID under the specified owner
+func (k Keeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NFTsOfClassByOwnerPrefix(owner, classID))
+	defer iterator.Close()
+
+	var balance uint64
+	for ; iterator.Valid(); iterator.Next() {
+		balance++
+	}
+	return balance
+}
+
+// GetTotalSupply returns the number of all nfts under the specified classID
+func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClassTotalSupplyKey(classID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// HasNFT determines whether the specified classID and nftID exist
+func (k Keeper) HasNFT(ctx sdk.Context, classID, nftID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.StoreKeyForNFT(classID, nftID))
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, token types.NFT) error {
+	bz, err := k.cdc.MarshalBinaryBare(&token)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.StoreKeyForNFT(token.ClassId, token.Id), bz)
+	return nil
+}
+
+func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OwnerStoreKey(classID, nftID), owner)
+	store.Set(types.NFTOfClassByOwnerStoreKey(owner, classID, nftID), types.Placeholder)
+}
+
+func (k Keeper) setTotalSupply(ctx sdk.Context, classID string, supply uint64) {
+	ctx.KVStore(k.storeKey).Set(types.ClassTotalSupplyKey(classID), sdk.Uint64ToBigEndian(supply))
+}
@@ -0,0 +1,68 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var (
+	ownerPk   = ed25519.GenPrivKey().PubKey()
+	ownerAddr = sdk.AccAddress(ownerPk.Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	class := types.Class{Id: "ClassID", Name: "ClassName", Symbol: "ClassSymbol"}
+	classBz, err := cdc.MarshalBinaryBare(&class)
+	require.NoError(t, err)
+
+	nft := types.NFT{ClassId: "ClassID", Id: "NFTID", Uri: "URI"}
+	nftBz, err := cdc.MarshalBinaryBare(&nft)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.StoreKeyForClass(class.Id), Value: classBz},
+			{Key: types.StoreKeyForNFT(nft.ClassId, nft.Id), Value: nftBz},
+			{Key: types.NFTOfClassByOwnerStoreKey(ownerAddr, nft.ClassId, nft.Id), Value: types.Placeholder},
+			{Key: types.OwnerStoreKey(nft.ClassId, nft.Id), Value: ownerAddr},
+			{Key: types.ClassTotalSupplyKey(nft.ClassId), Value: sdk.Uint64ToBigEndian(1)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Class", fmt.Sprintf("%v\n%v", class, class)},
+		{"NFT", fmt.Sprintf("%v\n%v", nft, nft)},
+		{"OwnerIndex", fmt.Sprintf("%v\n%v", types.Placeholder, types.Placeholder)},
+		{"Owner", fmt.Sprintf("%v\n%v", ownerAddr, ownerAddr)},
+		{"TotalSupply", "1\n1"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Simulation parameter constants
+const (
+	classes = "classes"
+	entries = "entries"
+)
+
+// genClasses returns a slice of randomly generated nft classes.
+func genClasses(r *rand.Rand) []*types.Class {
+	classes := make([]*types.Class, r.Intn(3)+1)
+	for i := range classes {
+		classes[i] = &types.Class{
+			Id:          fmt.Sprintf("class%d%s", i, simtypes.RandStringOfLength(r, 5)),
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      simtypes.RandStringOfLength(r, 3),
+			Description: simtypes.RandStringOfLength(r, 20),
+			Uri:         simtypes.RandStringOfLength(r, 15),
+		}
+	}
+	return classes
+}
+
+// genEntries returns a slice of randomly generated nfts, with every account
+// owning one nft of each class.
+func genEntries(r *rand.Rand, accounts []simtypes.Account, classes []*types.Class) []*types.Entry {
+	entries := make([]*types.Entry, len(accounts))
+	for i, account := range accounts {
+		nfts := make([]*types.NFT, len(classes))
+		for j, class := range classes {
+			nfts[j] = &types.NFT{
+				ClassId: class.Id,
+				Id:      fmt.Sprintf("nft%d", i),
+				Uri:     simtypes.RandStringOfLength(r, 10),
+			}
+		}
+		entries[i] = &types.Entry{
+			Owner: account.Address.String(),
+			Nfts:  nfts,
+		}
+	}
+	return entries
+}
+
+// RandomizedGenState generates a random GenesisState for nft
+func RandomizedGenState(simState *module.SimulationState) {
+	var nftClasses []*types.Class
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, classes, &nftClasses, simState.Rand,
+		func(r *rand.Rand) { nftClasses = genClasses(r) },
+	)
+
+	var nftEntries []*types.Entry
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, entries, &nftEntries, simState.Rand,
+		func(r *rand.Rand) { nftEntries = genEntries(r, simState.Accounts, nftClasses) },
+	)
+
+	nftGenesis := types.NewGenesisState(nftClasses, nftEntries)
+	bz, err := simState.Cdc.MarshalJSON(nftGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
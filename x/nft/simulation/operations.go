@@ -0,0 +1,106 @@
+package simulation
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSend = "op_weight_msg_send_nft"
+	TypeMsgSend     = "/cosmos.nft.v1beta1.Msg/Send"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+	protoCdc *codec.ProtoCodec,
+) simulation.WeightedOperations {
+	var weightMsgSend int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = simappparams.DefaultWeightSendNFT
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, k, protoCdc),
+		),
+	}
+}
+
+// SimulateMsgSend generates a MsgSend with random values.
+func SimulateMsgSend(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, protoCdc *codec.ProtoCodec) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		if sender.Address.Equals(receiver.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, "sender and receiver are the same"), nil, nil
+		}
+
+		var nfts []types.NFT
+		k.IterateClasses(ctx, func(class types.Class) bool {
+			nfts = append(nfts, k.GetNFTsOfClassByOwner(ctx, class.Id, sender.Address)...)
+			return false
+		})
+		if len(nfts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, "sender has no nfts"), nil, nil
+		}
+		nft := nfts[r.Intn(len(nfts))]
+
+		account := ak.GetAccount(ctx, sender.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, err.Error()), nil, err
+		}
+
+		msg := types.NewMsgSend(nft.ClassId, nft.Id, sender.Address, receiver.Address)
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+		nftMsgClient := types.NewMsgClient(svcMsgClientConn)
+		_, err = nftMsgClient.Send(context.Background(), msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, err.Error()), nil, err
+		}
+
+		tx, err := helpers.GenTx(
+			txGen,
+			svcMsgClientConn.GetMsgs(),
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			sender.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSend, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, svcMsgClientConn.GetMsgs()[0].Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(svcMsgClientConn.GetMsgs()[0], true, "", protoCdc), nil, err
+	}
+}
@@ -0,0 +1,121 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *simapp.SimApp
+	protoCdc *codec.ProtoCodec
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	suite.protoCdc = codec.NewProtoCodec(suite.app.InterfaceRegistry())
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		err := simapp.FundAccount(suite.app, suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	weightedOps := simulation.WeightedOperations(
+		make(simtypes.AppParams), app.AppCodec(), app.AccountKeeper,
+		app.BankKeeper, app.NFTKeeper, suite.protoCdc,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simappparams.DefaultWeightSendNFT, types.ModuleName, simulation.TypeMsgSend},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		require.Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSend() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	// mint an nft for each account, so that any of them can be the sender
+	require.NoError(app.NFTKeeper.SaveClass(ctx, types.Class{Id: "kitty"}))
+	require.NoError(app.NFTKeeper.Mint(ctx, types.NFT{ClassId: "kitty", Id: "kitty0"}, accounts[0].Address))
+	require.NoError(app.NFTKeeper.Mint(ctx, types.NFT{ClassId: "kitty", Id: "kitty1"}, accounts[1].Address))
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation, retrying until the random sender and receiver differ
+	op := simulation.SimulateMsgSend(app.AccountKeeper, app.BankKeeper, app.NFTKeeper, suite.protoCdc)
+	var (
+		operationMsg     simtypes.OperationMsg
+		futureOperations []simtypes.FutureOperation
+		err              error
+	)
+	for !operationMsg.OK {
+		operationMsg, futureOperations, err = op(r, app.BaseApp, ctx, accounts, "")
+		require.NoError(err)
+	}
+
+	var msg types.MsgSend
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.Equal("kitty", msg.ClassId)
+	require.NotEqual(msg.Sender, msg.Receiver)
+	require.Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,16 @@
+package types
+
+// nft module events
+const (
+	EventTypeSend = "send_nft"
+	EventTypeMint = "mint_nft"
+	EventTypeBurn = "burn_nft"
+
+	AttributeKeyClassID  = "class_id"
+	AttributeKeyID       = "id"
+	AttributeKeySender   = "sender"
+	AttributeKeyReceiver = "receiver"
+	AttributeKeyOwner    = "owner"
+
+	AttributeValueCategory = ModuleName
+)
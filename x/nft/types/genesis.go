@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(classes []*Class, entries []*Entry) *GenesisState {
+	return &GenesisState{
+		Classes: classes,
+		Entries: entries,
+	}
+}
+
+// DefaultGenesisState returns a default nft module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis checks that the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	classes := make(map[string]bool, len(data.Classes))
+	for _, class := range data.Classes {
+		if err := class.ValidateBasic(); err != nil {
+			return err
+		}
+		if classes[class.Id] {
+			return sdkerrors.Wrap(ErrClassExists, class.Id)
+		}
+		classes[class.Id] = true
+	}
+
+	nfts := make(map[[2]string]bool)
+	for _, entry := range data.Entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return err
+		}
+		for _, nft := range entry.Nfts {
+			if err := nft.ValidateBasic(); err != nil {
+				return err
+			}
+			if !classes[nft.ClassId] {
+				return sdkerrors.Wrap(ErrClassNotExists, nft.ClassId)
+			}
+			key := [2]string{nft.ClassId, nft.Id}
+			if nfts[key] {
+				return sdkerrors.Wrapf(ErrNFTExists, "class: %s, id: %s", nft.ClassId, nft.Id)
+			}
+			nfts[key] = true
+		}
+	}
+	return nil
+}
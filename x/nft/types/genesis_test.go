@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	kitty := &types.Class{Id: "kitty"}
+
+	cases := map[string]struct {
+		genState *types.GenesisState
+		valid    bool
+	}{
+		"default": {types.DefaultGenesisState(), true},
+		"valid": {types.NewGenesisState([]*types.Class{kitty}, []*types.Entry{
+			{Owner: owner, Nfts: []*types.NFT{{ClassId: "kitty", Id: "kitty1"}, {ClassId: "kitty", Id: "kitty2"}}},
+		}), true},
+		"invalid class id": {types.NewGenesisState([]*types.Class{{Id: "1kitty"}}, nil), false},
+		"duplicate class":  {types.NewGenesisState([]*types.Class{kitty, kitty}, nil), false},
+		"invalid owner": {types.NewGenesisState([]*types.Class{kitty}, []*types.Entry{
+			{Owner: "owner", Nfts: []*types.NFT{{ClassId: "kitty", Id: "kitty1"}}},
+		}), false},
+		"unknown class": {types.NewGenesisState([]*types.Class{kitty}, []*types.Entry{
+			{Owner: owner, Nfts: []*types.NFT{{ClassId: "puppy", Id: "puppy1"}}},
+		}), false},
+		"duplicate nft": {types.NewGenesisState([]*types.Class{kitty}, []*types.Entry{
+			{Owner: owner, Nfts: []*types.NFT{{ClassId: "kitty", Id: "kitty1"}}},
+			{Owner: owner, Nfts: []*types.NFT{{ClassId: "kitty", Id: "kitty1"}}},
+		}), false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateGenesis(*tc.genState)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSendValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.NoError(t, types.NewMsgSend("kitty", "kitty1", sender, receiver).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgSend("", "kitty1", sender, receiver).ValidateBasic(), types.ErrEmptyClassID)
+	require.ErrorIs(t, types.NewMsgSend("kitty", "k", sender, receiver).ValidateBasic(), types.ErrInvalidID)
+	require.Error(t, types.NewMsgSend("kitty", "kitty1", sender, nil).ValidateBasic())
+}
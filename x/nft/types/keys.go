@@ -0,0 +1,107 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "nft"
+
+	// StoreKey is the store key string for nft
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for nft
+	QuerierRoute = ModuleName
+)
+
+// Keys for nft store
+// Items are stored with the following key: values
+//
+// - 0x01<classID>: Class
+//
+// - 0x02<classID_len><classID><nftID>: NFT
+//
+// - 0x03<owner_len><owner><classID_len><classID><nftID>: []byte{}
+//
+// - 0x04<classID_len><classID><nftID>: owner
+//
+// - 0x05<classID>: BigEndian(totalSupply)
+var (
+	ClassKey             = []byte{0x01}
+	NFTKey               = []byte{0x02}
+	NFTOfClassByOwnerKey = []byte{0x03}
+	OwnerKey             = []byte{0x04}
+	ClassTotalSupply     = []byte{0x05}
+
+	// Placeholder is the value of the owner index entries.
+	Placeholder = []byte{0x01}
+)
+
+// StoreKeyForClass returns the key of the class with the given id.
+func StoreKeyForClass(classID string) []byte {
+	return append(append([]byte{}, ClassKey...), classID...)
+}
+
+// NFTsOfClassPrefix returns the prefix to iterate over the NFTs of a class.
+func NFTsOfClassPrefix(classID string) []byte {
+	return append(append([]byte{}, NFTKey...), lengthPrefix(classID)...)
+}
+
+// StoreKeyForNFT returns the key of the NFT with the given class and id.
+func StoreKeyForNFT(classID, nftID string) []byte {
+	return append(NFTsOfClassPrefix(classID), nftID...)
+}
+
+// NFTsOfOwnerPrefix returns the prefix to iterate over the NFTs of an owner.
+func NFTsOfOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, NFTOfClassByOwnerKey...), address.MustLengthPrefix(owner)...)
+}
+
+// NFTsOfClassByOwnerPrefix returns the prefix to iterate over the NFTs of a class owned by an owner.
+func NFTsOfClassByOwnerPrefix(owner sdk.AccAddress, classID string) []byte {
+	return append(NFTsOfOwnerPrefix(owner), lengthPrefix(classID)...)
+}
+
+// NFTOfClassByOwnerStoreKey returns the owner index key of an NFT.
+func NFTOfClassByOwnerStoreKey(owner sdk.AccAddress, classID, nftID string) []byte {
+	return append(NFTsOfClassByOwnerPrefix(owner, classID), nftID...)
+}
+
+// OwnerStoreKey returns the key of the owner of the NFT with the given class and id.
+func OwnerStoreKey(classID, nftID string) []byte {
+	return append(append(append([]byte{}, OwnerKey...), lengthPrefix(classID)...), nftID...)
+}
+
+// ClassTotalSupplyKey returns the key of the total supply of a class.
+func ClassTotalSupplyKey(classID string) []byte {
+	return append(append([]byte{}, ClassTotalSupply...), classID...)
+}
+
+// ParseNFTOfClassByOwnerKey parses an owner index key, stripped of its
+// NFTOfClassByOwnerKey prefix, into the owner, the class id and the nft id.
+func ParseNFTOfClassByOwnerKey(key []byte) (owner sdk.AccAddress, classID, nftID string) {
+	owner, key = parseLengthPrefixed(key)
+	classBz, nftBz := parseLengthPrefixed(key)
+	return owner, string(classBz), string(nftBz)
+}
+
+// ParseClassAndNFTID parses a key made of a length-prefixed class id followed
+// by an nft id.
+func ParseClassAndNFTID(key []byte) (classID, nftID string) {
+	classBz, nftBz := parseLengthPrefixed(key)
+	return string(classBz), string(nftBz)
+}
+
+func lengthPrefix(id string) []byte {
+	return address.MustLengthPrefix([]byte(id))
+}
+
+func parseLengthPrefixed(key []byte) ([]byte, []byte) {
+	l := int(key[0])
+	return append([]byte{}, key[1:l+1]...), key[l+1:]
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.MsgRequest = &MsgSend{}
+
+// NewMsgSend creates a new MsgSend.
+//nolint:interfacer
+func NewMsgSend(classID, nftID string, sender, receiver sdk.AccAddress) *MsgSend {
+	return &MsgSend{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(m.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", m.Sender)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSend) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
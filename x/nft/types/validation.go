@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// reIDString is the regex of class and nft ids: they start with a letter,
+	// followed by 2 to 100 letters, digits, '/', ':' or '-'.
+	reIDString = `[a-zA-Z][a-zA-Z0-9/:-]{2,100}`
+	reID       = regexp.MustCompile(fmt.Sprintf(`^%s$`, reIDString))
+)
+
+// ValidateClassID returns whether the class id is valid
+func ValidateClassID(id string) error {
+	if len(id) == 0 {
+		return ErrEmptyClassID
+	}
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid class id: %s", id)
+	}
+	return nil
+}
+
+// ValidateNFTID returns whether the nft id is valid
+func ValidateNFTID(id string) error {
+	if len(id) == 0 {
+		return ErrEmptyNFTID
+	}
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid nft id: %s", id)
+	}
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the class.
+func (c Class) ValidateBasic() error {
+	return ValidateClassID(c.Id)
+}
+
+// ValidateBasic performs a stateless validation of the nft.
+func (n NFT) ValidateBasic() error {
+	if err := ValidateClassID(n.ClassId); err != nil {
+		return err
+	}
+	return ValidateNFTID(n.Id)
+}
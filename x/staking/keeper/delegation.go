@@ -800,6 +800,70 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation cancels an amount of the unbonding delegation
+// entry created at the given height and delegates it back to the validator.
+// The amount cannot exceed the current balance of the entry, which accounts
+// for any slashing that happened during the unbonding. The entry is removed
+// once its whole balance is canceled.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) (newShares sdk.Dec, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return sdk.ZeroDec(), sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unbonding delegation entry is already mature")
+	}
+	if amount.GT(entry.Balance) {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(
+			types.ErrBadDelegationAmount, "amount %s is greater than the unbonding delegation entry balance %s", amount, entry.Balance,
+		)
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	newShares, err = k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if entry.Balance.Equal(amount) {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries,
+	// its pair left in the unbonding queue is then skipped on completion
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -936,3 +1000,110 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferDelegation moves the shares worth up to wantAmt tokens of the
+// delegation of fromAddr to valAddr to a delegation of toAddr, without
+// unbonding them, and returns the amount of tokens moved. If the delegator is
+// the validator operator and its self-delegation falls below the validator's
+// minimum, the validator is jailed, as in Unbond.
+//
+// NOTE: the shares are moved even if they were received through a
+// redelegation, in which case a slash of the redelegation source validator
+// only applies to the shares left with fromAddr.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) (sdk.Int, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoDelegation
+	}
+
+	// a delegation to a validator without tokens is worth nothing
+	if !wantAmt.IsPositive() || !validator.Tokens.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	amount := wantAmt
+	shares, err := validator.SharesFromTokens(wantAmt)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if shares.GTE(delegation.Shares) {
+		shares = delegation.Shares
+		amount = validator.TokensFromShares(shares).TruncateInt()
+	}
+
+	if err := k.transferDelegationShares(ctx, fromAddr, toAddr, valAddr, shares); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares.Sub(shares)).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	return amount, nil
+}
+
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation
+// entries of fromAddr from valAddr to toAddr, keeping their creation height
+// and completion time, and returns the amount of tokens moved. Entries are
+// only moved while toAddr has not reached the max number of entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubd, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	var remaining []types.UnbondingDelegationEntry
+	for _, entry := range ubd.Entries {
+		amount := sdk.MinInt(wantAmt.Sub(transferred), entry.Balance)
+		if !amount.IsPositive() || k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			remaining = append(remaining, entry)
+			continue
+		}
+
+		// the initial balance, from which slashes are computed, is split in
+		// proportion to the moved balance
+		initialBalance := entry.InitialBalance.Mul(amount).Quo(entry.Balance)
+
+		toUbd, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+		if !found {
+			toUbd = types.UnbondingDelegation{
+				DelegatorAddress: toAddr.String(),
+				ValidatorAddress: valAddr.String(),
+			}
+		}
+		toEntry := types.NewUnbondingDelegationEntry(entry.CreationHeight, entry.CompletionTime, amount)
+		toEntry.InitialBalance = initialBalance
+		toUbd.Entries = append(toUbd.Entries, toEntry)
+		k.SetUnbondingDelegation(ctx, toUbd)
+		k.InsertUBDQueue(ctx, toUbd, entry.CompletionTime)
+
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(initialBalance)
+		if entry.Balance.IsPositive() {
+			remaining = append(remaining, entry)
+		}
+
+		transferred = transferred.Add(amount)
+	}
+
+	ubd.Entries = remaining
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred
+}
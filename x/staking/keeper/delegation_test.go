@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -594,6 +595,115 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	require.False(t, found)
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a bonded validator and a delegation to that validator
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(delAddrs[1], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(333, 0))
+	unbondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, delAddrs[1], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens, ubd.Entries[0].Balance)
+
+	bondedBalance := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+	notBondedBalance := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+
+	ctx = ctx.WithBlockHeight(11)
+	cancelTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+
+	// unknown creation height
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 9, cancelTokens)
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegationEntry)
+
+	// amount greater than the entry balance
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, unbondTokens.AddRaw(1))
+	require.ErrorIs(t, err, types.ErrBadDelegationAmount)
+
+	// no unbonding delegation
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 10, cancelTokens)
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegation)
+
+	// cancel part of the entry
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, cancelTokens)
+	require.NoError(t, err)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(unbondTokens).Add(cancelTokens), delegation.Shares.RoundInt())
+
+	// the canceled tokens are moved back to the bonded pool
+	require.Equal(t, bondedBalance.Add(cancelTokens), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+	require.Equal(t, notBondedBalance.Sub(cancelTokens), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+
+	// cancel the rest of the entry
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[1], valAddrs[0], 10, unbondTokens.Sub(cancelTokens))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, startTokens, delegation.Shares.RoundInt())
+
+	// the pair left in the unbonding queue is skipped
+	ctx = ctx.WithBlockTime(completionTime)
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Equal(t, bondedBalance.Add(unbondTokens), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+}
+
+func TestCancelUnbondingDelegationMatureEntry(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(startTokens)
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 10, time.Unix(100, 0), startTokens)
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	_, err := app.StakingKeeper.CancelUnbondingDelegation(ctx, delAddrs[0], valAddrs[0], 10, startTokens)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
 // Make sure that that the retrieving the delegations doesn't affect the state
 func TestGetRedelegationsFromSrcValidator(t *testing.T) {
 	_, app, ctx := createTestInput()
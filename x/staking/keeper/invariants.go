@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenize-share-records",
+		TokenizeShareRecordsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TokenizeShareRecordsInvariant(k)(ctx)
 	}
 }
 
@@ -186,3 +193,59 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// TokenizeShareRecordsInvariant checks that each tokenize share record holds a
+// delegation if and only if its tokenized shares have a positive supply, and
+// that the liquid shares stored for each validator add up to the delegator
+// shares held by the records of the validator.
+func TokenizeShareRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		recordShares := make(map[string]sdk.Dec)
+
+		k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+			supply := k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom())
+			delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), record.GetValidatorAddr())
+
+			if found != supply.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\ttokenize share record %d has a delegation: %t, tokenized shares supply: %v\n",
+					record.Id, found, supply)
+			}
+
+			if found {
+				shares, ok := recordShares[record.Validator]
+				if !ok {
+					shares = sdk.ZeroDec()
+				}
+				recordShares[record.Validator] = shares.Add(delegation.Shares)
+			}
+
+			return false
+		})
+
+		validators := k.GetAllValidators(ctx)
+		for _, validator := range validators {
+			liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+
+			shares, ok := recordShares[validator.OperatorAddress]
+			if !ok {
+				shares = sdk.ZeroDec()
+			}
+
+			if !liquidShares.Equal(shares) {
+				broken = true
+				msg += fmt.Sprintf("broken validator liquid shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator liquid shares: %v\n"+
+					"\tsum of tokenize share records shares: %v\n", validator.OperatorAddress, liquidShares, shares)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tokenize share records", msg), broken
+	}
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares moves the shares worth the given amount of tokens of a
+// delegation to the module account of a new tokenize share record owned by
+// the given owner, and mints the same amount of tokenized shares to the
+// delegator. The tokenized shares of a validator are capped by the validator
+// liquid staking cap param.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, types.TokenizeShareRecord, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrNoValidatorFound
+	}
+
+	// the self delegation is kept by the operator to not go below the min self
+	// delegation out of the validator's control
+	if delAddr.Equals(valAddr) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTokenizeSelfDelegation
+	}
+
+	// shares received through a redelegation may still be slashed for
+	// infractions of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	if liquidShares.GT(validator.DelegatorShares.Mul(k.ValidatorLiquidStakingCap(ctx))) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrLiquidStakingCapExceeded
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.NewTokenizeShareRecord(recordID, owner, valAddr)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares)
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	return shareToken, record, nil
+}
+
+// RedeemTokensForShares burns an amount of tokenized shares held by the
+// delegator and moves the proportional part of the shares held by the module
+// account of their tokenize share record to a delegation of the delegator.
+// The record is deleted once all its tokenized shares are redeemed, the
+// rewards left in its module account being sent to its owner. It returns
+// the amount of tokens worth the redeemed shares.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrTokenizeShareRecordNotExists, shareToken.Denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount
+	if shareToken.Amount.GT(supply) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrBadSharesAmount, "amount %s is greater than the supply %s", shareToken.Amount, supply)
+	}
+
+	// the last tokenized shares redeem all the remaining shares so that no
+	// rounding dust is left in the record's delegation
+	shares := delegation.Shares
+	if shareToken.Amount.LT(supply) {
+		shares = delegation.Shares.MulInt(shareToken.Amount).QuoInt(supply)
+	}
+	if !shares.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrBadSharesAmount, "amount %s is worth no shares", shareToken.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.transferDelegationShares(ctx, record.GetModuleAddress(), delAddr, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+
+	if shareToken.Amount.Equal(supply) {
+		// the rewards withdrawn to the module account of the record when its
+		// delegation was modified could not be withdrawn once it is deleted
+		moduleAddr := record.GetModuleAddress()
+		if rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr); !rewards.IsZero() {
+			owner, err := sdk.AccAddressFromBech32(record.Owner)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+			if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), validator.TokensFromShares(shares).TruncateInt()), nil
+}
+
+// transferDelegationShares moves delegation shares of a validator from a
+// delegator to another one, without changing the validator's tokens and
+// shares. The delegation hooks are called for both delegators.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	from, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+	if from.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, from.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	from.Shares = from.Shares.Sub(shares)
+	if from.Shares.IsZero() {
+		k.RemoveDelegation(ctx, from)
+	} else {
+		k.SetDelegation(ctx, from)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	to, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		to = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	to.Shares = to.Shares.Add(shares)
+	k.SetDelegation(ctx, to)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupTokenizeShares creates a bonded validator and a delegation of the
+// returned delegator holding all its shares.
+func setupTokenizeShares(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, []sdk.ValAddress, sdk.Int) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(delAddrs[1], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	return app, ctx, delAddrs, valAddrs, startTokens
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, delAddrs, valAddrs, startTokens := setupTokenizeShares(t)
+
+	tokenizeTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	// the validator operator cannot tokenize its self delegation
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[0], valAddrs[0], tokenizeTokens, delAddrs[0])
+	require.ErrorIs(t, err, types.ErrTokenizeSelfDelegation)
+
+	// no delegation
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[2], valAddrs[0], tokenizeTokens, delAddrs[2])
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	// unknown validator
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[2], tokenizeTokens, delAddrs[1])
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+
+	shareToken, record, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], tokenizeTokens, delAddrs[2])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record.Id)
+	require.Equal(t, delAddrs[2].String(), record.Owner)
+	require.Equal(t, record.GetShareTokenDenom(), shareToken.Denom)
+	require.Equal(t, tokenizeTokens, shareToken.Amount)
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, delAddrs[1], shareToken.Denom))
+
+	// the tokenized shares are held by the record's module account
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(tokenizeTokens), delegation.Shares.RoundInt())
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens, recordDelegation.Shares.RoundInt())
+	require.Equal(t, recordDelegation.Shares, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+
+	stored, found := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.True(t, found)
+	require.Equal(t, record, stored)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddrs[2]))
+
+	_, broken := keeper.TokenizeShareRecordsInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// the share tokens are transferable, the receiver redeems part of them
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddrs[1], delAddrs[2], sdk.NewCoins(shareToken)))
+
+	redeemTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	returned, err := app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[2], sdk.NewCoin(shareToken.Denom, redeemTokens))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), redeemTokens), returned)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, redeemTokens, delegation.Shares.RoundInt())
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens), app.BankKeeper.GetSupply(ctx, shareToken.Denom).Amount)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(t, found)
+
+	_, broken = keeper.TokenizeShareRecordsInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// redeeming more than the supply fails
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[2], sdk.NewCoin(shareToken.Denom, tokenizeTokens))
+	require.ErrorIs(t, err, types.ErrBadSharesAmount)
+
+	// redeeming the rest deletes the record
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[2], sdk.NewCoin(shareToken.Denom, tokenizeTokens.Sub(redeemTokens)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens, delegation.Shares.RoundInt())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).Amount.IsZero())
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddrs[2]))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+
+	_, broken = keeper.TokenizeShareRecordsInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// the record does not exist anymore
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddrs[2], shareToken)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+}
+
+func TestTokenizeSharesLiquidStakingCap(t *testing.T) {
+	app, ctx, delAddrs, valAddrs, _ := setupTokenizeShares(t)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// 3 of the 10 consensus power of the validator exceeds the 25% cap
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 3), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrLiquidStakingCapExceeded)
+
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 2), delAddrs[1])
+	require.NoError(t, err)
+
+	// the liquid shares already tokenized count towards the cap
+	_, _, err = app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 1), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrLiquidStakingCapExceeded)
+}
+
+func TestTokenizeSharesRedelegationInProgress(t *testing.T) {
+	app, ctx, delAddrs, valAddrs, _ := setupTokenizeShares(t)
+
+	rd := types.NewRedelegation(delAddrs[1], valAddrs[1], valAddrs[0], 0, ctx.BlockTime(), sdk.NewInt(5), sdk.NewDec(5))
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, delAddrs[1], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 1), delAddrs[1])
+	require.ErrorIs(t, err, types.ErrRedelegationInProgress)
+}
@@ -46,6 +46,13 @@ func (k Keeper) PowerReduction(ctx sdk.Context) (res sdk.Int) {
 	return
 }
 
+// ValidatorLiquidStakingCap - the maximum fraction of the delegator shares of
+// a validator which can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -55,6 +62,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.PowerReduction(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
 	)
 }
 
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v040staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v040"
 	v043staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestMigrateJSON(t *testing.T) {
@@ -30,6 +31,7 @@ func TestMigrateJSON(t *testing.T) {
 	migrated := v043staking.MigrateJSON(stakingGenState)
 
 	require.True(t, migrated.Params.PowerReduction.Equal(sdk.DefaultPowerReduction))
+	require.True(t, migrated.Params.ValidatorLiquidStakingCap.Equal(types.DefaultValidatorLiquidStakingCap))
 
 	bz, err := clientCtx.JSONMarshaler.MarshalJSON(migrated)
 	require.NoError(t, err)
@@ -46,6 +48,7 @@ func TestMigrateJSON(t *testing.T) {
 	expected := `{
 	"delegations": [],
 	"exported": false,
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
@@ -54,9 +57,11 @@ func TestMigrateJSON(t *testing.T) {
 		"max_entries": 7,
 		"max_validators": 100,
 		"power_reduction": "1000000",
-		"unbonding_time": "1814400s"
+		"unbonding_time": "1814400s",
+		"validator_liquid_staking_cap": "1.000000000000000000"
 	},
 	"redelegations": [],
+	"tokenize_share_records": [],
 	"unbonding_delegations": [],
 	"validators": []
 }`
@@ -144,4 +144,8 @@ func TestStoreMigration(t *testing.T) {
 	powerReduction := sdk.NewInt(0)
 	paramSubspace.Get(ctx, types.KeyPowerReduction, &powerReduction)
 	require.True(t, powerReduction.Equal(sdk.DefaultPowerReduction))
+
+	liquidStakingCap := sdk.ZeroDec()
+	paramSubspace.Get(ctx, types.KeyValidatorLiquidStakingCap, &liquidStakingCap)
+	require.True(t, liquidStakingCap.Equal(types.DefaultValidatorLiquidStakingCap))
 }
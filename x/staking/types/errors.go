@@ -57,4 +57,10 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 48, "no unbonding delegation entry found at creation height")
+	ErrTokenizeShareRecordNotExists    = sdkerrors.Register(ModuleName, 49, "tokenize share record not exists")
+	ErrOnlyBondDenomForTokenize        = sdkerrors.Register(ModuleName, 50, "only bond denom is allowed for tokenize")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 51, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrTokenizeSelfDelegation          = sdkerrors.Register(ModuleName, 52, "validator operator is not allowed to tokenize its self delegation")
+	ErrLiquidStakingCapExceeded        = sdkerrors.Register(ModuleName, 53, "tokenizing shares would exceed the validator liquid staking cap")
 )
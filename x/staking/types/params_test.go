@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -21,3 +22,28 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateValidatorLiquidStakingCap(t *testing.T) {
+	tests := []struct {
+		name       string
+		cap        sdk.Dec
+		expectPass bool
+	}{
+		{"default", types.DefaultValidatorLiquidStakingCap, true},
+		{"zero", sdk.ZeroDec(), true},
+		{"fraction", sdk.NewDecWithPrec(25, 2), true},
+		{"nil", sdk.Dec{}, false},
+		{"negative", sdk.NewDec(-1), false},
+		{"greater than one", sdk.NewDecWithPrec(101, 2), false},
+	}
+
+	for _, tc := range tests {
+		params := types.DefaultParams()
+		params.ValidatorLiquidStakingCap = tc.cap
+		if tc.expectPass {
+			require.NoError(t, params.Validate(), "test: %v", tc.name)
+		} else {
+			require.Error(t, params.Validate(), "test: %v", tc.name)
+		}
+	}
+}
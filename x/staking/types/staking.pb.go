@@ -846,6 +846,9 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
 	// power_reduction is the amount of staking tokens required for 1 unit of consensus-engine power
 	PowerReduction github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=power_reduction,json=powerReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power_reduction" yaml:"power_reduction"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator which can be tokenized.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1111,6 +1114,81 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation tokenized into a bank denom. The
+// delegation is held by the record's module account and the tokens can be
+// redeemed back into a delegation by any holder.
+type TokenizeShareRecord struct {
+	// id is the unique id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account address of the owner of the record.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	// validator is the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1133,6 +1211,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1140,121 +1219,129 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6c, 0x23, 0x57,
-	0x19, 0xf7, 0xc4, 0xae, 0x63, 0x7f, 0x4e, 0xe2, 0xe4, 0x6d, 0x76, 0xeb, 0x98, 0xc5, 0xe3, 0x0e,
-	0x55, 0x09, 0xa8, 0x75, 0xd8, 0x14, 0x15, 0x91, 0x0b, 0xac, 0xe3, 0x2c, 0xb1, 0x5a, 0x96, 0x30,
-	0xc9, 0x06, 0x09, 0x2a, 0xac, 0xe7, 0x99, 0x17, 0x67, 0x88, 0x67, 0xc6, 0xcc, 0x7b, 0xde, 0xc6,
-	0x52, 0x0f, 0x1c, 0xcb, 0x22, 0x44, 0xb9, 0xf5, 0xb2, 0xd2, 0x4a, 0xbd, 0x56, 0xe2, 0x82, 0xb8,
-	0x72, 0x2d, 0x70, 0x59, 0x6e, 0x08, 0x21, 0x83, 0x76, 0x2f, 0xc0, 0x09, 0xf9, 0xc4, 0x0d, 0xf4,
-	0xfe, 0xcc, 0x9f, 0x8c, 0xe3, 0xdd, 0xf5, 0xd2, 0x43, 0x25, 0x7a, 0x49, 0xfc, 0xbe, 0xf7, 0x7d,
-	0xbf, 0xef, 0x7d, 0x7f, 0xdf, 0xf7, 0x06, 0x5e, 0xb6, 0x7c, 0xea, 0xfa, 0x74, 0x8b, 0x32, 0x7c,
-	0xe6, 0x78, 0xbd, 0xad, 0xbb, 0x37, 0xba, 0x84, 0xe1, 0x1b, 0xe1, 0xba, 0x31, 0x08, 0x7c, 0xe6,
-	0xa3, 0x6b, 0x92, 0xab, 0x11, 0x52, 0x15, 0x57, 0x75, 0xbd, 0xe7, 0xf7, 0x7c, 0xc1, 0xb2, 0xc5,
-	0x7f, 0x49, 0xee, 0xea, 0x46, 0xcf, 0xf7, 0x7b, 0x7d, 0xb2, 0x25, 0x56, 0xdd, 0xe1, 0xc9, 0x16,
-	0xf6, 0x46, 0x6a, 0xab, 0x96, 0xde, 0xb2, 0x87, 0x01, 0x66, 0x8e, 0xef, 0xa9, 0x7d, 0x3d, 0xbd,
-	0xcf, 0x1c, 0x97, 0x50, 0x86, 0xdd, 0x41, 0x88, 0x2d, 0x4f, 0xd2, 0x91, 0x4a, 0xd5, 0xb1, 0x14,
-	0xb6, 0x32, 0xa5, 0x8b, 0x29, 0x89, 0xec, 0xb0, 0x7c, 0x27, 0xc4, 0xbe, 0xce, 0x88, 0x67, 0x93,
-	0xc0, 0x75, 0x3c, 0xb6, 0xc5, 0x46, 0x03, 0x42, 0xe5, 0x5f, 0xb9, 0x6b, 0xfc, 0x54, 0x83, 0x95,
-	0x7d, 0x87, 0x32, 0x3f, 0x70, 0x2c, 0xdc, 0x6f, 0x7b, 0x27, 0x3e, 0x7a, 0x03, 0xf2, 0xa7, 0x04,
-	0xdb, 0x24, 0xa8, 0x68, 0x75, 0x6d, 0xb3, 0xb4, 0x5d, 0x69, 0xc4, 0x08, 0x0d, 0x29, 0xbb, 0x2f,
-	0xf6, 0x9b, 0xb9, 0x8f, 0xc7, 0x7a, 0xc6, 0x54, 0xdc, 0xe8, 0x1b, 0x90, 0xbf, 0x8b, 0xfb, 0x94,
-	0xb0, 0xca, 0x42, 0x3d, 0xbb, 0x59, 0xda, 0x7e, 0xa9, 0x71, 0xb9, 0xfb, 0x1a, 0xc7, 0xb8, 0xef,
-	0xd8, 0x98, 0xf9, 0x11, 0x80, 0x14, 0x33, 0x7e, 0xb5, 0x00, 0xe5, 0x5d, 0xdf, 0x75, 0x1d, 0x4a,
-	0x1d, 0xdf, 0x33, 0x31, 0x23, 0x14, 0x35, 0x21, 0x17, 0x60, 0x46, 0xc4, 0x51, 0x8a, 0xcd, 0x06,
-	0xe7, 0xff, 0xf3, 0x58, 0x7f, 0xa5, 0xe7, 0xb0, 0xd3, 0x61, 0xb7, 0x61, 0xf9, 0xae, 0x72, 0x86,
-	0xfa, 0xf7, 0x1a, 0xb5, 0xcf, 0x94, 0x7d, 0x2d, 0x62, 0x99, 0x42, 0x16, 0xbd, 0x0d, 0x05, 0x17,
-	0x9f, 0x77, 0x04, 0xce, 0x82, 0xc0, 0xb9, 0x39, 0x1f, 0xce, 0x64, 0xac, 0x97, 0x47, 0xd8, 0xed,
-	0xef, 0x18, 0x21, 0x8e, 0x61, 0x2e, 0xba, 0xf8, 0x9c, 0x1f, 0x11, 0x0d, 0xa0, 0xcc, 0xa9, 0xd6,
-	0x29, 0xf6, 0x7a, 0x44, 0x2a, 0xc9, 0x0a, 0x25, 0xfb, 0x73, 0x2b, 0xb9, 0x16, 0x2b, 0x49, 0xc0,
-	0x19, 0xe6, 0xb2, 0x8b, 0xcf, 0x77, 0x05, 0x81, 0x6b, 0xdc, 0x29, 0x7c, 0xf0, 0x40, 0xcf, 0xfc,
-	0xfd, 0x81, 0xae, 0x19, 0x7f, 0xd4, 0x00, 0x62, 0x8f, 0xa1, 0xb7, 0x61, 0xd5, 0x8a, 0x56, 0x42,
-	0x96, 0xaa, 0x18, 0x7e, 0x71, 0x56, 0x2c, 0x52, 0xfe, 0x6e, 0x16, 0xf8, 0xa1, 0x1f, 0x8e, 0x75,
-	0xcd, 0x2c, 0x5b, 0xa9, 0x50, 0xfc, 0x00, 0x4a, 0xc3, 0x81, 0x8d, 0x19, 0xe9, 0xf0, 0xec, 0x14,
-	0x9e, 0x2c, 0x6d, 0x57, 0x1b, 0x32, 0x75, 0x1b, 0x61, 0xea, 0x36, 0x8e, 0xc2, 0xd4, 0x6d, 0xd6,
-	0x38, 0xd6, 0x64, 0xac, 0x23, 0x69, 0x56, 0x42, 0xd8, 0x78, 0xff, 0xaf, 0xba, 0x66, 0x82, 0xa4,
-	0x70, 0x81, 0x84, 0x4d, 0xbf, 0xd3, 0xa0, 0xd4, 0x22, 0xd4, 0x0a, 0x9c, 0x01, 0xaf, 0x10, 0x54,
-	0x81, 0x45, 0xd7, 0xf7, 0x9c, 0x33, 0x95, 0x8f, 0x45, 0x33, 0x5c, 0xa2, 0x2a, 0x14, 0x1c, 0x9b,
-	0x78, 0xcc, 0x61, 0x23, 0x19, 0x57, 0x33, 0x5a, 0x73, 0xa9, 0x77, 0x48, 0x97, 0x3a, 0x61, 0x34,
-	0xcc, 0x70, 0x89, 0x6e, 0xc1, 0x2a, 0x25, 0xd6, 0x30, 0x70, 0xd8, 0xa8, 0x63, 0xf9, 0x1e, 0xc3,
-	0x16, 0xab, 0xe4, 0x44, 0xc0, 0x3e, 0x37, 0x19, 0xeb, 0x2f, 0xca, 0xb3, 0xa6, 0x39, 0x0c, 0xb3,
-	0x1c, 0x92, 0x76, 0x25, 0x85, 0x6b, 0xb0, 0x09, 0xc3, 0x4e, 0x9f, 0x56, 0x5e, 0x90, 0x1a, 0xd4,
-	0x32, 0x61, 0xcb, 0x47, 0x8b, 0x50, 0x8c, 0xb2, 0x9d, 0x6b, 0xf6, 0x07, 0x24, 0xe0, 0xbf, 0x3b,
-	0xd8, 0xb6, 0x03, 0x42, 0xa9, 0xca, 0xeb, 0x84, 0xe6, 0x34, 0x87, 0x61, 0x96, 0x43, 0xd2, 0x4d,
-	0x49, 0x41, 0x8c, 0x87, 0xd9, 0xa3, 0xc4, 0xa3, 0x43, 0xda, 0x19, 0x0c, 0xbb, 0x67, 0x64, 0xa4,
-	0xa2, 0xb1, 0x3e, 0x15, 0x8d, 0x9b, 0xde, 0xa8, 0xf9, 0x7a, 0x8c, 0x9e, 0x96, 0x33, 0x7e, 0xff,
-	0xeb, 0xd7, 0xd6, 0x55, 0x6a, 0x58, 0xc1, 0x68, 0xc0, 0xfc, 0xc6, 0xc1, 0xb0, 0xfb, 0x26, 0x19,
-	0xf1, 0xf0, 0x2b, 0xd6, 0x03, 0xc1, 0x89, 0xae, 0x41, 0xfe, 0x47, 0xd8, 0xe9, 0x13, 0x5b, 0x38,
-	0xb4, 0x60, 0xaa, 0x15, 0xda, 0x81, 0x3c, 0x65, 0x98, 0x0d, 0xa9, 0xf0, 0xe2, 0xca, 0xb6, 0x31,
-	0x2b, 0xd5, 0x9a, 0xbe, 0x67, 0x1f, 0x0a, 0x4e, 0x53, 0x49, 0xa0, 0x5b, 0x90, 0x67, 0xfe, 0x19,
-	0xf1, 0x94, 0x0b, 0xe7, 0xaa, 0xef, 0xb6, 0xc7, 0x4c, 0x25, 0xcd, 0x3d, 0x62, 0x93, 0x3e, 0xe9,
-	0x09, 0xc7, 0xd1, 0x53, 0x1c, 0x10, 0x5a, 0xc9, 0x0b, 0xc4, 0xf6, 0xdc, 0x45, 0xa8, 0x3c, 0x95,
-	0xc6, 0x33, 0xcc, 0x72, 0x44, 0x3a, 0x14, 0x14, 0xf4, 0x26, 0x94, 0xec, 0x38, 0x51, 0x2b, 0x8b,
-	0x22, 0x04, 0x5f, 0x98, 0x65, 0x7e, 0x22, 0xa7, 0x55, 0xdf, 0x4b, 0x4a, 0xf3, 0xe4, 0x18, 0x7a,
-	0x5d, 0xdf, 0xb3, 0x1d, 0xaf, 0xd7, 0x39, 0x25, 0x4e, 0xef, 0x94, 0x55, 0x0a, 0x75, 0x6d, 0x33,
-	0x9b, 0x4c, 0x8e, 0x34, 0x87, 0x61, 0x96, 0x23, 0xd2, 0xbe, 0xa0, 0x20, 0x1b, 0x56, 0x62, 0x2e,
-	0x51, 0xa8, 0xc5, 0xa7, 0x16, 0xea, 0x4b, 0xaa, 0x50, 0xaf, 0xa6, 0xb5, 0xc4, 0xb5, 0xba, 0x1c,
-	0x11, 0xb9, 0x18, 0xda, 0x07, 0x88, 0xdb, 0x43, 0x05, 0x84, 0x06, 0xe3, 0xe9, 0x3d, 0x46, 0x19,
-	0x9e, 0x90, 0x45, 0xef, 0xc2, 0x15, 0xd7, 0xf1, 0x3a, 0x94, 0xf4, 0x4f, 0x3a, 0xca, 0xc1, 0x1c,
-	0xb2, 0x24, 0xa2, 0xf7, 0xd6, 0x7c, 0xf9, 0x30, 0x19, 0xeb, 0x55, 0xd5, 0x42, 0xa7, 0x21, 0x0d,
-	0x73, 0xcd, 0x75, 0xbc, 0x43, 0xd2, 0x3f, 0x69, 0x45, 0xb4, 0x9d, 0xa5, 0xf7, 0x1e, 0xe8, 0x19,
-	0x55, 0xae, 0x19, 0xe3, 0x0d, 0x58, 0x3a, 0xc6, 0x7d, 0x55, 0x66, 0x84, 0xa2, 0xeb, 0x50, 0xc4,
-	0xe1, 0xa2, 0xa2, 0xd5, 0xb3, 0x9b, 0x45, 0x33, 0x26, 0xc8, 0x32, 0xff, 0xc9, 0x5f, 0xea, 0x9a,
-	0xf1, 0x91, 0x06, 0xf9, 0xd6, 0xf1, 0x01, 0x76, 0x02, 0xd4, 0x86, 0xb5, 0x38, 0x73, 0x2e, 0x16,
-	0xf9, 0xf5, 0xc9, 0x58, 0xaf, 0xa4, 0x93, 0x2b, 0xaa, 0xf2, 0x38, 0x81, 0xc3, 0x32, 0x6f, 0xc3,
-	0xda, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0x0b, 0x69, 0xa8, 0x29, 0x16, 0xc3, 0x5c, 0x8d, 0x68, 0x0a,
-	0x2a, 0x65, 0xe6, 0x1e, 0x2c, 0xca, 0xd3, 0x52, 0xb4, 0x03, 0x2f, 0x0c, 0xf8, 0x0f, 0x61, 0x5d,
-	0x69, 0xbb, 0x36, 0x33, 0x79, 0x05, 0xbf, 0x0a, 0x9f, 0x14, 0x31, 0x7e, 0xb9, 0x00, 0xd0, 0x3a,
-	0x3e, 0x3e, 0x0a, 0x9c, 0x41, 0x9f, 0xb0, 0x4f, 0xd2, 0xf2, 0x23, 0xb8, 0x1a, 0x9b, 0x45, 0x03,
-	0x2b, 0x65, 0x7d, 0x7d, 0x32, 0xd6, 0xaf, 0xa7, 0xad, 0x4f, 0xb0, 0x19, 0xe6, 0x95, 0x88, 0x7e,
-	0x18, 0x58, 0x97, 0xa2, 0xda, 0x94, 0x45, 0xa8, 0xd9, 0xd9, 0xa8, 0x09, 0xb6, 0x24, 0x6a, 0x8b,
-	0xb2, 0xcb, 0x5d, 0x7b, 0x08, 0xa5, 0xd8, 0x25, 0x14, 0xb5, 0xa0, 0xc0, 0xd4, 0x6f, 0xe5, 0x61,
-	0x63, 0xb6, 0x87, 0x43, 0x31, 0xe5, 0xe5, 0x48, 0xd2, 0xf8, 0xb7, 0x06, 0x10, 0xe7, 0xec, 0xa7,
-	0x33, 0xc5, 0x78, 0x2b, 0x57, 0x8d, 0x37, 0xfb, 0x5c, 0xa3, 0x9a, 0x92, 0x4e, 0xf9, 0xf3, 0x67,
-	0x0b, 0x70, 0xe5, 0x4e, 0xd8, 0x79, 0x3e, 0xf5, 0x3e, 0x38, 0x80, 0x45, 0xe2, 0xb1, 0xc0, 0x11,
-	0x4e, 0xe0, 0xd1, 0xfe, 0xca, 0xac, 0x68, 0x5f, 0x62, 0xd3, 0x9e, 0xc7, 0x82, 0x91, 0x8a, 0x7d,
-	0x08, 0x93, 0xf2, 0xc6, 0x2f, 0xb2, 0x50, 0x99, 0x25, 0x89, 0x76, 0xa1, 0x6c, 0x05, 0x44, 0x10,
-	0xc2, 0xfb, 0x43, 0x13, 0xf7, 0x47, 0x35, 0x9e, 0x2c, 0x53, 0x0c, 0x86, 0xb9, 0x12, 0x52, 0xd4,
-	0xed, 0xd1, 0x03, 0x3e, 0xf6, 0xf1, 0xb4, 0xe3, 0x5c, 0xcf, 0x38, 0xe7, 0x19, 0xea, 0xfa, 0x08,
-	0x95, 0x5c, 0x04, 0x90, 0xf7, 0xc7, 0x4a, 0x4c, 0x15, 0x17, 0xc8, 0x8f, 0xa1, 0xec, 0x78, 0x0e,
-	0x73, 0x70, 0xbf, 0xd3, 0xc5, 0x7d, 0xec, 0x59, 0xcf, 0x33, 0x35, 0xcb, 0x96, 0xaf, 0xd4, 0xa6,
-	0xe0, 0x0c, 0x73, 0x45, 0x51, 0x9a, 0x92, 0x80, 0xf6, 0x61, 0x31, 0x54, 0x95, 0x7b, 0xae, 0x69,
-	0x23, 0x14, 0x4f, 0x0c, 0x78, 0x3f, 0xcf, 0xc2, 0x9a, 0x49, 0xec, 0xcf, 0x42, 0x31, 0x5f, 0x28,
-	0xbe, 0x0d, 0x20, 0xcb, 0x9d, 0x37, 0xd8, 0xe7, 0x88, 0x06, 0x6f, 0x18, 0x45, 0x89, 0xd0, 0xa2,
-	0x2c, 0x11, 0x8f, 0xf1, 0x02, 0x2c, 0x25, 0xe3, 0xf1, 0x7f, 0x7a, 0x2b, 0xa1, 0x76, 0xdc, 0x89,
-	0x72, 0xa2, 0x13, 0x7d, 0x69, 0x56, 0x27, 0x9a, 0xca, 0xde, 0x27, 0xb7, 0xa0, 0x7f, 0x64, 0x21,
-	0x7f, 0x80, 0x03, 0xec, 0x52, 0x64, 0x4d, 0x4d, 0x9a, 0xf2, 0xad, 0xb9, 0x31, 0x95, 0x9f, 0x2d,
-	0xf5, 0xb5, 0xe3, 0x29, 0x83, 0xe6, 0x07, 0x97, 0x0c, 0x9a, 0xdf, 0x84, 0x15, 0xfe, 0x1c, 0x8e,
-	0x6c, 0x94, 0xde, 0x5e, 0x6e, 0x6e, 0xc4, 0x28, 0x17, 0xf7, 0xe5, 0x6b, 0x39, 0x7a, 0x74, 0x51,
-	0xf4, 0x35, 0x28, 0x71, 0x8e, 0xb8, 0x31, 0x73, 0xf1, 0x6b, 0xf1, 0xb3, 0x34, 0xb1, 0x69, 0x98,
-	0xe0, 0xe2, 0xf3, 0x3d, 0xb9, 0x40, 0x6f, 0x01, 0x3a, 0x8d, 0xbe, 0x8c, 0x74, 0x62, 0x77, 0x72,
-	0xf9, 0xcf, 0x4f, 0xc6, 0xfa, 0x86, 0x94, 0x9f, 0xe6, 0x31, 0xcc, 0xb5, 0x98, 0x18, 0xa2, 0x7d,
-	0x15, 0x80, 0xdb, 0xd5, 0xb1, 0x89, 0xe7, 0xbb, 0xea, 0xb9, 0x73, 0x75, 0x32, 0xd6, 0xd7, 0x24,
-	0x4a, 0xbc, 0x67, 0x98, 0x45, 0xbe, 0x68, 0xf1, 0xdf, 0xbc, 0x36, 0x07, 0xfe, 0x3b, 0x24, 0xe8,
-	0x04, 0xc4, 0x1e, 0x5a, 0x62, 0x32, 0xce, 0xff, 0x6f, 0xb5, 0x99, 0x82, 0x33, 0xcc, 0x15, 0x41,
-	0x31, 0x43, 0x42, 0xa2, 0x98, 0x3e, 0xd4, 0x00, 0xc5, 0xb7, 0x8c, 0x49, 0xe8, 0x80, 0x3f, 0x09,
-	0xf9, 0xec, 0x9f, 0x18, 0xd4, 0xb5, 0x27, 0xcf, 0xfe, 0xb1, 0x7c, 0x38, 0xfb, 0x27, 0x8a, 0xf3,
-	0xeb, 0x71, 0x47, 0x5e, 0x50, 0xa9, 0xa3, 0x60, 0xba, 0x98, 0x92, 0xc4, 0xfb, 0xc1, 0x09, 0xa5,
-	0xa7, 0x5a, 0x70, 0xc6, 0xf8, 0x83, 0x06, 0x1b, 0x53, 0x49, 0x1c, 0x1d, 0xf6, 0x87, 0x80, 0x82,
-	0xc4, 0xa6, 0x08, 0xd1, 0x48, 0x1d, 0x7a, 0xee, 0x9a, 0x58, 0x0b, 0xa6, 0x5a, 0xfd, 0x27, 0x77,
-	0xa9, 0xe4, 0x84, 0xcf, 0x7f, 0xab, 0xc1, 0x7a, 0x52, 0x7d, 0x64, 0xc8, 0x6d, 0x58, 0x4a, 0x6a,
-	0x57, 0x26, 0xbc, 0xfc, 0x2c, 0x26, 0xa8, 0xd3, 0x5f, 0x90, 0x47, 0xdf, 0x8d, 0x3b, 0x84, 0xfc,
-	0x5c, 0x77, 0xe3, 0x99, 0xbd, 0x11, 0x9e, 0x29, 0xdd, 0x29, 0x72, 0x22, 0x1e, 0xff, 0xd1, 0x20,
-	0x77, 0xe0, 0xfb, 0x7d, 0xe4, 0xc3, 0x9a, 0xe7, 0xb3, 0x0e, 0x4f, 0x66, 0x62, 0x77, 0xd4, 0x3b,
-	0x5f, 0xb6, 0xde, 0xdd, 0xf9, 0x9c, 0xf4, 0xcf, 0xb1, 0x3e, 0x0d, 0x65, 0x96, 0x3d, 0x9f, 0x35,
-	0x05, 0xe5, 0x48, 0x7e, 0x05, 0x78, 0x17, 0x96, 0x2f, 0x2a, 0x93, 0x8d, 0xf9, 0x7b, 0x73, 0x2b,
-	0xbb, 0x08, 0x33, 0x19, 0xeb, 0xeb, 0x71, 0x91, 0x46, 0x64, 0xc3, 0x5c, 0xea, 0x26, 0xb4, 0xef,
-	0x14, 0x78, 0xfc, 0xfe, 0xf5, 0x40, 0xd7, 0xbe, 0xfc, 0x1b, 0x0d, 0x20, 0xfe, 0xd8, 0x81, 0x5e,
-	0x85, 0x17, 0x9b, 0xdf, 0xb9, 0xdd, 0xea, 0x1c, 0x1e, 0xdd, 0x3c, 0xba, 0x73, 0xd8, 0xb9, 0x73,
-	0xfb, 0xf0, 0x60, 0x6f, 0xb7, 0x7d, 0xab, 0xbd, 0xd7, 0x5a, 0xcd, 0x54, 0xcb, 0xf7, 0xee, 0xd7,
-	0x4b, 0x77, 0x3c, 0x3a, 0x20, 0x96, 0x73, 0xe2, 0x10, 0x1b, 0xbd, 0x02, 0xeb, 0x17, 0xb9, 0xf9,
-	0x6a, 0xaf, 0xb5, 0xaa, 0x55, 0x97, 0xee, 0xdd, 0xaf, 0x17, 0xe4, 0xf8, 0x47, 0x6c, 0xb4, 0x09,
-	0x57, 0xa7, 0xf9, 0xda, 0xb7, 0xbf, 0xb5, 0xba, 0x50, 0x5d, 0xbe, 0x77, 0xbf, 0x5e, 0x8c, 0xe6,
-	0x44, 0x64, 0x00, 0x4a, 0x72, 0x2a, 0xbc, 0x6c, 0x15, 0xee, 0xdd, 0xaf, 0xe7, 0xa5, 0x03, 0xab,
-	0xb9, 0xf7, 0x3e, 0xac, 0x65, 0x9a, 0xb7, 0x3e, 0x7e, 0x54, 0xd3, 0x1e, 0x3e, 0xaa, 0x69, 0x7f,
-	0x7b, 0x54, 0xd3, 0xde, 0x7f, 0x5c, 0xcb, 0x3c, 0x7c, 0x5c, 0xcb, 0xfc, 0xe9, 0x71, 0x2d, 0xf3,
-	0xfd, 0x57, 0x9f, 0xe8, 0xbb, 0xf3, 0xe8, 0x3b, 0xba, 0xf0, 0x62, 0x37, 0x2f, 0x3a, 0xff, 0xeb,
-	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x90, 0x8f, 0x24, 0x66, 0x17, 0x00, 0x00,
+	// 1937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x23, 0x49,
+	0x15, 0x76, 0x3b, 0x1e, 0x27, 0x7e, 0xce, 0xc4, 0x49, 0x4d, 0x66, 0xd6, 0x31, 0xc1, 0xf6, 0xf6,
+	0xae, 0x96, 0x80, 0x76, 0x1d, 0x36, 0x8b, 0x16, 0x91, 0x0b, 0xc4, 0x71, 0x86, 0x44, 0x3b, 0x0c,
+	0xa1, 0xf3, 0x83, 0x04, 0x2b, 0x5a, 0xe5, 0xee, 0x8a, 0x53, 0xc4, 0xee, 0xf6, 0x76, 0x95, 0x67,
+	0x62, 0xb4, 0x07, 0xc4, 0x69, 0x19, 0x84, 0x58, 0x2e, 0x68, 0x2f, 0x23, 0x0d, 0xda, 0xeb, 0x4a,
+	0x5c, 0x10, 0x57, 0xae, 0x0b, 0x5c, 0x86, 0x1b, 0x42, 0xc8, 0xa0, 0x99, 0x0b, 0xe2, 0x84, 0x72,
+	0xe2, 0x06, 0xaa, 0x9f, 0xfe, 0x49, 0x3b, 0x99, 0x19, 0x0f, 0x7b, 0x58, 0x09, 0x2e, 0x33, 0xae,
+	0x57, 0xef, 0x7d, 0xaf, 0xde, 0x7f, 0x55, 0x07, 0x5e, 0x76, 0x7c, 0xd6, 0xf3, 0xd9, 0x2a, 0xe3,
+	0xf8, 0x84, 0x7a, 0x9d, 0xd5, 0x3b, 0xaf, 0xb7, 0x09, 0xc7, 0xaf, 0x87, 0xeb, 0x46, 0x3f, 0xf0,
+	0xb9, 0x8f, 0x6e, 0x28, 0xae, 0x46, 0x48, 0xd5, 0x5c, 0x95, 0xc5, 0x8e, 0xdf, 0xf1, 0x25, 0xcb,
+	0xaa, 0xf8, 0xa5, 0xb8, 0x2b, 0x4b, 0x1d, 0xdf, 0xef, 0x74, 0xc9, 0xaa, 0x5c, 0xb5, 0x07, 0x47,
+	0xab, 0xd8, 0x1b, 0xea, 0xad, 0x6a, 0x7a, 0xcb, 0x1d, 0x04, 0x98, 0x53, 0xdf, 0xd3, 0xfb, 0xb5,
+	0xf4, 0x3e, 0xa7, 0x3d, 0xc2, 0x38, 0xee, 0xf5, 0x43, 0x6c, 0x75, 0x12, 0x5b, 0x29, 0xd5, 0xc7,
+	0xd2, 0xd8, 0xda, 0x94, 0x36, 0x66, 0x24, 0xb2, 0xc3, 0xf1, 0x69, 0x88, 0xbd, 0xcc, 0x89, 0xe7,
+	0x92, 0xa0, 0x47, 0x3d, 0xbe, 0xca, 0x87, 0x7d, 0xc2, 0xd4, 0xbf, 0x6a, 0xd7, 0xfc, 0xb1, 0x01,
+	0x73, 0xdb, 0x94, 0x71, 0x3f, 0xa0, 0x0e, 0xee, 0xee, 0x78, 0x47, 0x3e, 0x7a, 0x13, 0xf2, 0xc7,
+	0x04, 0xbb, 0x24, 0x28, 0x1b, 0x75, 0x63, 0xa5, 0xb8, 0x56, 0x6e, 0xc4, 0x08, 0x0d, 0x25, 0xbb,
+	0x2d, 0xf7, 0x9b, 0xb9, 0x8f, 0x47, 0xb5, 0x8c, 0xa5, 0xb9, 0xd1, 0x57, 0x21, 0x7f, 0x07, 0x77,
+	0x19, 0xe1, 0xe5, 0x6c, 0x7d, 0x6a, 0xa5, 0xb8, 0xf6, 0x62, 0xe3, 0x62, 0xf7, 0x35, 0x0e, 0x71,
+	0x97, 0xba, 0x98, 0xfb, 0x11, 0x80, 0x12, 0x33, 0x7f, 0x95, 0x85, 0xd2, 0xa6, 0xdf, 0xeb, 0x51,
+	0xc6, 0xa8, 0xef, 0x59, 0x98, 0x13, 0x86, 0x9a, 0x90, 0x0b, 0x30, 0x27, 0xf2, 0x28, 0x85, 0x66,
+	0x43, 0xf0, 0xff, 0x79, 0x54, 0x7b, 0xa5, 0x43, 0xf9, 0xf1, 0xa0, 0xdd, 0x70, 0xfc, 0x9e, 0x76,
+	0x86, 0xfe, 0xef, 0x35, 0xe6, 0x9e, 0x68, 0xfb, 0x5a, 0xc4, 0xb1, 0xa4, 0x2c, 0x7a, 0x1b, 0x66,
+	0x7a, 0xf8, 0xd4, 0x96, 0x38, 0x59, 0x89, 0xb3, 0x31, 0x19, 0xce, 0xd9, 0xa8, 0x56, 0x1a, 0xe2,
+	0x5e, 0x77, 0xdd, 0x0c, 0x71, 0x4c, 0x6b, 0xba, 0x87, 0x4f, 0xc5, 0x11, 0x51, 0x1f, 0x4a, 0x82,
+	0xea, 0x1c, 0x63, 0xaf, 0x43, 0x94, 0x92, 0x29, 0xa9, 0x64, 0x7b, 0x62, 0x25, 0x37, 0x62, 0x25,
+	0x09, 0x38, 0xd3, 0xba, 0xda, 0xc3, 0xa7, 0x9b, 0x92, 0x20, 0x34, 0xae, 0xcf, 0x7c, 0xf0, 0xa0,
+	0x96, 0xf9, 0xfb, 0x83, 0x9a, 0x61, 0xfe, 0xd1, 0x00, 0x88, 0x3d, 0x86, 0xde, 0x86, 0x79, 0x27,
+	0x5a, 0x49, 0x59, 0xa6, 0x63, 0xf8, 0xb9, 0xcb, 0x62, 0x91, 0xf2, 0x77, 0x73, 0x46, 0x1c, 0xfa,
+	0xe1, 0xa8, 0x66, 0x58, 0x25, 0x27, 0x15, 0x8a, 0xef, 0x42, 0x71, 0xd0, 0x77, 0x31, 0x27, 0xb6,
+	0xc8, 0x4e, 0xe9, 0xc9, 0xe2, 0x5a, 0xa5, 0xa1, 0x52, 0xb7, 0x11, 0xa6, 0x6e, 0x63, 0x3f, 0x4c,
+	0xdd, 0x66, 0x55, 0x60, 0x9d, 0x8d, 0x6a, 0x48, 0x99, 0x95, 0x10, 0x36, 0xdf, 0xff, 0x6b, 0xcd,
+	0xb0, 0x40, 0x51, 0x84, 0x40, 0xc2, 0xa6, 0xdf, 0x19, 0x50, 0x6c, 0x11, 0xe6, 0x04, 0xb4, 0x2f,
+	0x2a, 0x04, 0x95, 0x61, 0xba, 0xe7, 0x7b, 0xf4, 0x44, 0xe7, 0x63, 0xc1, 0x0a, 0x97, 0xa8, 0x02,
+	0x33, 0xd4, 0x25, 0x1e, 0xa7, 0x7c, 0xa8, 0xe2, 0x6a, 0x45, 0x6b, 0x21, 0x75, 0x97, 0xb4, 0x19,
+	0x0d, 0xa3, 0x61, 0x85, 0x4b, 0x74, 0x13, 0xe6, 0x19, 0x71, 0x06, 0x01, 0xe5, 0x43, 0xdb, 0xf1,
+	0x3d, 0x8e, 0x1d, 0x5e, 0xce, 0xc9, 0x80, 0x7d, 0xe6, 0x6c, 0x54, 0x7b, 0x41, 0x9d, 0x35, 0xcd,
+	0x61, 0x5a, 0xa5, 0x90, 0xb4, 0xa9, 0x28, 0x42, 0x83, 0x4b, 0x38, 0xa6, 0x5d, 0x56, 0xbe, 0xa2,
+	0x34, 0xe8, 0x65, 0xc2, 0x96, 0x8f, 0xa6, 0xa1, 0x10, 0x65, 0xbb, 0xd0, 0xec, 0xf7, 0x49, 0x20,
+	0x7e, 0xdb, 0xd8, 0x75, 0x03, 0xc2, 0x58, 0xd9, 0x48, 0x6b, 0x4e, 0x73, 0x98, 0x56, 0x29, 0x24,
+	0x6d, 0x28, 0x0a, 0xe2, 0x22, 0xcc, 0x1e, 0x23, 0x1e, 0x1b, 0x30, 0xbb, 0x3f, 0x68, 0x9f, 0x90,
+	0xa1, 0x8e, 0xc6, 0xe2, 0x58, 0x34, 0x36, 0xbc, 0x61, 0xf3, 0x8d, 0x18, 0x3d, 0x2d, 0x67, 0xfe,
+	0xfe, 0xd7, 0xaf, 0x2d, 0xea, 0xd4, 0x70, 0x82, 0x61, 0x9f, 0xfb, 0x8d, 0xdd, 0x41, 0xfb, 0x2d,
+	0x32, 0xb4, 0x4a, 0x11, 0xeb, 0xae, 0xe4, 0x44, 0x37, 0x20, 0xff, 0x7d, 0x4c, 0xbb, 0xc4, 0x95,
+	0x0e, 0x9d, 0xb1, 0xf4, 0x0a, 0xad, 0x43, 0x9e, 0x71, 0xcc, 0x07, 0x4c, 0x7a, 0x71, 0x6e, 0xcd,
+	0xbc, 0x2c, 0xd5, 0x9a, 0xbe, 0xe7, 0xee, 0x49, 0x4e, 0x4b, 0x4b, 0xa0, 0x9b, 0x90, 0xe7, 0xfe,
+	0x09, 0xf1, 0xb4, 0x0b, 0x27, 0xaa, 0xef, 0x1d, 0x8f, 0x5b, 0x5a, 0x5a, 0x78, 0xc4, 0x25, 0x5d,
+	0xd2, 0x91, 0x8e, 0x63, 0xc7, 0x38, 0x20, 0xac, 0x9c, 0x97, 0x88, 0x3b, 0x13, 0x17, 0xa1, 0xf6,
+	0x54, 0x1a, 0xcf, 0xb4, 0x4a, 0x11, 0x69, 0x4f, 0x52, 0xd0, 0x5b, 0x50, 0x74, 0xe3, 0x44, 0x2d,
+	0x4f, 0xcb, 0x10, 0xbc, 0x74, 0x99, 0xf9, 0x89, 0x9c, 0xd6, 0x7d, 0x2f, 0x29, 0x2d, 0x92, 0x63,
+	0xe0, 0xb5, 0x7d, 0xcf, 0xa5, 0x5e, 0xc7, 0x3e, 0x26, 0xb4, 0x73, 0xcc, 0xcb, 0x33, 0x75, 0x63,
+	0x65, 0x2a, 0x99, 0x1c, 0x69, 0x0e, 0xd3, 0x2a, 0x45, 0xa4, 0x6d, 0x49, 0x41, 0x2e, 0xcc, 0xc5,
+	0x5c, 0xb2, 0x50, 0x0b, 0x4f, 0x2d, 0xd4, 0x17, 0x75, 0xa1, 0x5e, 0x4f, 0x6b, 0x89, 0x6b, 0xf5,
+	0x6a, 0x44, 0x14, 0x62, 0x68, 0x1b, 0x20, 0x6e, 0x0f, 0x65, 0x90, 0x1a, 0xcc, 0xa7, 0xf7, 0x18,
+	0x6d, 0x78, 0x42, 0x16, 0xbd, 0x0b, 0xd7, 0x7a, 0xd4, 0xb3, 0x19, 0xe9, 0x1e, 0xd9, 0xda, 0xc1,
+	0x02, 0xb2, 0x28, 0xa3, 0x77, 0x6b, 0xb2, 0x7c, 0x38, 0x1b, 0xd5, 0x2a, 0xba, 0x85, 0x8e, 0x43,
+	0x9a, 0xd6, 0x42, 0x8f, 0x7a, 0x7b, 0xa4, 0x7b, 0xd4, 0x8a, 0x68, 0xeb, 0xb3, 0xef, 0x3d, 0xa8,
+	0x65, 0x74, 0xb9, 0x66, 0xcc, 0x37, 0x61, 0xf6, 0x10, 0x77, 0x75, 0x99, 0x11, 0x86, 0x96, 0xa1,
+	0x80, 0xc3, 0x45, 0xd9, 0xa8, 0x4f, 0xad, 0x14, 0xac, 0x98, 0xa0, 0xca, 0xfc, 0x87, 0x7f, 0xa9,
+	0x1b, 0xe6, 0x47, 0x06, 0xe4, 0x5b, 0x87, 0xbb, 0x98, 0x06, 0x68, 0x07, 0x16, 0xe2, 0xcc, 0x39,
+	0x5f, 0xe4, 0xcb, 0x67, 0xa3, 0x5a, 0x39, 0x9d, 0x5c, 0x51, 0x95, 0xc7, 0x09, 0x1c, 0x96, 0xf9,
+	0x0e, 0x2c, 0xdc, 0x09, 0x7b, 0x47, 0x04, 0x95, 0x4d, 0x43, 0x8d, 0xb1, 0x98, 0xd6, 0x7c, 0x44,
+	0xd3, 0x50, 0x29, 0x33, 0xb7, 0x60, 0x5a, 0x9d, 0x96, 0xa1, 0x75, 0xb8, 0xd2, 0x17, 0x3f, 0xa4,
+	0x75, 0xc5, 0xb5, 0xea, 0xa5, 0xc9, 0x2b, 0xf9, 0x75, 0xf8, 0x94, 0x88, 0xf9, 0xf3, 0x2c, 0x40,
+	0xeb, 0xf0, 0x70, 0x3f, 0xa0, 0xfd, 0x2e, 0xe1, 0x9f, 0xa4, 0xe5, 0xfb, 0x70, 0x3d, 0x36, 0x8b,
+	0x05, 0x4e, 0xca, 0xfa, 0xfa, 0xd9, 0xa8, 0xb6, 0x9c, 0xb6, 0x3e, 0xc1, 0x66, 0x5a, 0xd7, 0x22,
+	0xfa, 0x5e, 0xe0, 0x5c, 0x88, 0xea, 0x32, 0x1e, 0xa1, 0x4e, 0x5d, 0x8e, 0x9a, 0x60, 0x4b, 0xa2,
+	0xb6, 0x18, 0xbf, 0xd8, 0xb5, 0x7b, 0x50, 0x8c, 0x5d, 0xc2, 0x50, 0x0b, 0x66, 0xb8, 0xfe, 0xad,
+	0x3d, 0x6c, 0x5e, 0xee, 0xe1, 0x50, 0x4c, 0x7b, 0x39, 0x92, 0x34, 0xff, 0x65, 0x00, 0xc4, 0x39,
+	0xfb, 0xe9, 0x4c, 0x31, 0xd1, 0xca, 0x75, 0xe3, 0x9d, 0x7a, 0xae, 0xab, 0x9a, 0x96, 0x4e, 0xf9,
+	0xf3, 0x27, 0x59, 0xb8, 0x76, 0x10, 0x76, 0x9e, 0x4f, 0xbd, 0x0f, 0x76, 0x61, 0x9a, 0x78, 0x3c,
+	0xa0, 0xd2, 0x09, 0x22, 0xda, 0x5f, 0xbc, 0x2c, 0xda, 0x17, 0xd8, 0xb4, 0xe5, 0xf1, 0x60, 0xa8,
+	0x63, 0x1f, 0xc2, 0xa4, 0xbc, 0xf1, 0xb3, 0x29, 0x28, 0x5f, 0x26, 0x89, 0x36, 0xa1, 0xe4, 0x04,
+	0x44, 0x12, 0xc2, 0xf9, 0x61, 0xc8, 0xf9, 0x51, 0x89, 0x6f, 0x96, 0x29, 0x06, 0xd3, 0x9a, 0x0b,
+	0x29, 0x7a, 0x7a, 0x74, 0x40, 0x5c, 0xfb, 0x44, 0xda, 0x09, 0xae, 0x67, 0xbc, 0xe7, 0x99, 0x7a,
+	0x7c, 0x84, 0x4a, 0xce, 0x03, 0xa8, 0xf9, 0x31, 0x17, 0x53, 0xe5, 0x00, 0x79, 0x07, 0x4a, 0xd4,
+	0xa3, 0x9c, 0xe2, 0xae, 0xdd, 0xc6, 0x5d, 0xec, 0x39, 0xcf, 0x73, 0x6b, 0x56, 0x2d, 0x5f, 0xab,
+	0x4d, 0xc1, 0x99, 0xd6, 0x9c, 0xa6, 0x34, 0x15, 0x01, 0x6d, 0xc3, 0x74, 0xa8, 0x2a, 0xf7, 0x5c,
+	0xb7, 0x8d, 0x50, 0x3c, 0x71, 0xc1, 0xfb, 0xe9, 0x14, 0x2c, 0x58, 0xc4, 0xfd, 0x7f, 0x28, 0x26,
+	0x0b, 0xc5, 0x37, 0x00, 0x54, 0xb9, 0x8b, 0x06, 0x5b, 0xce, 0x3d, 0x57, 0xc3, 0x28, 0x28, 0x84,
+	0x16, 0xe3, 0x89, 0x78, 0x8c, 0xb2, 0x30, 0x9b, 0x8c, 0xc7, 0xff, 0xe8, 0x54, 0x42, 0x3b, 0x71,
+	0x27, 0xca, 0xc9, 0x4e, 0xf4, 0xf9, 0xcb, 0x3a, 0xd1, 0x58, 0xf6, 0x3e, 0xb9, 0x05, 0xfd, 0xe8,
+	0x0a, 0xe4, 0x77, 0x71, 0x80, 0x7b, 0x0c, 0x39, 0x63, 0x37, 0x4d, 0xf5, 0xd6, 0x5c, 0x1a, 0xcb,
+	0xcf, 0x96, 0xfe, 0xda, 0xf1, 0x94, 0x8b, 0xe6, 0x07, 0x17, 0x5c, 0x34, 0xbf, 0x06, 0x73, 0xe2,
+	0x39, 0x1c, 0xd9, 0xa8, 0xbc, 0x7d, 0xb5, 0xb9, 0x14, 0xa3, 0x9c, 0xdf, 0x57, 0xaf, 0xe5, 0xe8,
+	0xd1, 0xc5, 0xd0, 0x97, 0xa1, 0x28, 0x38, 0xe2, 0xc6, 0x2c, 0xc4, 0x6f, 0xc4, 0xcf, 0xd2, 0xc4,
+	0xa6, 0x69, 0x41, 0x0f, 0x9f, 0x6e, 0xa9, 0x05, 0xba, 0x05, 0xe8, 0x38, 0xfa, 0x32, 0x62, 0xc7,
+	0xee, 0x14, 0xf2, 0x9f, 0x3d, 0x1b, 0xd5, 0x96, 0x94, 0xfc, 0x38, 0x8f, 0x69, 0x2d, 0xc4, 0xc4,
+	0x10, 0xed, 0x4b, 0x00, 0xc2, 0x2e, 0xdb, 0x25, 0x9e, 0xdf, 0xd3, 0xcf, 0x9d, 0xeb, 0x67, 0xa3,
+	0xda, 0x82, 0x42, 0x89, 0xf7, 0x4c, 0xab, 0x20, 0x16, 0x2d, 0xf1, 0x5b, 0xd4, 0x66, 0xdf, 0xbf,
+	0x4b, 0x02, 0x3b, 0x20, 0xee, 0xc0, 0x91, 0x37, 0xe3, 0xfc, 0x7f, 0x57, 0x9b, 0x29, 0x38, 0xd3,
+	0x9a, 0x93, 0x14, 0x2b, 0x24, 0xa0, 0x5f, 0x18, 0xb0, 0x1c, 0xa7, 0x5a, 0x97, 0xbe, 0x33, 0xa0,
+	0xae, 0xad, 0xb3, 0xc6, 0x76, 0x70, 0x5f, 0xbe, 0x73, 0x0a, 0xcd, 0x83, 0x89, 0x1f, 0x56, 0x2f,
+	0xa5, 0xd3, 0x78, 0x1c, 0xdb, 0xb4, 0x96, 0xa2, 0xed, 0x5b, 0x72, 0x77, 0x4f, 0x6d, 0x6e, 0xe2,
+	0x7e, 0xa2, 0xca, 0x3f, 0x34, 0x00, 0xc5, 0xe3, 0xcf, 0x22, 0xac, 0xef, 0x7b, 0x4c, 0x3e, 0x4a,
+	0x12, 0x2f, 0x08, 0xe3, 0xc9, 0x8f, 0x92, 0x58, 0x3e, 0x7c, 0x94, 0xc4, 0xb2, 0xe8, 0x2b, 0xf1,
+	0xa8, 0xc8, 0xea, 0x9c, 0xd6, 0x30, 0x6d, 0xcc, 0x48, 0xe2, 0x61, 0x43, 0x43, 0xe9, 0xb1, 0xd9,
+	0x90, 0x31, 0xff, 0x60, 0xc0, 0xd2, 0x58, 0x75, 0x45, 0x87, 0xfd, 0x1e, 0xa0, 0x20, 0xb1, 0x29,
+	0x73, 0x67, 0xa8, 0x0f, 0x3d, 0x71, 0xb1, 0x2e, 0x04, 0xe9, 0x8d, 0x4f, 0x70, 0xda, 0xe5, 0xa4,
+	0xcf, 0x7f, 0x6b, 0xc0, 0x62, 0x52, 0x7d, 0x64, 0xc8, 0x6d, 0x98, 0x4d, 0x6a, 0xd7, 0x26, 0xbc,
+	0xfc, 0x2c, 0x26, 0xe8, 0xd3, 0x9f, 0x93, 0x47, 0xdf, 0x8a, 0x5b, 0x97, 0xfa, 0x8e, 0xf8, 0xfa,
+	0x33, 0x7b, 0x23, 0x3c, 0x53, 0xba, 0x85, 0xe5, 0x64, 0x3c, 0xfe, 0x6d, 0x40, 0x6e, 0xd7, 0xf7,
+	0xbb, 0xc8, 0x87, 0x05, 0xcf, 0xe7, 0xb6, 0xa8, 0x32, 0xe2, 0xda, 0xfa, 0x03, 0x84, 0x9a, 0x09,
+	0x9b, 0x93, 0x39, 0xe9, 0x1f, 0xa3, 0xda, 0x38, 0x94, 0x55, 0xf2, 0x7c, 0xde, 0x94, 0x94, 0x7d,
+	0x49, 0x40, 0xef, 0xc2, 0xd5, 0xf3, 0xca, 0xd4, 0xc4, 0xf8, 0xf6, 0xc4, 0xca, 0xce, 0xc3, 0x9c,
+	0x8d, 0x6a, 0x8b, 0x71, 0xf7, 0x88, 0xc8, 0xa6, 0x35, 0xdb, 0x4e, 0x68, 0x5f, 0x9f, 0x11, 0xf1,
+	0xfb, 0xa7, 0x88, 0xe1, 0x2f, 0x0d, 0xb8, 0x26, 0x89, 0xf4, 0x07, 0x44, 0x7e, 0xc3, 0xb0, 0x88,
+	0xe3, 0x07, 0x2e, 0x9a, 0x83, 0x2c, 0x75, 0xa5, 0x07, 0x72, 0x56, 0x96, 0xba, 0x68, 0x11, 0xae,
+	0xf8, 0x77, 0x3d, 0x12, 0xe8, 0xaf, 0x6a, 0x6a, 0x21, 0x5b, 0xb1, 0xef, 0x0e, 0xba, 0xc4, 0xc6,
+	0x8e, 0xe3, 0x0f, 0x3c, 0xae, 0x47, 0x54, 0xb2, 0x15, 0x9f, 0xdb, 0x17, 0xad, 0x58, 0x12, 0x36,
+	0xd4, 0x5a, 0xbc, 0xa7, 0xa3, 0xf2, 0x56, 0x59, 0x69, 0xc5, 0x04, 0x95, 0x67, 0x5f, 0xf8, 0x8d,
+	0x01, 0x10, 0x7f, 0x29, 0x42, 0xaf, 0xc2, 0x0b, 0xcd, 0x6f, 0xde, 0x6e, 0xd9, 0x7b, 0xfb, 0x1b,
+	0xfb, 0x07, 0x7b, 0xf6, 0xc1, 0xed, 0xbd, 0xdd, 0xad, 0xcd, 0x9d, 0x9b, 0x3b, 0x5b, 0xad, 0xf9,
+	0x4c, 0xa5, 0x74, 0xef, 0x7e, 0xbd, 0x78, 0xe0, 0xb1, 0x3e, 0x71, 0xe8, 0x11, 0x25, 0x2e, 0x7a,
+	0x05, 0x16, 0xcf, 0x73, 0x8b, 0xd5, 0x56, 0x6b, 0xde, 0xa8, 0xcc, 0xde, 0xbb, 0x5f, 0x9f, 0x51,
+	0x77, 0x67, 0xe2, 0xa2, 0x15, 0xb8, 0x3e, 0xce, 0xb7, 0x73, 0xfb, 0xeb, 0xf3, 0xd9, 0xca, 0xd5,
+	0x7b, 0xf7, 0xeb, 0x85, 0xe8, 0x92, 0x8d, 0x4c, 0x40, 0x49, 0x4e, 0x8d, 0x37, 0x55, 0x81, 0x7b,
+	0xf7, 0xeb, 0x79, 0x15, 0xe4, 0x4a, 0xee, 0xbd, 0x0f, 0xab, 0x99, 0xe6, 0xcd, 0x8f, 0x1f, 0x55,
+	0x8d, 0x87, 0x8f, 0xaa, 0xc6, 0xdf, 0x1e, 0x55, 0x8d, 0xf7, 0x1f, 0x57, 0x33, 0x0f, 0x1f, 0x57,
+	0x33, 0x7f, 0x7a, 0x5c, 0xcd, 0x7c, 0xe7, 0xd5, 0x27, 0xc6, 0xf7, 0x34, 0xfa, 0x23, 0x84, 0x8c,
+	0x74, 0x3b, 0x2f, 0xc7, 0xe6, 0x1b, 0xff, 0x19, 0x00, 0x26, 0x37, 0xff, 0x1c, 0xa3, 0x18, 0x00,
+	0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1263,624 +1350,653 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9862 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x5c, 0xd7,
-		0x75, 0x18, 0xde, 0x7e, 0x00, 0xbb, 0x07, 0x0b, 0x60, 0x71, 0x01, 0x92, 0xcb, 0x25, 0x09, 0x40,
-		0x4f, 0x5f, 0x14, 0x25, 0x81, 0x12, 0x25, 0x52, 0xd2, 0xd2, 0xb6, 0xbc, 0x8b, 0x5d, 0x82, 0x10,
-		0xf1, 0xa5, 0x07, 0x80, 0x92, 0x65, 0xa7, 0x3b, 0x0f, 0xbb, 0x17, 0x8b, 0x27, 0xec, 0xbe, 0xf7,
-		0xf4, 0xde, 0x5b, 0x12, 0x90, 0xed, 0x8e, 0xfc, 0x51, 0xd7, 0x66, 0x26, 0x8d, 0x5d, 0x77, 0x1a,
-		0x5b, 0x36, 0x5d, 0x39, 0x4e, 0xeb, 0xd4, 0x71, 0x1b, 0x3b, 0x76, 0xdd, 0xa6, 0xed, 0x4c, 0xed,
-		0xce, 0xa4, 0xb1, 0xdd, 0x26, 0x63, 0xb7, 0x99, 0x36, 0xcd, 0xa4, 0x74, 0x2a, 0x7b, 0x5c, 0xc5,
-		0x75, 0x1b, 0x87, 0x75, 0xa7, 0xe9, 0x78, 0x3a, 0xed, 0xdc, 0xaf, 0xf7, 0xb5, 0x9f, 0x80, 0x48,
-		0xcb, 0x69, 0xf2, 0x0b, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0x73, 0xcf, 0x3d, 0xe7, 0xdc, 0x73, 0xbf,
-		0x1e, 0xe0, 0x8b, 0xe7, 0x61, 0xa6, 0x66, 0x18, 0xb5, 0x3a, 0x3e, 0x6d, 0x5a, 0x86, 0x63, 0x6c,
-		0x36, 0xb7, 0x4e, 0x57, 0xb1, 0x5d, 0xb1, 0x34, 0xd3, 0x31, 0xac, 0x59, 0x0a, 0x43, 0x63, 0x0c,
-		0x63, 0x56, 0x60, 0xc8, 0x4b, 0x30, 0x7e, 0x41, 0xab, 0xe3, 0xa2, 0x8b, 0xb8, 0x86, 0x1d, 0xf4,
-		0x38, 0xc4, 0xb6, 0xb4, 0x3a, 0xce, 0x48, 0x33, 0xd1, 0x93, 0xc3, 0x67, 0xee, 0x9a, 0x0d, 0x11,
-		0xcd, 0x06, 0x29, 0x56, 0x09, 0x58, 0xa1, 0x14, 0xf2, 0xf7, 0x62, 0x30, 0xd1, 0xa6, 0x16, 0x21,
-		0x88, 0xe9, 0x6a, 0x83, 0x70, 0x94, 0x4e, 0x26, 0x15, 0xfa, 0x1b, 0x65, 0x60, 0xc8, 0x54, 0x2b,
-		0x3b, 0x6a, 0x0d, 0x67, 0x22, 0x14, 0x2c, 0x8a, 0x68, 0x0a, 0xa0, 0x8a, 0x4d, 0xac, 0x57, 0xb1,
-		0x5e, 0xd9, 0xcb, 0x44, 0x67, 0xa2, 0x27, 0x93, 0x8a, 0x0f, 0x82, 0xee, 0x87, 0x71, 0xb3, 0xb9,
-		0x59, 0xd7, 0x2a, 0x65, 0x1f, 0x1a, 0xcc, 0x44, 0x4f, 0xc6, 0x95, 0x34, 0xab, 0x28, 0x7a, 0xc8,
-		0xf7, 0xc2, 0xd8, 0x55, 0xac, 0xee, 0xf8, 0x51, 0x87, 0x29, 0xea, 0x28, 0x01, 0xfb, 0x10, 0xe7,
-		0x20, 0xd5, 0xc0, 0xb6, 0xad, 0xd6, 0x70, 0xd9, 0xd9, 0x33, 0x71, 0x26, 0x46, 0x7b, 0x3f, 0xd3,
-		0xd2, 0xfb, 0x70, 0xcf, 0x87, 0x39, 0xd5, 0xfa, 0x9e, 0x89, 0x51, 0x1e, 0x92, 0x58, 0x6f, 0x36,
-		0x18, 0x87, 0x78, 0x07, 0xfd, 0x95, 0xf4, 0x66, 0x23, 0xcc, 0x25, 0x41, 0xc8, 0x38, 0x8b, 0x21,
-		0x1b, 0x5b, 0x57, 0xb4, 0x0a, 0xce, 0x0c, 0x52, 0x06, 0xf7, 0xb6, 0x30, 0x58, 0x63, 0xf5, 0x61,
-		0x1e, 0x82, 0x0e, 0xcd, 0x41, 0x12, 0xef, 0x3a, 0x58, 0xb7, 0x35, 0x43, 0xcf, 0x0c, 0x51, 0x26,
-		0x77, 0xb7, 0x19, 0x45, 0x5c, 0xaf, 0x86, 0x59, 0x78, 0x74, 0xe8, 0x1c, 0x0c, 0x19, 0xa6, 0xa3,
-		0x19, 0xba, 0x9d, 0x49, 0xcc, 0x48, 0x27, 0x87, 0xcf, 0x1c, 0x6f, 0x6b, 0x08, 0x2b, 0x0c, 0x47,
-		0x11, 0xc8, 0x68, 0x01, 0xd2, 0xb6, 0xd1, 0xb4, 0x2a, 0xb8, 0x5c, 0x31, 0xaa, 0xb8, 0xac, 0xe9,
-		0x5b, 0x46, 0x26, 0x49, 0x19, 0x4c, 0xb7, 0x76, 0x84, 0x22, 0xce, 0x19, 0x55, 0xbc, 0xa0, 0x6f,
-		0x19, 0xca, 0xa8, 0x1d, 0x28, 0xa3, 0xc3, 0x30, 0x68, 0xef, 0xe9, 0x8e, 0xba, 0x9b, 0x49, 0x51,
-		0x0b, 0xe1, 0x25, 0xf9, 0x37, 0x07, 0x61, 0xac, 0x1f, 0x13, 0x3b, 0x0f, 0xf1, 0x2d, 0xd2, 0xcb,
-		0x4c, 0x64, 0x3f, 0x3a, 0x60, 0x34, 0x41, 0x25, 0x0e, 0x1e, 0x50, 0x89, 0x79, 0x18, 0xd6, 0xb1,
-		0xed, 0xe0, 0x2a, 0xb3, 0x88, 0x68, 0x9f, 0x36, 0x05, 0x8c, 0xa8, 0xd5, 0xa4, 0x62, 0x07, 0x32,
-		0xa9, 0x67, 0x61, 0xcc, 0x15, 0xa9, 0x6c, 0xa9, 0x7a, 0x4d, 0xd8, 0xe6, 0xe9, 0x5e, 0x92, 0xcc,
-		0x96, 0x04, 0x9d, 0x42, 0xc8, 0x94, 0x51, 0x1c, 0x28, 0xa3, 0x22, 0x80, 0xa1, 0x63, 0x63, 0xab,
-		0x5c, 0xc5, 0x95, 0x7a, 0x26, 0xd1, 0x41, 0x4b, 0x2b, 0x04, 0xa5, 0x45, 0x4b, 0x06, 0x83, 0x56,
-		0xea, 0xe8, 0x09, 0xcf, 0xd4, 0x86, 0x3a, 0x58, 0xca, 0x12, 0x73, 0xb2, 0x16, 0x6b, 0xdb, 0x80,
-		0x51, 0x0b, 0x13, 0xbb, 0xc7, 0x55, 0xde, 0xb3, 0x24, 0x15, 0x62, 0xb6, 0x67, 0xcf, 0x14, 0x4e,
-		0xc6, 0x3a, 0x36, 0x62, 0xf9, 0x8b, 0xe8, 0x4e, 0x70, 0x01, 0x65, 0x6a, 0x56, 0x40, 0xa3, 0x50,
-		0x4a, 0x00, 0x97, 0xd5, 0x06, 0xce, 0xbe, 0x08, 0xa3, 0x41, 0xf5, 0xa0, 0x49, 0x88, 0xdb, 0x8e,
-		0x6a, 0x39, 0xd4, 0x0a, 0xe3, 0x0a, 0x2b, 0xa0, 0x34, 0x44, 0xb1, 0x5e, 0xa5, 0x51, 0x2e, 0xae,
-		0x90, 0x9f, 0xe8, 0xad, 0x5e, 0x87, 0xa3, 0xb4, 0xc3, 0xf7, 0xb4, 0x8e, 0x68, 0x80, 0x73, 0xb8,
-		0xdf, 0xd9, 0xc7, 0x60, 0x24, 0xd0, 0x81, 0x7e, 0x9b, 0x96, 0xdf, 0x05, 0x87, 0xda, 0xb2, 0x46,
-		0xcf, 0xc2, 0x64, 0x53, 0xd7, 0x74, 0x07, 0x5b, 0xa6, 0x85, 0x89, 0xc5, 0xb2, 0xa6, 0x32, 0xff,
-		0x65, 0xa8, 0x83, 0xcd, 0x6d, 0xf8, 0xb1, 0x19, 0x17, 0x65, 0xa2, 0xd9, 0x0a, 0x3c, 0x95, 0x4c,
-		0xbc, 0x36, 0x94, 0x7e, 0xe9, 0xa5, 0x97, 0x5e, 0x8a, 0xc8, 0x5f, 0x1b, 0x84, 0xc9, 0x76, 0x3e,
-		0xd3, 0xd6, 0x7d, 0x0f, 0xc3, 0xa0, 0xde, 0x6c, 0x6c, 0x62, 0x8b, 0x2a, 0x29, 0xae, 0xf0, 0x12,
-		0xca, 0x43, 0xbc, 0xae, 0x6e, 0xe2, 0x7a, 0x26, 0x36, 0x23, 0x9d, 0x1c, 0x3d, 0x73, 0x7f, 0x5f,
-		0x5e, 0x39, 0xbb, 0x48, 0x48, 0x14, 0x46, 0x89, 0xde, 0x02, 0x31, 0x1e, 0xa2, 0x09, 0x87, 0x53,
-		0xfd, 0x71, 0x20, 0xbe, 0xa4, 0x50, 0x3a, 0x74, 0x0c, 0x92, 0xe4, 0x2f, 0xb3, 0x8d, 0x41, 0x2a,
-		0x73, 0x82, 0x00, 0x88, 0x5d, 0xa0, 0x2c, 0x24, 0xa8, 0x9b, 0x54, 0xb1, 0x98, 0xda, 0xdc, 0x32,
-		0x31, 0xac, 0x2a, 0xde, 0x52, 0x9b, 0x75, 0xa7, 0x7c, 0x45, 0xad, 0x37, 0x31, 0x35, 0xf8, 0xa4,
-		0x92, 0xe2, 0xc0, 0xcb, 0x04, 0x86, 0xa6, 0x61, 0x98, 0x79, 0x95, 0xa6, 0x57, 0xf1, 0x2e, 0x8d,
-		0x9e, 0x71, 0x85, 0x39, 0xda, 0x02, 0x81, 0x90, 0xe6, 0x9f, 0xb7, 0x0d, 0x5d, 0x98, 0x26, 0x6d,
-		0x82, 0x00, 0x68, 0xf3, 0x8f, 0x85, 0x03, 0xf7, 0x89, 0xf6, 0xdd, 0x6b, 0xf1, 0xa5, 0x7b, 0x61,
-		0x8c, 0x62, 0x3c, 0xc2, 0x87, 0x5e, 0xad, 0x67, 0xc6, 0x67, 0xa4, 0x93, 0x09, 0x65, 0x94, 0x81,
-		0x57, 0x38, 0x54, 0xfe, 0x4a, 0x04, 0x62, 0x34, 0xb0, 0x8c, 0xc1, 0xf0, 0xfa, 0xdb, 0x56, 0x4b,
-		0xe5, 0xe2, 0xca, 0x46, 0x61, 0xb1, 0x94, 0x96, 0xd0, 0x28, 0x00, 0x05, 0x5c, 0x58, 0x5c, 0xc9,
-		0xaf, 0xa7, 0x23, 0x6e, 0x79, 0x61, 0x79, 0xfd, 0xdc, 0xa3, 0xe9, 0xa8, 0x4b, 0xb0, 0xc1, 0x00,
-		0x31, 0x3f, 0xc2, 0x23, 0x67, 0xd2, 0x71, 0x94, 0x86, 0x14, 0x63, 0xb0, 0xf0, 0x6c, 0xa9, 0x78,
-		0xee, 0xd1, 0xf4, 0x60, 0x10, 0xf2, 0xc8, 0x99, 0xf4, 0x10, 0x1a, 0x81, 0x24, 0x85, 0x14, 0x56,
-		0x56, 0x16, 0xd3, 0x09, 0x97, 0xe7, 0xda, 0xba, 0xb2, 0xb0, 0x3c, 0x9f, 0x4e, 0xba, 0x3c, 0xe7,
-		0x95, 0x95, 0x8d, 0xd5, 0x34, 0xb8, 0x1c, 0x96, 0x4a, 0x6b, 0x6b, 0xf9, 0xf9, 0x52, 0x7a, 0xd8,
-		0xc5, 0x28, 0xbc, 0x6d, 0xbd, 0xb4, 0x96, 0x4e, 0x05, 0xc4, 0x7a, 0xe4, 0x4c, 0x7a, 0xc4, 0x6d,
-		0xa2, 0xb4, 0xbc, 0xb1, 0x94, 0x1e, 0x45, 0xe3, 0x30, 0xc2, 0x9a, 0x10, 0x42, 0x8c, 0x85, 0x40,
-		0xe7, 0x1e, 0x4d, 0xa7, 0x3d, 0x41, 0x18, 0x97, 0xf1, 0x00, 0xe0, 0xdc, 0xa3, 0x69, 0x24, 0xcf,
-		0x41, 0x9c, 0x9a, 0x21, 0x42, 0x30, 0xba, 0x98, 0x2f, 0x94, 0x16, 0xcb, 0x2b, 0xab, 0xeb, 0x0b,
-		0x2b, 0xcb, 0xf9, 0xc5, 0xb4, 0xe4, 0xc1, 0x94, 0xd2, 0xd3, 0x1b, 0x0b, 0x4a, 0xa9, 0x98, 0x8e,
-		0xf8, 0x61, 0xab, 0xa5, 0xfc, 0x7a, 0xa9, 0x98, 0x8e, 0xca, 0x15, 0x98, 0x6c, 0x17, 0x50, 0xdb,
-		0xba, 0x90, 0xcf, 0x16, 0x22, 0x1d, 0x6c, 0x81, 0xf2, 0x0a, 0xdb, 0x82, 0xfc, 0xdd, 0x08, 0x4c,
-		0xb4, 0x99, 0x54, 0xda, 0x36, 0xf2, 0x24, 0xc4, 0x99, 0x2d, 0xb3, 0x69, 0xf6, 0xbe, 0xb6, 0xb3,
-		0x13, 0xb5, 0xec, 0x96, 0xa9, 0x96, 0xd2, 0xf9, 0x53, 0x8d, 0x68, 0x87, 0x54, 0x83, 0xb0, 0x68,
-		0x31, 0xd8, 0x9f, 0x6b, 0x09, 0xfe, 0x6c, 0x7e, 0x3c, 0xd7, 0xcf, 0xfc, 0x48, 0x61, 0xfb, 0x9b,
-		0x04, 0xe2, 0x6d, 0x26, 0x81, 0xf3, 0x30, 0xde, 0xc2, 0xa8, 0xef, 0x60, 0xfc, 0x3e, 0x09, 0x32,
-		0x9d, 0x94, 0xd3, 0x23, 0x24, 0x46, 0x02, 0x21, 0xf1, 0x7c, 0x58, 0x83, 0x77, 0x74, 0x1e, 0x84,
-		0x96, 0xb1, 0xfe, 0xac, 0x04, 0x87, 0xdb, 0xa7, 0x94, 0x6d, 0x65, 0x78, 0x0b, 0x0c, 0x36, 0xb0,
-		0xb3, 0x6d, 0x88, 0xb4, 0xea, 0x9e, 0x36, 0x93, 0x35, 0xa9, 0x0e, 0x0f, 0x36, 0xa7, 0xf2, 0xcf,
-		0xf6, 0xd1, 0x4e, 0x79, 0x21, 0x93, 0xa6, 0x45, 0xd2, 0x0f, 0x45, 0xe0, 0x50, 0x5b, 0xe6, 0x6d,
-		0x05, 0x3d, 0x01, 0xa0, 0xe9, 0x66, 0xd3, 0x61, 0xa9, 0x13, 0x8b, 0xc4, 0x49, 0x0a, 0xa1, 0xc1,
-		0x8b, 0x44, 0xd9, 0xa6, 0xe3, 0xd6, 0x47, 0x69, 0x3d, 0x30, 0x10, 0x45, 0x78, 0xdc, 0x13, 0x34,
-		0x46, 0x05, 0x9d, 0xea, 0xd0, 0xd3, 0x16, 0xc3, 0x7c, 0x08, 0xd2, 0x95, 0xba, 0x86, 0x75, 0xa7,
-		0x6c, 0x3b, 0x16, 0x56, 0x1b, 0x9a, 0x5e, 0xa3, 0x53, 0x4d, 0x22, 0x17, 0xdf, 0x52, 0xeb, 0x36,
-		0x56, 0xc6, 0x58, 0xf5, 0x9a, 0xa8, 0x25, 0x14, 0xd4, 0x80, 0x2c, 0x1f, 0xc5, 0x60, 0x80, 0x82,
-		0x55, 0xbb, 0x14, 0xf2, 0x47, 0x92, 0x30, 0xec, 0x4b, 0xc0, 0xd1, 0x1d, 0x90, 0x7a, 0x5e, 0xbd,
-		0xa2, 0x96, 0xc5, 0xa2, 0x8a, 0x69, 0x62, 0x98, 0xc0, 0x56, 0xf9, 0xc2, 0xea, 0x21, 0x98, 0xa4,
-		0x28, 0x46, 0xd3, 0xc1, 0x56, 0xb9, 0x52, 0x57, 0x6d, 0x9b, 0x2a, 0x2d, 0x41, 0x51, 0x11, 0xa9,
-		0x5b, 0x21, 0x55, 0x73, 0xa2, 0x06, 0x9d, 0x85, 0x09, 0x4a, 0xd1, 0x68, 0xd6, 0x1d, 0xcd, 0xac,
-		0xe3, 0x32, 0x59, 0xe6, 0xd9, 0x74, 0xca, 0x71, 0x25, 0x1b, 0x27, 0x18, 0x4b, 0x1c, 0x81, 0x48,
-		0x64, 0xa3, 0x22, 0x9c, 0xa0, 0x64, 0x35, 0xac, 0x63, 0x4b, 0x75, 0x70, 0x19, 0xbf, 0xd0, 0x54,
-		0xeb, 0x76, 0x59, 0xd5, 0xab, 0xe5, 0x6d, 0xd5, 0xde, 0xce, 0x4c, 0x12, 0x06, 0x85, 0x48, 0x46,
-		0x52, 0x8e, 0x12, 0xc4, 0x79, 0x8e, 0x57, 0xa2, 0x68, 0x79, 0xbd, 0x7a, 0x51, 0xb5, 0xb7, 0x51,
-		0x0e, 0x0e, 0x53, 0x2e, 0xb6, 0x63, 0x69, 0x7a, 0xad, 0x5c, 0xd9, 0xc6, 0x95, 0x9d, 0x72, 0xd3,
-		0xd9, 0x7a, 0x3c, 0x73, 0xcc, 0xdf, 0x3e, 0x95, 0x70, 0x8d, 0xe2, 0xcc, 0x11, 0x94, 0x0d, 0x67,
-		0xeb, 0x71, 0xb4, 0x06, 0x29, 0x32, 0x18, 0x0d, 0xed, 0x45, 0x5c, 0xde, 0x32, 0x2c, 0x3a, 0x87,
-		0x8e, 0xb6, 0x09, 0x4d, 0x3e, 0x0d, 0xce, 0xae, 0x70, 0x82, 0x25, 0xa3, 0x8a, 0x73, 0xf1, 0xb5,
-		0xd5, 0x52, 0xa9, 0xa8, 0x0c, 0x0b, 0x2e, 0x17, 0x0c, 0x8b, 0x18, 0x54, 0xcd, 0x70, 0x15, 0x3c,
-		0xcc, 0x0c, 0xaa, 0x66, 0x08, 0xf5, 0x9e, 0x85, 0x89, 0x4a, 0x85, 0xf5, 0x59, 0xab, 0x94, 0xf9,
-		0x62, 0xcc, 0xce, 0xa4, 0x03, 0xca, 0xaa, 0x54, 0xe6, 0x19, 0x02, 0xb7, 0x71, 0x1b, 0x3d, 0x01,
-		0x87, 0x3c, 0x65, 0xf9, 0x09, 0xc7, 0x5b, 0x7a, 0x19, 0x26, 0x3d, 0x0b, 0x13, 0xe6, 0x5e, 0x2b,
-		0x21, 0x0a, 0xb4, 0x68, 0xee, 0x85, 0xc9, 0x1e, 0x83, 0x49, 0x73, 0xdb, 0x6c, 0xa5, 0x3b, 0xe5,
-		0xa7, 0x43, 0xe6, 0xb6, 0x19, 0x26, 0xbc, 0x9b, 0xae, 0xcc, 0x2d, 0x5c, 0x51, 0x1d, 0x5c, 0xcd,
-		0x1c, 0xf1, 0xa3, 0xfb, 0x2a, 0xd0, 0x2c, 0xa4, 0x2b, 0x95, 0x32, 0xd6, 0xd5, 0xcd, 0x3a, 0x2e,
-		0xab, 0x16, 0xd6, 0x55, 0x3b, 0x33, 0x4d, 0x91, 0x63, 0x8e, 0xd5, 0xc4, 0xca, 0x68, 0xa5, 0x52,
-		0xa2, 0x95, 0x79, 0x5a, 0x87, 0x4e, 0xc1, 0xb8, 0xb1, 0xf9, 0x7c, 0x85, 0x59, 0x64, 0xd9, 0xb4,
-		0xf0, 0x96, 0xb6, 0x9b, 0xb9, 0x8b, 0xaa, 0x77, 0x8c, 0x54, 0x50, 0x7b, 0x5c, 0xa5, 0x60, 0x74,
-		0x1f, 0xa4, 0x2b, 0xf6, 0xb6, 0x6a, 0x99, 0x34, 0x24, 0xdb, 0xa6, 0x5a, 0xc1, 0x99, 0xbb, 0x19,
-		0x2a, 0x83, 0x2f, 0x0b, 0x30, 0xf1, 0x08, 0xfb, 0xaa, 0xb6, 0xe5, 0x08, 0x8e, 0xf7, 0x32, 0x8f,
-		0xa0, 0x30, 0xce, 0xed, 0x24, 0xa4, 0x89, 0x26, 0x02, 0x0d, 0x9f, 0xa4, 0x68, 0xa3, 0xe6, 0xb6,
-		0xe9, 0x6f, 0xf7, 0x4e, 0x18, 0x21, 0x98, 0x5e, 0xa3, 0xf7, 0xb1, 0xc4, 0xcd, 0xdc, 0xf6, 0xb5,
-		0xf8, 0x28, 0x1c, 0x26, 0x48, 0x0d, 0xec, 0xa8, 0x55, 0xd5, 0x51, 0x7d, 0xd8, 0x0f, 0x50, 0x6c,
-		0xa2, 0xf6, 0x25, 0x5e, 0x19, 0x90, 0xd3, 0x6a, 0x6e, 0xee, 0xb9, 0x86, 0xf5, 0x20, 0x93, 0x93,
-		0xc0, 0x84, 0x69, 0xdd, 0xb6, 0xe4, 0x5c, 0xce, 0x41, 0xca, 0x6f, 0xf7, 0x28, 0x09, 0xcc, 0xf2,
-		0xd3, 0x12, 0x49, 0x82, 0xe6, 0x56, 0x8a, 0x24, 0x7d, 0x79, 0xae, 0x94, 0x8e, 0x90, 0x34, 0x6a,
-		0x71, 0x61, 0xbd, 0x54, 0x56, 0x36, 0x96, 0xd7, 0x17, 0x96, 0x4a, 0xe9, 0xa8, 0x2f, 0xb1, 0x7f,
-		0x2a, 0x96, 0xb8, 0x27, 0x7d, 0xaf, 0xfc, 0xed, 0x08, 0x8c, 0x06, 0x57, 0x6a, 0xe8, 0x4d, 0x70,
-		0x44, 0x6c, 0xab, 0xd8, 0xd8, 0x29, 0x5f, 0xd5, 0x2c, 0xea, 0x90, 0x0d, 0x95, 0x4d, 0x8e, 0xae,
-		0xfd, 0x4c, 0x72, 0xac, 0x35, 0xec, 0x3c, 0xa3, 0x59, 0xc4, 0xdd, 0x1a, 0xaa, 0x83, 0x16, 0x61,
-		0x5a, 0x37, 0xca, 0xb6, 0xa3, 0xea, 0x55, 0xd5, 0xaa, 0x96, 0xbd, 0x0d, 0xad, 0xb2, 0x5a, 0xa9,
-		0x60, 0xdb, 0x36, 0xd8, 0x44, 0xe8, 0x72, 0x39, 0xae, 0x1b, 0x6b, 0x1c, 0xd9, 0x9b, 0x21, 0xf2,
-		0x1c, 0x35, 0x64, 0xbe, 0xd1, 0x4e, 0xe6, 0x7b, 0x0c, 0x92, 0x0d, 0xd5, 0x2c, 0x63, 0xdd, 0xb1,
-		0xf6, 0x68, 0x7e, 0x9e, 0x50, 0x12, 0x0d, 0xd5, 0x2c, 0x91, 0xf2, 0x4f, 0x65, 0x99, 0xf4, 0x54,
-		0x2c, 0x91, 0x48, 0x27, 0x9f, 0x8a, 0x25, 0x92, 0x69, 0x90, 0x5f, 0x8d, 0x42, 0xca, 0x9f, 0xaf,
-		0x93, 0xe5, 0x4f, 0x85, 0xce, 0x58, 0x12, 0x8d, 0x69, 0x77, 0x76, 0xcd, 0xee, 0x67, 0xe7, 0xc8,
-		0x54, 0x96, 0x1b, 0x64, 0xc9, 0xb1, 0xc2, 0x28, 0x49, 0x1a, 0x41, 0x8c, 0x0d, 0xb3, 0x64, 0x24,
-		0xa1, 0xf0, 0x12, 0x9a, 0x87, 0xc1, 0xe7, 0x6d, 0xca, 0x7b, 0x90, 0xf2, 0xbe, 0xab, 0x3b, 0xef,
-		0xa7, 0xd6, 0x28, 0xf3, 0xe4, 0x53, 0x6b, 0xe5, 0xe5, 0x15, 0x65, 0x29, 0xbf, 0xa8, 0x70, 0x72,
-		0x74, 0x14, 0x62, 0x75, 0xf5, 0xc5, 0xbd, 0xe0, 0xa4, 0x47, 0x41, 0xfd, 0x0e, 0xc2, 0x51, 0x88,
-		0x5d, 0xc5, 0xea, 0x4e, 0x70, 0xaa, 0xa1, 0xa0, 0xdb, 0xe8, 0x0c, 0xa7, 0x21, 0x4e, 0xf5, 0x85,
-		0x00, 0xb8, 0xc6, 0xd2, 0x03, 0x28, 0x01, 0xb1, 0xb9, 0x15, 0x85, 0x38, 0x44, 0x1a, 0x52, 0x0c,
-		0x5a, 0x5e, 0x5d, 0x28, 0xcd, 0x95, 0xd2, 0x11, 0xf9, 0x2c, 0x0c, 0x32, 0x25, 0x10, 0x67, 0x71,
-		0xd5, 0x90, 0x1e, 0xe0, 0x45, 0xce, 0x43, 0x12, 0xb5, 0x1b, 0x4b, 0x85, 0x92, 0x92, 0x8e, 0x04,
-		0x87, 0x3a, 0x96, 0x8e, 0xcb, 0x36, 0xa4, 0xfc, 0x79, 0xf8, 0x4f, 0x67, 0x31, 0xfe, 0x55, 0x09,
-		0x86, 0x7d, 0x79, 0x35, 0x49, 0x88, 0xd4, 0x7a, 0xdd, 0xb8, 0x5a, 0x56, 0xeb, 0x9a, 0x6a, 0x73,
-		0xd3, 0x00, 0x0a, 0xca, 0x13, 0x48, 0xbf, 0x43, 0xf7, 0x53, 0x72, 0x91, 0x78, 0x7a, 0x50, 0xfe,
-		0x94, 0x04, 0xe9, 0x70, 0x62, 0x1b, 0x12, 0x53, 0x7a, 0x23, 0xc5, 0x94, 0x3f, 0x29, 0xc1, 0x68,
-		0x30, 0x9b, 0x0d, 0x89, 0x77, 0xc7, 0x1b, 0x2a, 0xde, 0x1f, 0x45, 0x60, 0x24, 0x90, 0xc3, 0xf6,
-		0x2b, 0xdd, 0x0b, 0x30, 0xae, 0x55, 0x71, 0xc3, 0x34, 0x1c, 0xac, 0x57, 0xf6, 0xca, 0x75, 0x7c,
-		0x05, 0xd7, 0x33, 0x32, 0x0d, 0x1a, 0xa7, 0xbb, 0x67, 0xc9, 0xb3, 0x0b, 0x1e, 0xdd, 0x22, 0x21,
-		0xcb, 0x4d, 0x2c, 0x14, 0x4b, 0x4b, 0xab, 0x2b, 0xeb, 0xa5, 0xe5, 0xb9, 0xb7, 0x95, 0x37, 0x96,
-		0x2f, 0x2d, 0xaf, 0x3c, 0xb3, 0xac, 0xa4, 0xb5, 0x10, 0xda, 0x6d, 0x74, 0xfb, 0x55, 0x48, 0x87,
-		0x85, 0x42, 0x47, 0xa0, 0x9d, 0x58, 0xe9, 0x01, 0x34, 0x01, 0x63, 0xcb, 0x2b, 0xe5, 0xb5, 0x85,
-		0x62, 0xa9, 0x5c, 0xba, 0x70, 0xa1, 0x34, 0xb7, 0xbe, 0xc6, 0xf6, 0x3d, 0x5c, 0xec, 0xf5, 0x80,
-		0x83, 0xcb, 0x2f, 0x47, 0x61, 0xa2, 0x8d, 0x24, 0x28, 0xcf, 0x57, 0x2c, 0x6c, 0x11, 0xf5, 0x60,
-		0x3f, 0xd2, 0xcf, 0x92, 0x9c, 0x61, 0x55, 0xb5, 0x1c, 0xbe, 0xc0, 0xb9, 0x0f, 0x88, 0x96, 0x74,
-		0x47, 0xdb, 0xd2, 0xb0, 0xc5, 0xf7, 0x93, 0xd8, 0x32, 0x66, 0xcc, 0x83, 0xb3, 0x2d, 0xa5, 0x07,
-		0x00, 0x99, 0x86, 0xad, 0x39, 0xda, 0x15, 0x5c, 0xd6, 0x74, 0xb1, 0xf9, 0x44, 0x96, 0x35, 0x31,
-		0x25, 0x2d, 0x6a, 0x16, 0x74, 0xc7, 0xc5, 0xd6, 0x71, 0x4d, 0x0d, 0x61, 0x93, 0x60, 0x1e, 0x55,
-		0xd2, 0xa2, 0xc6, 0xc5, 0xbe, 0x03, 0x52, 0x55, 0xa3, 0x49, 0x72, 0x3d, 0x86, 0x47, 0xe6, 0x0e,
-		0x49, 0x19, 0x66, 0x30, 0x17, 0x85, 0x67, 0xf1, 0xde, 0xae, 0x57, 0x4a, 0x19, 0x66, 0x30, 0x86,
-		0x72, 0x2f, 0x8c, 0xa9, 0xb5, 0x9a, 0x45, 0x98, 0x0b, 0x46, 0x6c, 0x5d, 0x32, 0xea, 0x82, 0x29,
-		0x62, 0xf6, 0x29, 0x48, 0x08, 0x3d, 0x90, 0xa9, 0x9a, 0x68, 0xa2, 0x6c, 0xb2, 0xc5, 0x76, 0xe4,
-		0x64, 0x52, 0x49, 0xe8, 0xa2, 0xf2, 0x0e, 0x48, 0x69, 0x76, 0xd9, 0xdb, 0xc4, 0x8f, 0xcc, 0x44,
-		0x4e, 0x26, 0x94, 0x61, 0xcd, 0x76, 0x37, 0x40, 0xe5, 0xcf, 0x46, 0x60, 0x34, 0x78, 0x08, 0x81,
-		0x8a, 0x90, 0xa8, 0x1b, 0x15, 0x95, 0x9a, 0x16, 0x3b, 0x01, 0x3b, 0xd9, 0xe3, 0xdc, 0x62, 0x76,
-		0x91, 0xe3, 0x2b, 0x2e, 0x65, 0xf6, 0x77, 0x25, 0x48, 0x08, 0x30, 0x3a, 0x0c, 0x31, 0x53, 0x75,
-		0xb6, 0x29, 0xbb, 0x78, 0x21, 0x92, 0x96, 0x14, 0x5a, 0x26, 0x70, 0xdb, 0x54, 0x75, 0x6a, 0x02,
-		0x1c, 0x4e, 0xca, 0x64, 0x5c, 0xeb, 0x58, 0xad, 0xd2, 0x45, 0x8f, 0xd1, 0x68, 0x60, 0xdd, 0xb1,
-		0xc5, 0xb8, 0x72, 0xf8, 0x1c, 0x07, 0xa3, 0xfb, 0x61, 0xdc, 0xb1, 0x54, 0xad, 0x1e, 0xc0, 0x8d,
-		0x51, 0xdc, 0xb4, 0xa8, 0x70, 0x91, 0x73, 0x70, 0x54, 0xf0, 0xad, 0x62, 0x47, 0xad, 0x6c, 0xe3,
-		0xaa, 0x47, 0x34, 0x48, 0x37, 0x37, 0x8e, 0x70, 0x84, 0x22, 0xaf, 0x17, 0xb4, 0xf2, 0xb7, 0x25,
-		0x18, 0x17, 0xcb, 0xb4, 0xaa, 0xab, 0xac, 0x25, 0x00, 0x55, 0xd7, 0x0d, 0xc7, 0xaf, 0xae, 0x56,
-		0x53, 0x6e, 0xa1, 0x9b, 0xcd, 0xbb, 0x44, 0x8a, 0x8f, 0x41, 0xb6, 0x01, 0xe0, 0xd5, 0x74, 0x54,
-		0xdb, 0x34, 0x0c, 0xf3, 0x13, 0x26, 0x7a, 0x4c, 0xc9, 0x16, 0xf6, 0xc0, 0x40, 0x64, 0x3d, 0x87,
-		0x26, 0x21, 0xbe, 0x89, 0x6b, 0x9a, 0xce, 0xf7, 0x8d, 0x59, 0x41, 0x6c, 0xbf, 0xc4, 0xdc, 0xed,
-		0x97, 0xc2, 0x5f, 0x85, 0x89, 0x8a, 0xd1, 0x08, 0x8b, 0x5b, 0x48, 0x87, 0x36, 0x17, 0xec, 0x8b,
-		0xd2, 0x73, 0x0f, 0x72, 0xa4, 0x9a, 0x51, 0x57, 0xf5, 0xda, 0xac, 0x61, 0xd5, 0xbc, 0x63, 0x56,
-		0x92, 0xf1, 0xd8, 0xbe, 0xc3, 0x56, 0x73, 0xf3, 0xcf, 0x24, 0xe9, 0x97, 0x23, 0xd1, 0xf9, 0xd5,
-		0xc2, 0xe7, 0x22, 0xd9, 0x79, 0x46, 0xb8, 0x2a, 0x94, 0xa1, 0xe0, 0xad, 0x3a, 0xae, 0x90, 0x0e,
-		0xc2, 0x0f, 0xee, 0x87, 0xc9, 0x9a, 0x51, 0x33, 0x28, 0xa7, 0xd3, 0xe4, 0x17, 0x3f, 0xa7, 0x4d,
-		0xba, 0xd0, 0x6c, 0xcf, 0x43, 0xdd, 0xdc, 0x32, 0x4c, 0x70, 0xe4, 0x32, 0x3d, 0x28, 0x62, 0xcb,
-		0x18, 0xd4, 0x75, 0x0f, 0x2d, 0xf3, 0xc5, 0xef, 0xd1, 0xe9, 0x5b, 0x19, 0xe7, 0xa4, 0xa4, 0x8e,
-		0xad, 0x74, 0x72, 0x0a, 0x1c, 0x0a, 0xf0, 0x63, 0x4e, 0x8a, 0xad, 0x1e, 0x1c, 0x7f, 0x8b, 0x73,
-		0x9c, 0xf0, 0x71, 0x5c, 0xe3, 0xa4, 0xb9, 0x39, 0x18, 0xd9, 0x0f, 0xaf, 0x7f, 0xc5, 0x79, 0xa5,
-		0xb0, 0x9f, 0xc9, 0x3c, 0x8c, 0x51, 0x26, 0x95, 0xa6, 0xed, 0x18, 0x0d, 0x1a, 0x01, 0xbb, 0xb3,
-		0xf9, 0xed, 0xef, 0x31, 0xaf, 0x19, 0x25, 0x64, 0x73, 0x2e, 0x55, 0x2e, 0x07, 0xf4, 0x6c, 0xac,
-		0x8a, 0x2b, 0xf5, 0x1e, 0x1c, 0xbe, 0xce, 0x05, 0x71, 0xf1, 0x73, 0x97, 0x61, 0x92, 0xfc, 0xa6,
-		0x01, 0xca, 0x2f, 0x49, 0xef, 0x0d, 0xb7, 0xcc, 0xb7, 0xdf, 0xc7, 0x1c, 0x73, 0xc2, 0x65, 0xe0,
-		0x93, 0xc9, 0x37, 0x8a, 0x35, 0xec, 0x38, 0xd8, 0xb2, 0xcb, 0x6a, 0xbd, 0x9d, 0x78, 0xbe, 0x1d,
-		0x8b, 0xcc, 0xc7, 0x7f, 0x18, 0x1c, 0xc5, 0x79, 0x46, 0x99, 0xaf, 0xd7, 0x73, 0x1b, 0x70, 0xa4,
-		0x8d, 0x55, 0xf4, 0xc1, 0xf3, 0x65, 0xce, 0x73, 0xb2, 0xc5, 0x32, 0x08, 0xdb, 0x55, 0x10, 0x70,
-		0x77, 0x2c, 0xfb, 0xe0, 0xf9, 0x09, 0xce, 0x13, 0x71, 0x5a, 0x31, 0xa4, 0x84, 0xe3, 0x53, 0x30,
-		0x7e, 0x05, 0x5b, 0x9b, 0x86, 0xcd, 0x77, 0x89, 0xfa, 0x60, 0xf7, 0x49, 0xce, 0x6e, 0x8c, 0x13,
-		0xd2, 0x6d, 0x23, 0xc2, 0xeb, 0x09, 0x48, 0x6c, 0xa9, 0x15, 0xdc, 0x07, 0x8b, 0xeb, 0x9c, 0xc5,
-		0x10, 0xc1, 0x27, 0xa4, 0x79, 0x48, 0xd5, 0x0c, 0x3e, 0x47, 0xf5, 0x26, 0xff, 0x14, 0x27, 0x1f,
-		0x16, 0x34, 0x9c, 0x85, 0x69, 0x98, 0xcd, 0x3a, 0x99, 0xc0, 0x7a, 0xb3, 0xf8, 0x3b, 0x82, 0x85,
-		0xa0, 0xe1, 0x2c, 0xf6, 0xa1, 0xd6, 0x57, 0x04, 0x0b, 0xdb, 0xa7, 0xcf, 0x27, 0x61, 0xd8, 0xd0,
-		0xeb, 0x7b, 0x86, 0xde, 0x8f, 0x10, 0x9f, 0xe6, 0x1c, 0x80, 0x93, 0x10, 0x06, 0xe7, 0x21, 0xd9,
-		0xef, 0x40, 0xfc, 0xdd, 0x1f, 0x0a, 0xf7, 0x10, 0x23, 0x30, 0x0f, 0x63, 0x22, 0x40, 0x69, 0x86,
-		0xde, 0x07, 0x8b, 0xbf, 0xc7, 0x59, 0x8c, 0xfa, 0xc8, 0x78, 0x37, 0x1c, 0x6c, 0x3b, 0x35, 0xdc,
-		0x0f, 0x93, 0xcf, 0x8a, 0x6e, 0x70, 0x12, 0xae, 0xca, 0x4d, 0xac, 0x57, 0xb6, 0xfb, 0xe3, 0xf0,
-		0xab, 0x42, 0x95, 0x82, 0x86, 0xb0, 0x98, 0x83, 0x91, 0x86, 0x6a, 0xd9, 0xdb, 0x6a, 0xbd, 0xaf,
-		0xe1, 0xf8, 0xfb, 0x9c, 0x47, 0xca, 0x25, 0xe2, 0x1a, 0x69, 0xea, 0xfb, 0x61, 0xf3, 0x39, 0xa1,
-		0x11, 0x1f, 0x19, 0x77, 0x3d, 0xdb, 0xa1, 0x5b, 0x6a, 0xfb, 0xe1, 0xf6, 0x6b, 0xc2, 0xf5, 0x18,
-		0xed, 0x92, 0x9f, 0xe3, 0x79, 0x48, 0xda, 0xda, 0x8b, 0x7d, 0xb1, 0xf9, 0xbc, 0x18, 0x69, 0x4a,
-		0x40, 0x88, 0xdf, 0x06, 0x47, 0xdb, 0x4e, 0x13, 0x7d, 0x30, 0xfb, 0x07, 0x9c, 0xd9, 0xe1, 0x36,
-		0x53, 0x05, 0x0f, 0x09, 0xfb, 0x65, 0xf9, 0x0f, 0x45, 0x48, 0xc0, 0x21, 0x5e, 0xab, 0x64, 0xd5,
-		0x60, 0xab, 0x5b, 0xfb, 0xd3, 0xda, 0xaf, 0x0b, 0xad, 0x31, 0xda, 0x80, 0xd6, 0xd6, 0xe1, 0x30,
-		0xe7, 0xb8, 0xbf, 0x71, 0xfd, 0x82, 0x08, 0xac, 0x8c, 0x7a, 0x23, 0x38, 0xba, 0x6f, 0x87, 0xac,
-		0xab, 0x4e, 0x91, 0x9e, 0xda, 0xe5, 0x86, 0x6a, 0xf6, 0xc1, 0xf9, 0x8b, 0x9c, 0xb3, 0x88, 0xf8,
-		0x6e, 0x7e, 0x6b, 0x2f, 0xa9, 0x26, 0x61, 0xfe, 0x2c, 0x64, 0x04, 0xf3, 0xa6, 0x6e, 0xe1, 0x8a,
-		0x51, 0xd3, 0xb5, 0x17, 0x71, 0xb5, 0x0f, 0xd6, 0xbf, 0x11, 0x1a, 0xaa, 0x0d, 0x1f, 0x39, 0xe1,
-		0xbc, 0x00, 0x69, 0x37, 0x57, 0x29, 0x6b, 0x0d, 0xd3, 0xb0, 0x9c, 0x1e, 0x1c, 0xbf, 0x24, 0x46,
-		0xca, 0xa5, 0x5b, 0xa0, 0x64, 0xb9, 0x12, 0xb0, 0x73, 0xe6, 0x7e, 0x4d, 0xf2, 0xcb, 0x9c, 0xd1,
-		0x88, 0x47, 0xc5, 0x03, 0x47, 0xc5, 0x68, 0x98, 0xaa, 0xd5, 0x4f, 0xfc, 0xfb, 0x47, 0x22, 0x70,
-		0x70, 0x12, 0x1e, 0x38, 0x48, 0x46, 0x47, 0x66, 0xfb, 0x3e, 0x38, 0x7c, 0x45, 0x04, 0x0e, 0x41,
-		0xc3, 0x59, 0x88, 0x84, 0xa1, 0x0f, 0x16, 0xff, 0x58, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0xd3, 0xde,
-		0x44, 0x6b, 0xe1, 0x9a, 0x66, 0x3b, 0x16, 0x4b, 0x8a, 0xbb, 0xb3, 0xfa, 0x27, 0x3f, 0x0c, 0x26,
-		0x61, 0x8a, 0x8f, 0x94, 0x44, 0x22, 0xbe, 0xc9, 0x4a, 0xd7, 0x4c, 0xbd, 0x05, 0xfb, 0x4d, 0x11,
-		0x89, 0x7c, 0x64, 0x44, 0x36, 0x5f, 0x86, 0x48, 0xd4, 0x5e, 0x21, 0x2b, 0x85, 0x3e, 0xd8, 0xfd,
-		0xd3, 0x90, 0x70, 0x6b, 0x82, 0x96, 0xf0, 0xf4, 0xe5, 0x3f, 0x4d, 0x7d, 0x07, 0xef, 0xf5, 0x65,
-		0x9d, 0xff, 0x2c, 0x94, 0xff, 0x6c, 0x30, 0x4a, 0x16, 0x43, 0xc6, 0x42, 0xf9, 0x14, 0xea, 0x75,
-		0xab, 0x28, 0xf3, 0x9e, 0x1f, 0xf3, 0xfe, 0x06, 0xd3, 0xa9, 0xdc, 0x22, 0x31, 0xf2, 0x60, 0xd2,
-		0xd3, 0x9b, 0xd9, 0xfb, 0x7e, 0xec, 0xda, 0x79, 0x20, 0xe7, 0xc9, 0x5d, 0x80, 0x91, 0x40, 0xc2,
-		0xd3, 0x9b, 0xd5, 0xfb, 0x39, 0xab, 0x94, 0x3f, 0xdf, 0xc9, 0x9d, 0x85, 0x18, 0x49, 0x5e, 0x7a,
-		0x93, 0xff, 0x35, 0x4e, 0x4e, 0xd1, 0x73, 0x6f, 0x86, 0x84, 0x48, 0x5a, 0x7a, 0x93, 0x7e, 0x80,
-		0x93, 0xba, 0x24, 0x84, 0x5c, 0x24, 0x2c, 0xbd, 0xc9, 0xff, 0xba, 0x20, 0x17, 0x24, 0x84, 0xbc,
-		0x7f, 0x15, 0x7e, 0xf5, 0xe7, 0x63, 0x7c, 0xd2, 0x11, 0xba, 0x3b, 0x0f, 0x43, 0x3c, 0x53, 0xe9,
-		0x4d, 0xfd, 0x21, 0xde, 0xb8, 0xa0, 0xc8, 0x3d, 0x06, 0xf1, 0x3e, 0x15, 0xfe, 0x0b, 0x9c, 0x94,
-		0xe1, 0xe7, 0xe6, 0x60, 0xd8, 0x97, 0x9d, 0xf4, 0x26, 0xff, 0x1b, 0x9c, 0xdc, 0x4f, 0x45, 0x44,
-		0xe7, 0xd9, 0x49, 0x6f, 0x06, 0xbf, 0x28, 0x44, 0xe7, 0x14, 0x44, 0x6d, 0x22, 0x31, 0xe9, 0x4d,
-		0xfd, 0x61, 0xa1, 0x75, 0x41, 0x92, 0x7b, 0x12, 0x92, 0xee, 0x64, 0xd3, 0x9b, 0xfe, 0x23, 0x9c,
-		0xde, 0xa3, 0x21, 0x1a, 0xf0, 0x4d, 0x76, 0xbd, 0x59, 0xfc, 0x4d, 0xa1, 0x01, 0x1f, 0x15, 0x71,
-		0xa3, 0x70, 0x02, 0xd3, 0x9b, 0xd3, 0x47, 0x85, 0x1b, 0x85, 0xf2, 0x17, 0x32, 0x9a, 0x34, 0xe6,
-		0xf7, 0x66, 0xf1, 0xb7, 0xc4, 0x68, 0x52, 0x7c, 0x22, 0x46, 0x38, 0x23, 0xe8, 0xcd, 0xe3, 0x97,
-		0x84, 0x18, 0xa1, 0x84, 0x20, 0xb7, 0x0a, 0xa8, 0x35, 0x1b, 0xe8, 0xcd, 0xef, 0x63, 0x9c, 0xdf,
-		0x78, 0x4b, 0x32, 0x90, 0x7b, 0x06, 0x0e, 0xb7, 0xcf, 0x04, 0x7a, 0x73, 0xfd, 0xf8, 0x8f, 0x43,
-		0x6b, 0x37, 0x7f, 0x22, 0x90, 0x5b, 0xf7, 0xa6, 0x14, 0x7f, 0x16, 0xd0, 0x9b, 0xed, 0xcb, 0x3f,
-		0x0e, 0x06, 0x6e, 0x7f, 0x12, 0x90, 0xcb, 0x03, 0x78, 0x13, 0x70, 0x6f, 0x5e, 0x9f, 0xe4, 0xbc,
-		0x7c, 0x44, 0xc4, 0x35, 0xf8, 0xfc, 0xdb, 0x9b, 0xfe, 0xba, 0x70, 0x0d, 0x4e, 0x41, 0x5c, 0x43,
-		0x4c, 0xbd, 0xbd, 0xa9, 0x3f, 0x25, 0x5c, 0x43, 0x90, 0x10, 0xcb, 0xf6, 0xcd, 0x6e, 0xbd, 0x39,
-		0x7c, 0x5a, 0x58, 0xb6, 0x8f, 0x2a, 0xb7, 0x0c, 0xe3, 0x2d, 0x13, 0x62, 0x6f, 0x56, 0xbf, 0xcc,
-		0x59, 0xa5, 0xc3, 0xf3, 0xa1, 0x7f, 0xf2, 0xe2, 0x93, 0x61, 0x6f, 0x6e, 0x9f, 0x09, 0x4d, 0x5e,
-		0x7c, 0x2e, 0xcc, 0x9d, 0x87, 0x84, 0xde, 0xac, 0xd7, 0x89, 0xf3, 0xa0, 0xee, 0x37, 0x01, 0x33,
-		0x7f, 0xfc, 0x13, 0xae, 0x1d, 0x41, 0x90, 0x3b, 0x0b, 0x71, 0xdc, 0xd8, 0xc4, 0xd5, 0x5e, 0x94,
-		0x3f, 0xf8, 0x89, 0x08, 0x98, 0x04, 0x3b, 0xf7, 0x24, 0x00, 0xdb, 0x1a, 0xa1, 0x87, 0x81, 0x3d,
-		0x68, 0xff, 0xeb, 0x4f, 0xf8, 0xd5, 0x1b, 0x8f, 0xc4, 0x63, 0xc0, 0x2e, 0xf2, 0x74, 0x67, 0xf0,
-		0xc3, 0x20, 0x03, 0x3a, 0x22, 0x4f, 0xc0, 0xd0, 0xf3, 0xb6, 0xa1, 0x3b, 0x6a, 0xad, 0x17, 0xf5,
-		0x7f, 0xe3, 0xd4, 0x02, 0x9f, 0x28, 0xac, 0x61, 0x58, 0xd8, 0x51, 0x6b, 0x76, 0x2f, 0xda, 0xff,
-		0xce, 0x69, 0x5d, 0x02, 0x42, 0x5c, 0x51, 0x6d, 0xa7, 0x9f, 0x7e, 0xff, 0x89, 0x20, 0x16, 0x04,
-		0x44, 0x68, 0xf2, 0x7b, 0x07, 0xef, 0xf5, 0xa2, 0xfd, 0x91, 0x10, 0x9a, 0xe3, 0xe7, 0xde, 0x0c,
-		0x49, 0xf2, 0x93, 0xdd, 0xa7, 0xeb, 0x41, 0xfc, 0xa7, 0x9c, 0xd8, 0xa3, 0x20, 0x2d, 0xdb, 0x4e,
-		0xd5, 0xd1, 0x7a, 0x2b, 0xfb, 0x26, 0x1f, 0x69, 0x81, 0x9f, 0xcb, 0xc3, 0xb0, 0xed, 0x54, 0xab,
-		0x4d, 0x9e, 0x9f, 0xf6, 0x20, 0xff, 0x1f, 0x3f, 0x71, 0xb7, 0x2c, 0x5c, 0x1a, 0x32, 0xda, 0x57,
-		0x77, 0x1c, 0xd3, 0xa0, 0x07, 0x1e, 0xbd, 0x38, 0xfc, 0x98, 0x73, 0xf0, 0x91, 0xe4, 0xe6, 0x20,
-		0x45, 0xfa, 0x62, 0x61, 0x13, 0xd3, 0xd3, 0xa9, 0x1e, 0x2c, 0xfe, 0x27, 0x57, 0x40, 0x80, 0xa8,
-		0xf0, 0x73, 0x5f, 0x7f, 0x75, 0x4a, 0xfa, 0xd6, 0xab, 0x53, 0xd2, 0x1f, 0xbd, 0x3a, 0x25, 0x7d,
-		0xf8, 0xbb, 0x53, 0x03, 0xdf, 0xfa, 0xee, 0xd4, 0xc0, 0xef, 0x7f, 0x77, 0x6a, 0xa0, 0xfd, 0x2e,
-		0x31, 0xcc, 0x1b, 0xf3, 0x06, 0xdb, 0x1f, 0x7e, 0x4e, 0xae, 0x69, 0xce, 0x76, 0x73, 0x73, 0xb6,
-		0x62, 0x34, 0xe8, 0x36, 0xae, 0xb7, 0x5b, 0xeb, 0x2e, 0x72, 0xe0, 0xbd, 0x51, 0x38, 0x5a, 0x31,
-		0xec, 0x86, 0x61, 0x97, 0xd9, 0x7e, 0x2f, 0x2b, 0xf0, 0x1d, 0xdf, 0x94, 0xbf, 0xaa, 0x8f, 0x4d,
-		0xdf, 0x8b, 0x30, 0x4a, 0xbb, 0x4e, 0xb7, 0xbb, 0xa8, 0xb5, 0xf5, 0x0c, 0x10, 0xdf, 0xf8, 0xf7,
-		0x71, 0xda, 0xeb, 0x11, 0x97, 0x90, 0x9e, 0xde, 0xaf, 0xc3, 0xa4, 0xd6, 0x30, 0xeb, 0x98, 0x6e,
-		0xf3, 0x97, 0xdd, 0xba, 0xde, 0xfc, 0xbe, 0xc9, 0xf9, 0x4d, 0x78, 0xe4, 0x0b, 0x82, 0x3a, 0xb7,
-		0x08, 0xe3, 0x6a, 0xa5, 0x82, 0xcd, 0x00, 0xcb, 0x1e, 0xc3, 0x22, 0x04, 0x4c, 0x73, 0x4a, 0x97,
-		0x5b, 0xe1, 0xc9, 0x4e, 0x43, 0xf3, 0xdc, 0xdd, 0x3e, 0xcd, 0x5b, 0xb8, 0x86, 0xf5, 0x07, 0x75,
-		0xec, 0x5c, 0x35, 0xac, 0x1d, 0xae, 0xde, 0x07, 0x59, 0x53, 0x83, 0xec, 0x06, 0x33, 0xbc, 0x3f,
-		0x0a, 0x53, 0xac, 0xe2, 0xf4, 0xa6, 0x6a, 0xe3, 0xd3, 0x57, 0x1e, 0xde, 0xc4, 0x8e, 0xfa, 0xf0,
-		0xe9, 0x8a, 0xa1, 0xe9, 0x7c, 0x24, 0x26, 0xf8, 0xb8, 0x90, 0xfa, 0x59, 0x5e, 0x9f, 0x6d, 0xbb,
-		0x4d, 0x2f, 0xcf, 0x43, 0x6c, 0xce, 0xd0, 0x74, 0x34, 0x09, 0xf1, 0x2a, 0xd6, 0x8d, 0x06, 0xbf,
-		0x73, 0xc7, 0x0a, 0xe8, 0x4e, 0x18, 0x54, 0x1b, 0x46, 0x53, 0x77, 0xd8, 0x09, 0x45, 0x61, 0xf8,
-		0xeb, 0x37, 0xa6, 0x07, 0xfe, 0xe0, 0xc6, 0x74, 0x74, 0x41, 0x77, 0x14, 0x5e, 0x95, 0x8b, 0xbd,
-		0xf6, 0xca, 0xb4, 0x24, 0x3f, 0x05, 0x43, 0x45, 0x5c, 0x39, 0x08, 0xaf, 0x22, 0xae, 0x84, 0x78,
-		0xdd, 0x07, 0x89, 0x05, 0xdd, 0x61, 0xb7, 0x22, 0x4f, 0x40, 0x54, 0xd3, 0xd9, 0x45, 0x9b, 0x50,
-		0xfb, 0x04, 0x4e, 0x50, 0x8b, 0xb8, 0xe2, 0xa2, 0x56, 0x71, 0x25, 0x8c, 0x4a, 0xd8, 0x13, 0x78,
-		0xa1, 0xf8, 0xfb, 0xff, 0x79, 0x6a, 0xe0, 0xa5, 0x57, 0xa7, 0x06, 0x3a, 0x8e, 0x84, 0xdf, 0x07,
-		0xb8, 0x8a, 0xf9, 0x10, 0xd8, 0xd5, 0x1d, 0x76, 0x46, 0xe2, 0x0e, 0xc3, 0xef, 0x0c, 0x82, 0xcc,
-		0x71, 0x6c, 0x47, 0xdd, 0xd1, 0xf4, 0x9a, 0x3b, 0x12, 0x6a, 0xd3, 0xd9, 0x7e, 0x91, 0x0f, 0xc5,
-		0x61, 0x3e, 0x14, 0x1c, 0xa7, 0xfb, 0x68, 0x64, 0x3b, 0x7b, 0x57, 0xb6, 0xc7, 0x98, 0xcb, 0xff,
-		0x26, 0x0a, 0x68, 0xcd, 0x51, 0x77, 0x70, 0xbe, 0xe9, 0x6c, 0x1b, 0x96, 0xf6, 0x22, 0x8b, 0x65,
-		0x18, 0xa0, 0xa1, 0xee, 0x96, 0x1d, 0x63, 0x07, 0xeb, 0x36, 0x55, 0xcd, 0xf0, 0x99, 0xa3, 0xb3,
-		0x6d, 0xec, 0x63, 0x96, 0x0c, 0x5d, 0xe1, 0xfe, 0xcf, 0x7d, 0x67, 0xfa, 0xde, 0xde, 0x5a, 0xa0,
-		0xc8, 0x24, 0xb9, 0xde, 0x5d, 0xa7, 0x8c, 0xd1, 0x65, 0x60, 0x97, 0x2c, 0xca, 0x75, 0xcd, 0x76,
-		0xf8, 0x3d, 0xed, 0xb3, 0xb3, 0xed, 0xfb, 0x3e, 0xdb, 0x2a, 0xe6, 0xec, 0x65, 0xb5, 0xae, 0x55,
-		0x55, 0xc7, 0xb0, 0xec, 0x8b, 0x03, 0x4a, 0x92, 0xb2, 0x5a, 0xd4, 0x6c, 0x07, 0xad, 0x43, 0xb2,
-		0x8a, 0xf5, 0x3d, 0xc6, 0x36, 0xfa, 0xfa, 0xd8, 0x26, 0x08, 0x27, 0xca, 0xf5, 0x59, 0x40, 0xaa,
-		0x1f, 0x4f, 0x3c, 0x4c, 0x62, 0xf7, 0x2b, 0x3b, 0xb0, 0x0f, 0x70, 0xa6, 0xef, 0x28, 0xc6, 0xd5,
-		0x30, 0x28, 0x7b, 0x0f, 0x80, 0xd7, 0x26, 0xca, 0xc0, 0x90, 0x5a, 0xad, 0x5a, 0xd8, 0xb6, 0xe9,
-		0x01, 0x60, 0x52, 0x11, 0xc5, 0xdc, 0xf8, 0xbf, 0xfd, 0xf2, 0x83, 0x23, 0x01, 0x8e, 0x85, 0x14,
-		0xc0, 0x15, 0x97, 0xf4, 0xd4, 0xa7, 0x24, 0x18, 0x6f, 0x69, 0x11, 0xc9, 0x30, 0x95, 0xdf, 0x58,
-		0xbf, 0xb8, 0xa2, 0x2c, 0x3c, 0x97, 0x5f, 0x5f, 0x58, 0x59, 0x2e, 0xb3, 0x2b, 0xff, 0xcb, 0x6b,
-		0xab, 0xa5, 0xb9, 0x85, 0x0b, 0x0b, 0xa5, 0x62, 0x7a, 0x00, 0x4d, 0xc3, 0xb1, 0x36, 0x38, 0xc5,
-		0xd2, 0x62, 0x69, 0x3e, 0xbf, 0x5e, 0x4a, 0x4b, 0xe8, 0x0e, 0x38, 0xd1, 0x96, 0x89, 0x8b, 0x12,
-		0xe9, 0x80, 0xa2, 0x94, 0x5c, 0x94, 0x68, 0xe1, 0x42, 0x47, 0x2f, 0x7a, 0xa0, 0xab, 0xfd, 0xec,
-		0xba, 0xee, 0x12, 0xf4, 0xa7, 0xf7, 0x44, 0xe0, 0x68, 0x78, 0xca, 0x50, 0xf5, 0xbd, 0x0e, 0xaf,
-		0x3e, 0x3b, 0x44, 0xb3, 0x8b, 0x10, 0xcd, 0xeb, 0x7b, 0xe8, 0x28, 0xcb, 0xa7, 0xcb, 0x4d, 0xab,
-		0xce, 0x63, 0xd0, 0x10, 0x29, 0x6f, 0x58, 0x75, 0x12, 0x9b, 0xc4, 0x45, 0x7f, 0xe9, 0x64, 0x8a,
-		0xdf, 0xde, 0xcf, 0xa5, 0x3f, 0xf6, 0xca, 0xf4, 0xc0, 0x17, 0x5e, 0x99, 0x1e, 0xf8, 0xd1, 0xa7,
-		0xa7, 0x07, 0x5e, 0xfa, 0xc3, 0x99, 0x81, 0xc2, 0x4e, 0xb8, 0x7b, 0x5f, 0xed, 0x39, 0x9b, 0x26,
-		0xf2, 0xfa, 0x1e, 0x0d, 0x44, 0xab, 0xd2, 0x73, 0x71, 0xda, 0x39, 0x71, 0x80, 0x3a, 0x15, 0x3e,
-		0x40, 0x7d, 0x06, 0xd7, 0xeb, 0x97, 0x74, 0xe3, 0x2a, 0x1d, 0x55, 0x4f, 0x07, 0x1f, 0x8d, 0xc0,
-		0x54, 0xcb, 0xb4, 0xc9, 0x33, 0x8c, 0x4e, 0xcf, 0x5f, 0x73, 0x90, 0x28, 0x8a, 0xc4, 0x25, 0x03,
-		0x43, 0x36, 0xae, 0x18, 0x7a, 0x95, 0x79, 0x7a, 0x54, 0x11, 0x45, 0xd2, 0x6d, 0x5d, 0xd5, 0x0d,
-		0x9b, 0xdf, 0xb9, 0x67, 0x85, 0xc2, 0x27, 0xa4, 0xfd, 0xe5, 0x0b, 0x23, 0xa2, 0x25, 0xd1, 0xcd,
-		0x87, 0x7b, 0x1e, 0x29, 0xef, 0x90, 0x5e, 0xba, 0x9d, 0x08, 0x1c, 0x2b, 0xf7, 0xab, 0x95, 0x5f,
-		0x8a, 0xc0, 0x74, 0x58, 0x2b, 0x24, 0x6d, 0xb3, 0x1d, 0xb5, 0x61, 0x76, 0x52, 0xcb, 0x79, 0x48,
-		0xae, 0x0b, 0x9c, 0x7d, 0xeb, 0xe5, 0xfa, 0x3e, 0xf5, 0x32, 0xea, 0x36, 0x25, 0x14, 0x73, 0xa6,
-		0x4f, 0xc5, 0xb8, 0xfd, 0x38, 0x90, 0x66, 0x3e, 0x17, 0x83, 0x13, 0xf4, 0x51, 0x96, 0xd5, 0xd0,
-		0x74, 0xe7, 0x74, 0xc5, 0xda, 0x33, 0x1d, 0x9a, 0xb8, 0x19, 0x5b, 0x5c, 0x2f, 0xe3, 0x5e, 0xf5,
-		0x2c, 0xab, 0xee, 0xe0, 0x39, 0x5b, 0x10, 0x5f, 0x25, 0x74, 0x44, 0x23, 0x8e, 0xe1, 0xa8, 0x75,
-		0xae, 0x29, 0x56, 0x20, 0x50, 0xf6, 0x90, 0x2b, 0xc2, 0xa0, 0x9a, 0x78, 0xc3, 0x55, 0xc7, 0xea,
-		0x16, 0xbb, 0x0f, 0x1f, 0xa5, 0x0e, 0x95, 0x20, 0x00, 0x7a, 0xf5, 0x7d, 0x12, 0xe2, 0x6a, 0x93,
-		0x5d, 0xe5, 0x88, 0x12, 0x4f, 0xa3, 0x05, 0xf9, 0x12, 0x0c, 0xf1, 0x03, 0x65, 0x94, 0x86, 0xe8,
-		0x0e, 0xde, 0xa3, 0xed, 0xa4, 0x14, 0xf2, 0x13, 0xcd, 0x42, 0x9c, 0x0a, 0xcf, 0x27, 0x90, 0xcc,
-		0x6c, 0x8b, 0xf4, 0xb3, 0x54, 0x48, 0x85, 0xa1, 0xc9, 0x4f, 0x41, 0xa2, 0x68, 0x34, 0x34, 0xdd,
-		0x08, 0x72, 0x4b, 0x32, 0x6e, 0x54, 0x66, 0xb3, 0xc9, 0xf3, 0x0d, 0x85, 0x15, 0xd0, 0x61, 0x18,
-		0x64, 0xef, 0x23, 0xf8, 0x75, 0x14, 0x5e, 0x92, 0xe7, 0x60, 0x88, 0xf2, 0x5e, 0x31, 0x11, 0xe2,
-		0x2f, 0xeb, 0xf8, 0x43, 0x0c, 0x9a, 0x9a, 0x72, 0xf6, 0x11, 0x4f, 0x58, 0x04, 0xb1, 0xaa, 0xea,
-		0xa8, 0xbc, 0xdf, 0xf4, 0xb7, 0xfc, 0x16, 0x48, 0x70, 0x26, 0x36, 0x3a, 0x03, 0x51, 0xc3, 0xb4,
-		0xf9, 0x85, 0x92, 0x6c, 0xa7, 0xae, 0xac, 0x98, 0x85, 0x18, 0xc9, 0x54, 0x14, 0x82, 0x5c, 0x50,
-		0x3a, 0x06, 0xd5, 0xc7, 0x7d, 0x41, 0xd5, 0x37, 0xe4, 0xbe, 0x9f, 0x6c, 0x48, 0x5b, 0xcc, 0xc1,
-		0x35, 0x96, 0x4f, 0x47, 0x60, 0xca, 0x57, 0x7b, 0x05, 0x5b, 0xb6, 0x66, 0xe8, 0x7c, 0x3e, 0x67,
-		0xd6, 0x82, 0x7c, 0x42, 0xf2, 0xfa, 0x0e, 0xe6, 0xf2, 0x66, 0x88, 0xe6, 0x4d, 0x13, 0x65, 0x21,
-		0x41, 0xcb, 0x15, 0x83, 0xd9, 0x4b, 0x4c, 0x71, 0xcb, 0xa4, 0xce, 0x36, 0xb6, 0x9c, 0xab, 0xaa,
-		0xe5, 0x3e, 0x21, 0x14, 0x65, 0xf9, 0x09, 0x48, 0xce, 0x19, 0xba, 0x8d, 0x75, 0xbb, 0x49, 0x7d,
-		0x70, 0xb3, 0x6e, 0x54, 0x76, 0x38, 0x07, 0x56, 0x20, 0x0a, 0x57, 0x4d, 0x93, 0x52, 0xc6, 0x14,
-		0xf2, 0x93, 0xe5, 0x86, 0x85, 0xb5, 0x8e, 0x2a, 0x7a, 0x62, 0xff, 0x2a, 0xe2, 0x9d, 0x74, 0x75,
-		0xf4, 0x7f, 0x24, 0x38, 0xde, 0xea, 0x50, 0x3b, 0x78, 0xcf, 0xde, 0xaf, 0x3f, 0x3d, 0x0b, 0xc9,
-		0x55, 0xfa, 0x8e, 0xff, 0x12, 0xde, 0x43, 0x59, 0x18, 0xc2, 0xd5, 0x33, 0x67, 0xcf, 0x3e, 0xfc,
-		0x04, 0xb3, 0xf6, 0x8b, 0x03, 0x8a, 0x00, 0xa0, 0x29, 0x48, 0xda, 0xb8, 0x62, 0x9e, 0x39, 0x7b,
-		0x6e, 0xe7, 0x61, 0x66, 0x5e, 0x24, 0x03, 0x72, 0x41, 0xb9, 0x04, 0xe9, 0xf5, 0x6b, 0x9f, 0x9e,
-		0x96, 0x0a, 0x71, 0x88, 0xda, 0xcd, 0xc6, 0x6d, 0xb5, 0x91, 0x97, 0xe3, 0x30, 0xe3, 0xa7, 0xa4,
-		0x91, 0xca, 0xcd, 0x4a, 0xb8, 0x0e, 0xd2, 0x3e, 0x1d, 0x50, 0x8c, 0x0e, 0xc9, 0x6c, 0x57, 0x4d,
-		0xca, 0xbf, 0x21, 0x41, 0xca, 0x4d, 0x95, 0xd6, 0xb0, 0x83, 0xce, 0xfb, 0xf3, 0x1f, 0xee, 0x36,
-		0xc7, 0x66, 0xc3, 0x6d, 0x79, 0x29, 0x9d, 0xe2, 0x43, 0x47, 0x8f, 0x51, 0x43, 0x34, 0x0d, 0x9b,
-		0x3f, 0x2b, 0xeb, 0x41, 0xea, 0x22, 0xa3, 0x07, 0x00, 0xd1, 0x08, 0x57, 0xbe, 0x62, 0x38, 0x9a,
-		0x5e, 0x2b, 0x9b, 0xc6, 0x55, 0xfe, 0x58, 0x37, 0xaa, 0xa4, 0x69, 0xcd, 0x65, 0x5a, 0xb1, 0x4a,
-		0xe0, 0x44, 0xe8, 0xa4, 0xcb, 0x25, 0x98, 0xde, 0x91, 0x20, 0x20, 0x8a, 0xe8, 0x3c, 0x0c, 0x99,
-		0xcd, 0xcd, 0xb2, 0x88, 0x18, 0xc3, 0x67, 0x8e, 0xb7, 0xf3, 0x7f, 0x61, 0x1f, 0x3c, 0x02, 0x0c,
-		0x9a, 0xcd, 0x4d, 0x62, 0x2d, 0x77, 0x40, 0xaa, 0x8d, 0x30, 0xc3, 0x57, 0x3c, 0x39, 0xe8, 0xe7,
-		0x23, 0x78, 0x0f, 0xca, 0xa6, 0xa5, 0x19, 0x96, 0xe6, 0xec, 0xd1, 0xfc, 0x35, 0xaa, 0xa4, 0x45,
-		0xc5, 0x2a, 0x87, 0xcb, 0x3b, 0x30, 0xb6, 0x46, 0xd7, 0xb7, 0x9e, 0xe4, 0x67, 0x3d, 0xf9, 0xa4,
-		0xde, 0xf2, 0x75, 0x94, 0x2c, 0xd2, 0x22, 0x59, 0xe1, 0xe9, 0x8e, 0xd6, 0xf9, 0xd8, 0xfe, 0xad,
-		0x33, 0x98, 0x21, 0xfe, 0xc9, 0xd1, 0x80, 0x73, 0x32, 0xe3, 0xf4, 0x87, 0xaf, 0x7e, 0x0d, 0xb3,
-		0x57, 0x36, 0x91, 0xed, 0x3e, 0xa9, 0x66, 0x7b, 0x84, 0xd1, 0x6c, 0x4f, 0x17, 0x92, 0x9f, 0x80,
-		0x91, 0x55, 0xd5, 0x72, 0xd6, 0xb0, 0x73, 0x11, 0xab, 0x55, 0x6c, 0x05, 0x67, 0xdd, 0x11, 0x31,
-		0xeb, 0x22, 0x88, 0xd1, 0xa9, 0x95, 0xcd, 0x3a, 0xf4, 0xb7, 0xbc, 0x0d, 0x31, 0x7a, 0x33, 0xd4,
-		0x9d, 0x91, 0x39, 0x05, 0x9b, 0x91, 0x49, 0x2c, 0xdd, 0x73, 0xb0, 0x2d, 0xd2, 0x5b, 0x5a, 0x40,
-		0x8f, 0x8a, 0x79, 0x35, 0xda, 0x7d, 0x5e, 0xe5, 0x86, 0xc8, 0x67, 0xd7, 0x3a, 0x0c, 0x15, 0x48,
-		0x28, 0x5e, 0x28, 0xba, 0x82, 0x48, 0x9e, 0x20, 0x68, 0x09, 0xc6, 0x4c, 0xd5, 0x72, 0xe8, 0x93,
-		0x98, 0x6d, 0xda, 0x0b, 0x6e, 0xeb, 0xd3, 0xad, 0x9e, 0x17, 0xe8, 0x2c, 0x6f, 0x65, 0xc4, 0xf4,
-		0x03, 0xe5, 0xef, 0xc7, 0x60, 0x90, 0x2b, 0xe3, 0xcd, 0x30, 0xc4, 0xd5, 0xca, 0xad, 0xf3, 0xc4,
-		0x6c, 0xeb, 0xc4, 0x34, 0xeb, 0x4e, 0x20, 0x9c, 0x9f, 0xa0, 0x41, 0xf7, 0x40, 0xa2, 0xb2, 0xad,
-		0x6a, 0x7a, 0x59, 0xab, 0x8a, 0xad, 0x86, 0x57, 0x6f, 0x4c, 0x0f, 0xcd, 0x11, 0xd8, 0x42, 0x51,
-		0x19, 0xa2, 0x95, 0x0b, 0x55, 0x92, 0x09, 0x6c, 0x63, 0xad, 0xb6, 0xed, 0x70, 0x0f, 0xe3, 0x25,
-		0xf4, 0x38, 0xc4, 0x88, 0x41, 0xf0, 0x07, 0x93, 0xd9, 0x96, 0x0d, 0x1f, 0x37, 0xd9, 0x2b, 0x24,
-		0x48, 0xc3, 0x1f, 0xfe, 0xce, 0xb4, 0xa4, 0x50, 0x0a, 0x34, 0x07, 0x23, 0x75, 0xd5, 0x76, 0xca,
-		0x74, 0x06, 0x23, 0xcd, 0xc7, 0xf9, 0x7a, 0xbb, 0x45, 0x21, 0x5c, 0xb1, 0x5c, 0xf4, 0x61, 0x42,
-		0xc5, 0x40, 0x55, 0x74, 0x12, 0xd2, 0x94, 0x49, 0xc5, 0x68, 0x34, 0x34, 0x87, 0xe5, 0x56, 0x83,
-		0x54, 0xef, 0xa3, 0x04, 0x3e, 0x47, 0xc1, 0x34, 0xc3, 0x3a, 0x06, 0x49, 0xfa, 0x44, 0x8b, 0xa2,
-		0xb0, 0xeb, 0xc8, 0x09, 0x02, 0xa0, 0x95, 0xf7, 0xc2, 0x98, 0x17, 0x1f, 0x19, 0x4a, 0x82, 0x71,
-		0xf1, 0xc0, 0x14, 0xf1, 0x21, 0x98, 0xd4, 0xf1, 0x2e, 0xbd, 0x20, 0x1d, 0xc0, 0x4e, 0x52, 0x6c,
-		0x44, 0xea, 0x2e, 0x07, 0x29, 0xee, 0x86, 0xd1, 0x8a, 0x50, 0x3e, 0xc3, 0x05, 0x8a, 0x3b, 0xe2,
-		0x42, 0x29, 0xda, 0x51, 0x48, 0xa8, 0xa6, 0xc9, 0x10, 0x86, 0x79, 0x7c, 0x34, 0x4d, 0x5a, 0x75,
-		0x0a, 0xc6, 0x69, 0x1f, 0x2d, 0x6c, 0x37, 0xeb, 0x0e, 0x67, 0x92, 0xa2, 0x38, 0x63, 0xa4, 0x42,
-		0x61, 0x70, 0x8a, 0x7b, 0x27, 0x8c, 0xe0, 0x2b, 0x5a, 0x15, 0xeb, 0x15, 0xcc, 0xf0, 0x46, 0x28,
-		0x5e, 0x4a, 0x00, 0x29, 0xd2, 0x7d, 0xe0, 0xc6, 0xbd, 0xb2, 0x88, 0xc9, 0xa3, 0x8c, 0x9f, 0x80,
-		0xe7, 0x19, 0x58, 0xce, 0x40, 0xac, 0xa8, 0x3a, 0x2a, 0x49, 0x30, 0x9c, 0x5d, 0x36, 0xd1, 0xa4,
-		0x14, 0xf2, 0x53, 0x7e, 0x2d, 0x02, 0xb1, 0xcb, 0x86, 0x83, 0xd1, 0x23, 0xbe, 0x04, 0x70, 0xb4,
-		0x9d, 0x3d, 0xaf, 0x69, 0x35, 0x1d, 0x57, 0x97, 0xec, 0x9a, 0xef, 0x7b, 0x0a, 0x9e, 0x39, 0x45,
-		0x02, 0xe6, 0x34, 0x09, 0x71, 0xcb, 0x68, 0xea, 0x55, 0x71, 0x93, 0x97, 0x16, 0x50, 0x09, 0x12,
-		0xae, 0x95, 0xc4, 0x7a, 0x59, 0xc9, 0x18, 0xb1, 0x12, 0x62, 0xc3, 0x1c, 0xa0, 0x0c, 0x6d, 0x72,
-		0x63, 0x29, 0x40, 0xd2, 0x0d, 0x5e, 0xdc, 0xda, 0xfa, 0x33, 0x58, 0x8f, 0x8c, 0x4c, 0x26, 0xee,
-		0xd8, 0xbb, 0xca, 0x63, 0x16, 0x97, 0x76, 0x2b, 0xb8, 0xf6, 0x02, 0x66, 0xc5, 0xbf, 0xed, 0x30,
-		0x44, 0xfb, 0xe5, 0x99, 0x15, 0xfb, 0xbe, 0xc3, 0x71, 0x48, 0xda, 0x5a, 0x4d, 0x57, 0x9d, 0xa6,
-		0x85, 0xb9, 0xe5, 0x79, 0x00, 0xf9, 0xab, 0x12, 0x0c, 0x32, 0x4b, 0xf6, 0xe9, 0x4d, 0x6a, 0xaf,
-		0xb7, 0x48, 0x27, 0xbd, 0x45, 0x0f, 0xae, 0xb7, 0x3c, 0x80, 0x2b, 0x8c, 0xcd, 0x9f, 0xdc, 0xb7,
-		0xc9, 0x18, 0x98, 0x88, 0x6b, 0x5a, 0x8d, 0x3b, 0xaa, 0x8f, 0x48, 0xfe, 0x4f, 0x12, 0x49, 0x62,
-		0x79, 0x3d, 0xca, 0xc3, 0x88, 0x90, 0xab, 0xbc, 0x55, 0x57, 0x6b, 0xdc, 0x76, 0x4e, 0x74, 0x14,
-		0xee, 0x42, 0x5d, 0xad, 0x29, 0xc3, 0x5c, 0x1e, 0x52, 0x68, 0x3f, 0x0e, 0x91, 0x0e, 0xe3, 0x10,
-		0x18, 0xf8, 0xe8, 0xc1, 0x06, 0x3e, 0x30, 0x44, 0xb1, 0xf0, 0x10, 0x7d, 0x29, 0x42, 0x17, 0x33,
-		0xa6, 0x61, 0xab, 0xf5, 0x9f, 0x86, 0x47, 0x1c, 0x83, 0xa4, 0x69, 0xd4, 0xcb, 0xac, 0x86, 0xdd,
-		0x70, 0x4f, 0x98, 0x46, 0x5d, 0x69, 0x19, 0xf6, 0xf8, 0x2d, 0x72, 0x97, 0xc1, 0x5b, 0xa0, 0xb5,
-		0xa1, 0xb0, 0xd6, 0x2c, 0x48, 0x31, 0x55, 0xf0, 0xb9, 0xec, 0x21, 0xa2, 0x03, 0x3a, 0x39, 0x4a,
-		0xad, 0x73, 0x2f, 0x13, 0x9b, 0x61, 0x2a, 0x1c, 0x8f, 0x50, 0xb0, 0xd0, 0xdf, 0x6e, 0x15, 0xec,
-		0x37, 0x4b, 0x85, 0xe3, 0xc9, 0x7f, 0x5b, 0x02, 0x58, 0x24, 0x9a, 0xa5, 0xfd, 0x25, 0xb3, 0x90,
-		0x4d, 0x45, 0x28, 0x07, 0x5a, 0x9e, 0xea, 0x34, 0x68, 0xbc, 0xfd, 0x94, 0xed, 0x97, 0x7b, 0x0e,
-		0x46, 0x3c, 0x63, 0xb4, 0xb1, 0x10, 0x66, 0xaa, 0x4b, 0x56, 0xbd, 0x86, 0x1d, 0x25, 0x75, 0xc5,
-		0x57, 0x92, 0xff, 0xa5, 0x04, 0x49, 0x2a, 0xd3, 0x12, 0x76, 0xd4, 0xc0, 0x18, 0x4a, 0x07, 0x1f,
-		0xc3, 0x13, 0x00, 0x8c, 0x8d, 0xad, 0xbd, 0x88, 0xb9, 0x65, 0x25, 0x29, 0x64, 0x4d, 0x7b, 0x11,
-		0xa3, 0x73, 0xae, 0xc2, 0xa3, 0xdd, 0x15, 0x2e, 0xb2, 0x6e, 0xae, 0xf6, 0x23, 0x30, 0x44, 0x3f,
-		0x51, 0xb5, 0x6b, 0xf3, 0x44, 0x7a, 0x50, 0x6f, 0x36, 0xd6, 0x77, 0x6d, 0xf9, 0x79, 0x18, 0x5a,
-		0xdf, 0x65, 0x7b, 0x23, 0xc7, 0x20, 0x69, 0x19, 0x06, 0x9f, 0x93, 0x59, 0x2e, 0x94, 0x20, 0x00,
-		0x3a, 0x05, 0x89, 0xfd, 0x80, 0x88, 0xb7, 0x1f, 0xe0, 0x6d, 0x68, 0x44, 0xfb, 0xda, 0xd0, 0x38,
-		0xf5, 0x1f, 0x24, 0x18, 0xf6, 0xc5, 0x07, 0xf4, 0x30, 0x1c, 0x2a, 0x2c, 0xae, 0xcc, 0x5d, 0x2a,
-		0x2f, 0x14, 0xcb, 0x17, 0x16, 0xf3, 0xf3, 0xde, 0x1b, 0xae, 0xec, 0xe1, 0x6b, 0xd7, 0x67, 0x90,
-		0x0f, 0x77, 0x43, 0xa7, 0x3b, 0x4a, 0xe8, 0x34, 0x4c, 0x06, 0x49, 0xf2, 0x85, 0xb5, 0xd2, 0xf2,
-		0x7a, 0x5a, 0xca, 0x1e, 0xba, 0x76, 0x7d, 0x66, 0xdc, 0x47, 0x91, 0xdf, 0xb4, 0xb1, 0xee, 0xb4,
-		0x12, 0xcc, 0xad, 0x2c, 0x2d, 0x2d, 0xac, 0xa7, 0x23, 0x2d, 0x04, 0x3c, 0x60, 0xdf, 0x07, 0xe3,
-		0x41, 0x82, 0xe5, 0x85, 0xc5, 0x74, 0x34, 0x8b, 0xae, 0x5d, 0x9f, 0x19, 0xf5, 0x61, 0x2f, 0x6b,
-		0xf5, 0x6c, 0xe2, 0x83, 0x9f, 0x99, 0x1a, 0xf8, 0xd5, 0x5f, 0x99, 0x92, 0x48, 0xcf, 0x46, 0x02,
-		0x31, 0x02, 0x3d, 0x00, 0x47, 0xd6, 0x16, 0xe6, 0x97, 0x4b, 0xc5, 0xf2, 0xd2, 0xda, 0xbc, 0xd8,
-		0x83, 0x16, 0xbd, 0x1b, 0xbb, 0x76, 0x7d, 0x66, 0x98, 0x77, 0xa9, 0x13, 0xf6, 0xaa, 0x52, 0xba,
-		0xbc, 0xb2, 0x5e, 0x4a, 0x4b, 0x0c, 0x7b, 0xd5, 0xc2, 0x57, 0x0c, 0x87, 0x7d, 0xc3, 0xee, 0x21,
-		0x38, 0xda, 0x06, 0xdb, 0xed, 0xd8, 0xf8, 0xb5, 0xeb, 0x33, 0x23, 0xab, 0x16, 0x66, 0xfe, 0x43,
-		0x29, 0x66, 0x21, 0xd3, 0x4a, 0xb1, 0xb2, 0xba, 0xb2, 0x96, 0x5f, 0x4c, 0xcf, 0x64, 0xd3, 0xd7,
-		0xae, 0xcf, 0xa4, 0x44, 0x30, 0xa4, 0x1b, 0xfd, 0x6e, 0xcf, 0x6e, 0xe7, 0x8a, 0xe7, 0xfb, 0xb3,
-		0x70, 0x57, 0x87, 0x33, 0x26, 0x71, 0x3a, 0x71, 0xa0, 0x53, 0xa6, 0x8e, 0xfb, 0xec, 0xd9, 0x1e,
-		0xdb, 0xcf, 0xbd, 0x97, 0x4e, 0x07, 0x3f, 0xc1, 0xca, 0x76, 0x5d, 0xdc, 0xc9, 0x1f, 0x92, 0x60,
-		0xf4, 0xa2, 0x66, 0x3b, 0x86, 0xa5, 0x55, 0xd4, 0x3a, 0x7d, 0xb9, 0x75, 0xae, 0xdf, 0xd8, 0x1a,
-		0x72, 0xf5, 0x27, 0x61, 0xf0, 0x8a, 0x5a, 0x67, 0x41, 0x2d, 0x4a, 0x3f, 0x34, 0xd3, 0xe1, 0xc8,
-		0xc7, 0x0d, 0x6d, 0x82, 0x01, 0x23, 0x93, 0x7f, 0x3d, 0x02, 0x63, 0xd4, 0x19, 0x6c, 0xf6, 0x09,
-		0x32, 0xb2, 0xc6, 0x2a, 0x40, 0xcc, 0x52, 0x1d, 0xbe, 0x69, 0x58, 0x98, 0xe5, 0xa7, 0x8f, 0xf7,
-		0xf4, 0x71, 0x96, 0x56, 0xc4, 0x15, 0x85, 0xd2, 0xa2, 0x77, 0x40, 0xa2, 0xa1, 0xee, 0x96, 0x29,
-		0x1f, 0xb6, 0x72, 0xc9, 0xef, 0x8f, 0xcf, 0xcd, 0x1b, 0xd3, 0x63, 0x7b, 0x6a, 0xa3, 0x9e, 0x93,
-		0x05, 0x1f, 0x59, 0x19, 0x6a, 0xa8, 0xbb, 0x44, 0x44, 0x64, 0xc2, 0x18, 0x81, 0x56, 0xb6, 0x55,
-		0xbd, 0x86, 0x59, 0x23, 0x74, 0x0b, 0xb4, 0x70, 0x71, 0xdf, 0x8d, 0x1c, 0xf6, 0x1a, 0xf1, 0xb1,
-		0x93, 0x95, 0x91, 0x86, 0xba, 0x3b, 0x47, 0x01, 0xa4, 0xc5, 0x5c, 0xe2, 0x63, 0xaf, 0x4c, 0x0f,
-		0xd0, 0x13, 0xdd, 0x6f, 0x4b, 0x00, 0x9e, 0xc6, 0xd0, 0x3b, 0x20, 0x5d, 0x71, 0x4b, 0x94, 0x56,
-		0x9c, 0x4d, 0xde, 0xdb, 0x69, 0x2c, 0x42, 0xfa, 0x66, 0x73, 0xf3, 0xb7, 0x6e, 0x4c, 0x4b, 0xca,
-		0x58, 0x25, 0x34, 0x14, 0x6f, 0x87, 0xe1, 0xa6, 0x59, 0x55, 0x1d, 0x5c, 0xa6, 0xeb, 0xb8, 0x48,
-		0xcf, 0x79, 0x7e, 0x8a, 0xf0, 0xba, 0x79, 0x63, 0x1a, 0xb1, 0x6e, 0xf9, 0x88, 0x65, 0x3a, 0xfb,
-		0x03, 0x83, 0x10, 0x02, 0x5f, 0x9f, 0xbe, 0x21, 0xc1, 0x70, 0xd1, 0x77, 0xa7, 0x32, 0x03, 0x43,
-		0x0d, 0x43, 0xd7, 0x76, 0xb8, 0x3d, 0x26, 0x15, 0x51, 0x44, 0x59, 0x48, 0xb0, 0xc7, 0xac, 0xce,
-		0x9e, 0xd8, 0x0a, 0x15, 0x65, 0x42, 0x75, 0x15, 0x6f, 0xda, 0x9a, 0x18, 0x0d, 0x45, 0x14, 0xd1,
-		0x05, 0x48, 0xdb, 0xb8, 0xd2, 0xb4, 0x34, 0x67, 0xaf, 0x5c, 0x31, 0x74, 0x47, 0xad, 0x38, 0xec,
-		0x59, 0x64, 0xe1, 0xd8, 0xcd, 0x1b, 0xd3, 0x47, 0x98, 0xac, 0x61, 0x0c, 0x59, 0x19, 0x13, 0xa0,
-		0x39, 0x06, 0x21, 0x2d, 0x54, 0xb1, 0xa3, 0x6a, 0x75, 0x3b, 0xc3, 0x2e, 0x27, 0x88, 0xa2, 0xaf,
-		0x2f, 0x9f, 0x1f, 0xf2, 0x6f, 0x6c, 0x5d, 0x80, 0xb4, 0x61, 0x62, 0x2b, 0x90, 0x88, 0x4a, 0xe1,
-		0x96, 0xc3, 0x18, 0xb2, 0x32, 0x26, 0x40, 0x22, 0x49, 0x75, 0xc8, 0x30, 0x8b, 0x85, 0xa2, 0xd9,
-		0xdc, 0xf4, 0xf6, 0xc3, 0x26, 0x5b, 0x46, 0x23, 0xaf, 0xef, 0x15, 0x1e, 0xf1, 0xb8, 0x87, 0xe9,
-		0xe4, 0x6f, 0x7e, 0xf9, 0xc1, 0x49, 0x6e, 0x1a, 0xde, 0xfe, 0xd4, 0x25, 0xbc, 0x47, 0x86, 0x9f,
-		0xa3, 0xae, 0x52, 0x4c, 0x92, 0x76, 0x3e, 0xaf, 0x6a, 0x75, 0xf1, 0xbc, 0x5f, 0xe1, 0x25, 0x94,
-		0x83, 0x41, 0xdb, 0x51, 0x9d, 0xa6, 0xcd, 0x4f, 0x7a, 0xe5, 0x4e, 0xa6, 0x56, 0x30, 0xf4, 0xea,
-		0x1a, 0xc5, 0x54, 0x38, 0x05, 0xba, 0x00, 0x83, 0xfc, 0x08, 0x3d, 0xbe, 0x6f, 0xff, 0xa6, 0x77,
-		0x25, 0x18, 0x35, 0xd1, 0x48, 0x15, 0xd7, 0x71, 0x8d, 0xa5, 0x55, 0xdb, 0x2a, 0x59, 0x7d, 0xd0,
-		0x6f, 0xef, 0x15, 0x16, 0xf6, 0xed, 0x84, 0x5c, 0x53, 0x61, 0x7e, 0xb2, 0x32, 0xe6, 0x82, 0xd6,
-		0x28, 0x04, 0x5d, 0x0a, 0x5c, 0xfe, 0xe5, 0x1f, 0xa8, 0xbc, 0xb3, 0x53, 0xf7, 0x7d, 0x36, 0x2d,
-		0xf6, 0x27, 0xfc, 0x57, 0x87, 0x2f, 0x40, 0xba, 0xa9, 0x6f, 0x1a, 0x3a, 0x7d, 0x83, 0xcb, 0xf3,
-		0x7b, 0xb2, 0xbe, 0x8b, 0xfa, 0x8d, 0x23, 0x8c, 0x21, 0x2b, 0x63, 0x2e, 0xe8, 0x22, 0x5b, 0x05,
-		0x54, 0x61, 0xd4, 0xc3, 0xa2, 0x8e, 0x9a, 0xec, 0xe9, 0xa8, 0x77, 0x70, 0x47, 0x3d, 0x14, 0x6e,
-		0xc5, 0xf3, 0xd5, 0x11, 0x17, 0x48, 0xc8, 0xd0, 0x45, 0x00, 0x2f, 0x3c, 0xd0, 0x7d, 0x8a, 0xe1,
-		0xce, 0x03, 0xef, 0xc5, 0x18, 0xb1, 0xde, 0xf3, 0x68, 0xd1, 0xbb, 0x60, 0xa2, 0xa1, 0xe9, 0x65,
-		0x1b, 0xd7, 0xb7, 0xca, 0x5c, 0xc1, 0x84, 0x25, 0xfd, 0x84, 0x52, 0x61, 0x71, 0x7f, 0xf6, 0x70,
-		0xf3, 0xc6, 0x74, 0x96, 0x87, 0xd0, 0x56, 0x96, 0xb2, 0x32, 0xde, 0xd0, 0xf4, 0x35, 0x5c, 0xdf,
-		0x2a, 0xba, 0xb0, 0x5c, 0xea, 0x83, 0xaf, 0x4c, 0x0f, 0x70, 0x77, 0x1d, 0x90, 0xcf, 0xd1, 0xbd,
-		0x73, 0xee, 0x66, 0xd8, 0x26, 0x6b, 0x12, 0x55, 0x14, 0xf8, 0x55, 0x03, 0x0f, 0xc0, 0xdc, 0xfc,
-		0xa5, 0x3f, 0x9c, 0x91, 0xe4, 0xcf, 0x4b, 0x30, 0x58, 0xbc, 0xbc, 0xaa, 0x6a, 0x16, 0x5a, 0x80,
-		0x71, 0xcf, 0x72, 0x82, 0x4e, 0x7e, 0xfc, 0xe6, 0x8d, 0xe9, 0x4c, 0xd8, 0xb8, 0x5c, 0x2f, 0xf7,
-		0x0c, 0x58, 0xb8, 0xf9, 0x42, 0xa7, 0x85, 0x6b, 0x80, 0x55, 0x0b, 0x8a, 0xdc, 0xba, 0xac, 0x0d,
-		0x75, 0xb3, 0x04, 0x43, 0x4c, 0x5a, 0x1b, 0xe5, 0x20, 0x6e, 0x92, 0x1f, 0xfc, 0x60, 0x60, 0xaa,
-		0xa3, 0xf1, 0x52, 0x7c, 0x77, 0x23, 0x93, 0x90, 0xc8, 0x1f, 0x89, 0x00, 0x14, 0x2f, 0x5f, 0x5e,
-		0xb7, 0x34, 0xb3, 0x8e, 0x9d, 0x5b, 0xd9, 0xf3, 0x75, 0x38, 0xe4, 0x5b, 0x25, 0x59, 0x95, 0x50,
-		0xef, 0x67, 0x6e, 0xde, 0x98, 0x3e, 0x1e, 0xee, 0xbd, 0x0f, 0x4d, 0x56, 0x26, 0xbc, 0xf5, 0x92,
-		0x55, 0x69, 0xcb, 0xb5, 0x6a, 0x3b, 0x2e, 0xd7, 0x68, 0x67, 0xae, 0x3e, 0x34, 0x3f, 0xd7, 0xa2,
-		0xed, 0xb4, 0x57, 0xed, 0x1a, 0x0c, 0x7b, 0x2a, 0xb1, 0x51, 0x11, 0x12, 0x0e, 0xff, 0xcd, 0x35,
-		0x2c, 0x77, 0xd6, 0xb0, 0x20, 0xe3, 0x5a, 0x76, 0x29, 0xe5, 0x3f, 0x93, 0x00, 0x3c, 0x9b, 0xfd,
-		0xd9, 0x34, 0x31, 0x12, 0xca, 0x79, 0xe0, 0x8d, 0x1e, 0x28, 0x55, 0xe3, 0xd4, 0x21, 0x7d, 0xfe,
-		0x7c, 0x04, 0x26, 0x36, 0x44, 0xe4, 0xf9, 0x99, 0xd7, 0xc1, 0x2a, 0x0c, 0x61, 0xdd, 0xb1, 0x34,
-		0xaa, 0x04, 0x32, 0xda, 0x0f, 0x75, 0x1a, 0xed, 0x36, 0x7d, 0xa2, 0x1f, 0x91, 0x12, 0x9b, 0xee,
-		0x9c, 0x4d, 0x48, 0x1b, 0xbf, 0x18, 0x85, 0x4c, 0x27, 0x4a, 0x34, 0x07, 0x63, 0x15, 0x0b, 0xb3,
-		0x8b, 0x57, 0xfe, 0x9d, 0xbf, 0x42, 0xd6, 0xcb, 0x2c, 0x43, 0x08, 0xb2, 0x32, 0x2a, 0x20, 0x7c,
-		0xf6, 0xa8, 0x01, 0x49, 0xfb, 0x88, 0xd9, 0xd1, 0xfb, 0x5b, 0xfd, 0xe5, 0x79, 0x32, 0x9f, 0x3e,
-		0x44, 0x23, 0x41, 0x06, 0x6c, 0xfe, 0x18, 0xf5, 0xa0, 0x74, 0x02, 0x79, 0x01, 0xc6, 0x34, 0x5d,
-		0x73, 0x34, 0xb5, 0x5e, 0xde, 0x54, 0xeb, 0xaa, 0x5e, 0x39, 0x48, 0xd6, 0xcc, 0x42, 0x3e, 0x6f,
-		0x36, 0xc4, 0x4e, 0x56, 0x46, 0x39, 0xa4, 0xc0, 0x00, 0xe8, 0x22, 0x0c, 0x89, 0xa6, 0x62, 0x07,
-		0xca, 0x36, 0x04, 0xb9, 0x2f, 0xc1, 0xfb, 0x85, 0x28, 0x8c, 0x2b, 0xb8, 0xfa, 0x97, 0x43, 0xb1,
-		0xbf, 0xa1, 0x58, 0x02, 0x60, 0xee, 0x4e, 0x02, 0xec, 0x01, 0x46, 0x83, 0x04, 0x8c, 0x24, 0xe3,
-		0x50, 0xb4, 0x1d, 0xdf, 0x78, 0xdc, 0x88, 0x40, 0xca, 0x3f, 0x1e, 0x7f, 0x41, 0x67, 0x25, 0xb4,
-		0xe0, 0x45, 0xa2, 0x18, 0xff, 0xf4, 0x6e, 0x87, 0x48, 0xd4, 0x62, 0xbd, 0xdd, 0x43, 0xd0, 0x1f,
-		0x47, 0x61, 0x70, 0x55, 0xb5, 0xd4, 0x86, 0x8d, 0x2a, 0x2d, 0x99, 0xa6, 0xd8, 0x7e, 0x6c, 0xf9,
-		0xc0, 0x3a, 0xdf, 0xed, 0xe8, 0x91, 0x68, 0x7e, 0xac, 0x4d, 0xa2, 0xf9, 0x56, 0x18, 0x25, 0xcb,
-		0x61, 0xdf, 0x15, 0x06, 0xa2, 0xed, 0x91, 0xc2, 0x51, 0x8f, 0x4b, 0xb0, 0x9e, 0xad, 0x96, 0x2f,
-		0xfb, 0xef, 0x30, 0x0c, 0x13, 0x0c, 0x2f, 0x30, 0x13, 0xf2, 0xc3, 0xde, 0xb2, 0xd4, 0x57, 0x29,
-		0x2b, 0xd0, 0x50, 0x77, 0x4b, 0xac, 0x80, 0x16, 0x01, 0x6d, 0xbb, 0x3b, 0x23, 0x65, 0x4f, 0x9d,
-		0x84, 0xfe, 0xc4, 0xcd, 0x1b, 0xd3, 0x47, 0x19, 0x7d, 0x2b, 0x8e, 0xac, 0x8c, 0x7b, 0x40, 0xc1,
-		0xed, 0x51, 0x00, 0xd2, 0xaf, 0x32, 0xbb, 0xc2, 0xcd, 0x96, 0x3b, 0x87, 0x6e, 0xde, 0x98, 0x1e,
-		0x67, 0x5c, 0xbc, 0x3a, 0x59, 0x49, 0x92, 0x42, 0x91, 0xde, 0xee, 0x7e, 0x01, 0xc6, 0xe8, 0x9d,
-		0x80, 0xb2, 0x85, 0xab, 0x4d, 0xfa, 0x69, 0x18, 0xbe, 0xae, 0x39, 0xb0, 0x6f, 0x86, 0xd8, 0xc9,
-		0xca, 0x28, 0x85, 0x28, 0x02, 0xe0, 0x73, 0xa6, 0xcf, 0x48, 0x80, 0xbc, 0x59, 0x46, 0xc1, 0xb6,
-		0x49, 0x96, 0x84, 0x24, 0xf7, 0xf7, 0x25, 0xea, 0x52, 0xf7, 0xdc, 0xdf, 0xa3, 0x17, 0xb9, 0xbf,
-		0xcf, 0x39, 0x9f, 0xf0, 0x22, 0x72, 0xa4, 0xd7, 0x15, 0x6a, 0x6e, 0x95, 0xe1, 0x10, 0x3c, 0x20,
-		0xff, 0x6b, 0x09, 0x8e, 0xb6, 0x18, 0xb1, 0x2b, 0xec, 0x5f, 0x01, 0x64, 0xf9, 0x2a, 0xf9, 0xa7,
-		0x1b, 0x99, 0xd0, 0xfb, 0xf6, 0x89, 0x71, 0xab, 0x25, 0xd4, 0xdf, 0xba, 0x49, 0x85, 0xdd, 0xd1,
-		0xff, 0x17, 0x12, 0x4c, 0xfa, 0x9b, 0x77, 0x3b, 0xb2, 0x0c, 0x29, 0x7f, 0xeb, 0xbc, 0x0b, 0x77,
-		0xf5, 0xd3, 0x05, 0x2e, 0x7d, 0x80, 0x1e, 0x3d, 0xed, 0x45, 0x08, 0xb6, 0x5d, 0xf7, 0x70, 0xdf,
-		0xda, 0x10, 0x32, 0x85, 0x23, 0x45, 0x8c, 0x8e, 0xc7, 0xff, 0x95, 0x20, 0xb6, 0x6a, 0x18, 0x75,
-		0x64, 0xc0, 0xb8, 0x6e, 0x38, 0x65, 0x62, 0xcc, 0xb8, 0xea, 0xbf, 0x2a, 0x9f, 0x2c, 0xcc, 0xed,
-		0x4f, 0x49, 0x3f, 0xb8, 0x31, 0xdd, 0xca, 0x4a, 0x19, 0xd3, 0x0d, 0xa7, 0x40, 0x21, 0xfc, 0xb6,
-		0xfc, 0xbb, 0x60, 0x24, 0xd8, 0x18, 0x0b, 0xcc, 0xcf, 0xec, 0xbb, 0xb1, 0x20, 0x9b, 0x9b, 0x37,
-		0xa6, 0x27, 0x3d, 0x27, 0x75, 0xc1, 0xb2, 0x92, 0xda, 0xf4, 0xb5, 0xce, 0x6e, 0x94, 0xfd, 0xe8,
-		0x95, 0x69, 0xe9, 0xd4, 0x57, 0x24, 0x00, 0x6f, 0xb3, 0x03, 0x3d, 0x00, 0x47, 0x0a, 0x2b, 0xcb,
-		0xc5, 0xf2, 0xda, 0x7a, 0x7e, 0x7d, 0x63, 0x2d, 0x78, 0xad, 0x5c, 0xec, 0xc8, 0xdb, 0x26, 0xae,
-		0x68, 0x5b, 0x1a, 0xae, 0xa2, 0x7b, 0x60, 0x32, 0x88, 0x4d, 0x4a, 0xa5, 0x62, 0x5a, 0xca, 0xa6,
-		0xae, 0x5d, 0x9f, 0x49, 0xb0, 0xf4, 0x0f, 0x57, 0xd1, 0x49, 0x38, 0xd4, 0x8a, 0xb7, 0xb0, 0x3c,
-		0x9f, 0x8e, 0x64, 0x47, 0xae, 0x5d, 0x9f, 0x49, 0xba, 0x79, 0x22, 0x92, 0x01, 0xf9, 0x31, 0x39,
-		0xbf, 0x68, 0x16, 0xae, 0x5d, 0x9f, 0x19, 0x64, 0x0a, 0xcc, 0xc6, 0x3e, 0xf8, 0x99, 0xa9, 0x81,
-		0x5b, 0x7e, 0xf9, 0xfc, 0x4f, 0x87, 0x3a, 0x6e, 0xb4, 0xd7, 0xb0, 0x8e, 0x6d, 0xcd, 0x3e, 0xd0,
-		0x46, 0x7b, 0x5f, 0x9b, 0xf7, 0xf2, 0xef, 0xc5, 0x21, 0x35, 0xcf, 0x5a, 0x21, 0x03, 0x81, 0xd1,
-		0x9b, 0x60, 0xd0, 0xa4, 0x33, 0x97, 0x7b, 0x72, 0xd7, 0xc1, 0xe0, 0xd9, 0xfc, 0xe6, 0x5e, 0x1f,
-		0x63, 0xb3, 0x9d, 0xcd, 0xef, 0x8f, 0xb0, 0x6b, 0x6d, 0xde, 0x45, 0xad, 0xd4, 0xbe, 0xb6, 0x98,
-		0x58, 0x28, 0xe6, 0xbb, 0x39, 0x61, 0x7e, 0x32, 0xbb, 0x8a, 0xb2, 0x4e, 0x20, 0xec, 0x42, 0xda,
-		0xfb, 0x25, 0x38, 0x44, 0xb1, 0xbc, 0xb9, 0x9f, 0x62, 0x8a, 0xf5, 0xc5, 0xa9, 0x4e, 0x5d, 0x58,
-		0x54, 0x6d, 0xef, 0x7a, 0x09, 0xbb, 0x42, 0x76, 0x17, 0x9f, 0x7b, 0x8f, 0xfb, 0x1a, 0x0f, 0xb3,
-		0x95, 0x95, 0x89, 0x7a, 0x0b, 0xa5, 0x8d, 0xe6, 0x03, 0x77, 0x08, 0x63, 0xfb, 0xdb, 0xdd, 0xf7,
-		0xdf, 0x27, 0x7c, 0x0a, 0x86, 0xbd, 0x58, 0x62, 0xf3, 0x7f, 0x35, 0xd3, 0xff, 0xdc, 0xe1, 0x27,
-		0x46, 0x1f, 0x90, 0xe0, 0x90, 0x97, 0x40, 0xf8, 0xd9, 0xb2, 0x7f, 0xc9, 0x73, 0xff, 0x3e, 0xd6,
-		0x5e, 0x61, 0xe5, 0xb4, 0xe5, 0x2b, 0x2b, 0x93, 0xcd, 0x56, 0x52, 0xb2, 0xea, 0x1b, 0xf1, 0x47,
-		0x56, 0x3b, 0x23, 0xbe, 0x3a, 0xd9, 0x7f, 0x68, 0x0e, 0x32, 0x60, 0xff, 0x26, 0xc4, 0x34, 0x2c,
-		0x07, 0x57, 0xe9, 0x1e, 0x60, 0x42, 0x71, 0xcb, 0xf2, 0x32, 0xa0, 0xd6, 0xc1, 0x0d, 0xdf, 0x99,
-		0xf4, 0x9e, 0xc4, 0xa0, 0x49, 0x88, 0xfb, 0x6f, 0x15, 0xb2, 0x42, 0x2e, 0xf1, 0x41, 0x3e, 0x7d,
-		0xde, 0x72, 0x9f, 0xff, 0x4e, 0x04, 0x4e, 0xf9, 0x4f, 0xa4, 0x5e, 0x68, 0x62, 0x6b, 0xcf, 0x75,
-		0x51, 0x53, 0xad, 0x69, 0xba, 0xff, 0xe1, 0xc5, 0x51, 0xff, 0x84, 0x4f, 0x71, 0x85, 0x9e, 0xe4,
-		0x0f, 0x4a, 0x30, 0xbc, 0xaa, 0xd6, 0xb0, 0x82, 0x5f, 0x68, 0x62, 0xdb, 0x69, 0x73, 0xb1, 0xfd,
-		0x30, 0x0c, 0x1a, 0x5b, 0x5b, 0xe2, 0x18, 0x3d, 0xa6, 0xf0, 0x12, 0xe9, 0x73, 0x5d, 0x6b, 0x68,
-		0xec, 0x06, 0x5a, 0x4c, 0x61, 0x05, 0x34, 0x0d, 0xc3, 0x15, 0xa3, 0xa9, 0x73, 0x97, 0xcb, 0xc4,
-		0xc4, 0xe7, 0x5d, 0x9a, 0x3a, 0x73, 0x39, 0xa2, 0x44, 0x0b, 0x5f, 0xc1, 0x96, 0xcd, 0x3e, 0x68,
-		0x99, 0x50, 0x44, 0x51, 0x7e, 0x12, 0x52, 0x4c, 0x12, 0x3e, 0x19, 0x1f, 0x85, 0x04, 0xbd, 0xdc,
-		0xe5, 0xc9, 0x33, 0x44, 0xca, 0x97, 0xd8, 0xf5, 0x78, 0xc6, 0x9f, 0x89, 0xc4, 0x0a, 0x85, 0x42,
-		0x47, 0x2d, 0x9f, 0xec, 0x1d, 0x35, 0x98, 0x0e, 0x5d, 0x0d, 0xff, 0x56, 0x1c, 0x0e, 0xf1, 0xf3,
-		0x42, 0xd5, 0xd4, 0x4e, 0x6f, 0x3b, 0x8e, 0x78, 0xae, 0x01, 0x3c, 0xf1, 0x56, 0x4d, 0x4d, 0xde,
-		0x83, 0xd8, 0x45, 0xc7, 0x31, 0xd1, 0x29, 0x88, 0x5b, 0xcd, 0x3a, 0x16, 0xfb, 0x4f, 0xee, 0x09,
-		0x81, 0x6a, 0x6a, 0xb3, 0x04, 0x41, 0x69, 0xd6, 0xb1, 0xc2, 0x50, 0x50, 0x09, 0xa6, 0xb7, 0x9a,
-		0xf5, 0xfa, 0x5e, 0xb9, 0x8a, 0xe9, 0x7f, 0xe8, 0x72, 0xff, 0xc7, 0x05, 0xde, 0x35, 0x55, 0xf1,
-		0xa5, 0x4c, 0xa2, 0x98, 0xe3, 0x14, 0xad, 0x48, 0xb1, 0xc4, 0xff, 0xb7, 0x28, 0x09, 0x1c, 0xf9,
-		0x0f, 0x22, 0x90, 0x10, 0xac, 0xe9, 0x7d, 0x75, 0x5c, 0xc7, 0x15, 0xc7, 0x10, 0xe7, 0x37, 0x6e,
-		0x19, 0x21, 0x88, 0xd6, 0xf8, 0xe0, 0x25, 0x2f, 0x0e, 0x28, 0xa4, 0x40, 0x60, 0xee, 0x2b, 0x02,
-		0x02, 0x33, 0x9b, 0x64, 0x3c, 0x63, 0xa6, 0x21, 0x16, 0x8a, 0x17, 0x07, 0x14, 0x5a, 0x42, 0x19,
-		0x18, 0x24, 0x4e, 0xe3, 0xb0, 0xd1, 0x22, 0x70, 0x5e, 0x46, 0x87, 0x21, 0x6e, 0xaa, 0x4e, 0x85,
-		0x5d, 0xf0, 0x23, 0x15, 0xac, 0x88, 0x1e, 0x83, 0x41, 0xf6, 0x10, 0x3c, 0xfc, 0xef, 0x6f, 0x88,
-		0x32, 0xd8, 0x17, 0xf7, 0x88, 0xdc, 0xab, 0xaa, 0xe3, 0x60, 0x4b, 0x27, 0x0c, 0x19, 0x3a, 0x42,
-		0x10, 0xdb, 0x34, 0xaa, 0x7b, 0xfc, 0x5f, 0xf2, 0xd0, 0xdf, 0xfc, 0x7f, 0x80, 0x50, 0x7b, 0x28,
-		0xd3, 0x4a, 0xf6, 0x9f, 0xc8, 0x52, 0x02, 0x58, 0x20, 0x48, 0x25, 0x98, 0x50, 0xab, 0x55, 0x8d,
-		0xfd, 0x77, 0x9c, 0xf2, 0xa6, 0x46, 0x83, 0x87, 0x4d, 0xff, 0xcf, 0x5c, 0xa7, 0xb1, 0x40, 0x1e,
-		0x41, 0x81, 0xe3, 0x17, 0x92, 0x30, 0x64, 0x32, 0xa1, 0xe4, 0xf3, 0x30, 0xde, 0x22, 0x29, 0x91,
-		0x6f, 0x47, 0xd3, 0xab, 0xe2, 0x69, 0x05, 0xf9, 0x4d, 0x60, 0xf4, 0x1b, 0x99, 0xec, 0x64, 0x8c,
-		0xfe, 0x2e, 0xbc, 0xb7, 0xf3, 0x0b, 0x9c, 0x51, 0xdf, 0x0b, 0x1c, 0xd5, 0xd4, 0x0a, 0x49, 0xca,
-		0x9f, 0xbf, 0xbb, 0xc9, 0xb7, 0xbe, 0xbb, 0xa9, 0x61, 0x5d, 0x4c, 0xcc, 0xa4, 0x4a, 0x35, 0x35,
-		0x9b, 0x9a, 0xa3, 0xf7, 0xcd, 0x4e, 0xfb, 0xbc, 0xef, 0x37, 0x7d, 0x86, 0x13, 0x9b, 0xcf, 0xaf,
-		0x2e, 0xb8, 0x76, 0xfc, 0xb5, 0x08, 0x1c, 0xf7, 0xd9, 0xb1, 0x0f, 0xb9, 0xd5, 0x9c, 0xb3, 0xed,
-		0x2d, 0xbe, 0x8f, 0xe7, 0xd0, 0x97, 0x20, 0x46, 0xf0, 0x51, 0x8f, 0xff, 0xd0, 0x91, 0xf9, 0xc2,
-		0x37, 0xff, 0xb9, 0x1c, 0x3c, 0x43, 0x0b, 0x8c, 0x0a, 0x65, 0x52, 0xf8, 0x40, 0xff, 0xfa, 0x4b,
-		0x7b, 0x9f, 0x2b, 0xb5, 0x6f, 0x9d, 0x1a, 0xc3, 0x3a, 0xfc, 0xde, 0xd9, 0x8e, 0xcf, 0x65, 0x59,
-		0x30, 0xed, 0x9e, 0x5f, 0xed, 0x23, 0x52, 0x77, 0x7a, 0x8d, 0xd0, 0x6d, 0x04, 0xfb, 0xcc, 0xd4,
-		0x76, 0xe1, 0xf0, 0xd3, 0xa4, 0x6d, 0x6f, 0xd1, 0x2e, 0x42, 0xfe, 0x61, 0xf7, 0x6c, 0x51, 0xe2,
-		0xff, 0xe6, 0x4f, 0x9c, 0x1b, 0x82, 0x27, 0x1f, 0x5f, 0x3b, 0xde, 0x33, 0xdb, 0x71, 0x2a, 0x99,
-		0xf5, 0x4d, 0x23, 0x8a, 0x8f, 0x52, 0xfe, 0x35, 0x09, 0x8e, 0xb4, 0x34, 0xcd, 0x63, 0xfc, 0x7c,
-		0x9b, 0x87, 0x13, 0x07, 0x4a, 0x7a, 0xe6, 0xdb, 0x08, 0x7b, 0x6f, 0x4f, 0x61, 0x99, 0x14, 0x01,
-		0x69, 0xdf, 0x02, 0x87, 0x82, 0xc2, 0x0a, 0x35, 0xdd, 0x0d, 0xa3, 0xc1, 0xfd, 0x69, 0xae, 0xae,
-		0x91, 0xc0, 0x0e, 0xb5, 0x5c, 0x0e, 0xeb, 0xd9, 0xed, 0x6b, 0x09, 0x92, 0x2e, 0x2a, 0xcf, 0x8e,
-		0xfb, 0xee, 0xaa, 0x47, 0x29, 0x7f, 0x44, 0x82, 0x99, 0x60, 0x0b, 0xbe, 0x3c, 0x69, 0x7f, 0xc2,
-		0xde, 0xb2, 0x21, 0x7e, 0x4d, 0x82, 0x3b, 0xba, 0xc8, 0xc4, 0x15, 0xf0, 0x22, 0x4c, 0xfa, 0x36,
-		0x09, 0x44, 0x08, 0x17, 0xc3, 0x7e, 0xaa, 0x77, 0x86, 0xea, 0xae, 0x89, 0x8f, 0x11, 0xa5, 0x7c,
-		0xee, 0x3b, 0xd3, 0x13, 0xad, 0x75, 0xb6, 0x32, 0xd1, 0xba, 0xb0, 0xbf, 0x85, 0xf6, 0xf1, 0xb2,
-		0x04, 0xf7, 0x05, 0xbb, 0xda, 0x26, 0xd5, 0x7d, 0xa3, 0xc6, 0xe1, 0x3f, 0x4a, 0x70, 0xaa, 0x1f,
-		0xe1, 0xf8, 0x80, 0x6c, 0xc2, 0x84, 0x97, 0x84, 0x87, 0xc7, 0x63, 0x5f, 0xa9, 0x3d, 0xb3, 0x52,
-		0xe4, 0x72, 0xbb, 0x0d, 0x8a, 0x37, 0xb9, 0x63, 0xf9, 0x87, 0xdc, 0x55, 0x72, 0x70, 0x6f, 0x59,
-		0x28, 0x39, 0xb0, 0xbb, 0xdc, 0x66, 0x2c, 0x22, 0x6d, 0xc6, 0xc2, 0xcb, 0xda, 0xe5, 0x2b, 0x3c,
-		0x6e, 0xb5, 0xd9, 0x9e, 0x7b, 0x3b, 0x4c, 0xb4, 0x31, 0x65, 0xee, 0xd5, 0xfb, 0xb0, 0x64, 0x05,
-		0xb5, 0x1a, 0xab, 0xbc, 0x07, 0xd3, 0xb4, 0xdd, 0x36, 0x8a, 0xbe, 0xdd, 0x5d, 0x6e, 0xf0, 0xd8,
-		0xd2, 0xb6, 0x69, 0xde, 0xf7, 0x05, 0x18, 0x64, 0xe3, 0xcc, 0xbb, 0x7b, 0x00, 0x43, 0xe1, 0x0c,
-		0xe4, 0x4f, 0x88, 0x58, 0x56, 0x14, 0x62, 0xb7, 0xf7, 0xa1, 0x7e, 0xfa, 0x7a, 0x8b, 0x7c, 0xc8,
-		0xa7, 0x8c, 0x6f, 0x8b, 0xa8, 0xd6, 0x5e, 0x3a, 0xae, 0x8e, 0xca, 0x2d, 0x8b, 0x6a, 0x4c, 0x37,
-		0xb7, 0x37, 0x7c, 0xfd, 0x8a, 0x08, 0x5f, 0x6e, 0x9f, 0x7a, 0x84, 0xaf, 0x37, 0x46, 0xf5, 0x6e,
-		0x20, 0xeb, 0x21, 0xe6, 0x9f, 0xc7, 0x40, 0xf6, 0x23, 0x09, 0x8e, 0xd2, 0xbe, 0xf9, 0xf7, 0x28,
-		0xf6, 0xab, 0xf2, 0x07, 0x00, 0xd9, 0x56, 0xa5, 0xdc, 0xd6, 0xbb, 0xd3, 0xb6, 0x55, 0xb9, 0x1c,
-		0x98, 0x5f, 0x1e, 0x00, 0x54, 0x0d, 0xec, 0x44, 0x51, 0x6c, 0x76, 0x67, 0x2f, 0x5d, 0xf5, 0x6d,
-		0x74, 0xb4, 0x19, 0xce, 0xd8, 0x2d, 0x18, 0xce, 0x6f, 0x49, 0x90, 0x6d, 0xd7, 0x65, 0x3e, 0x7c,
-		0x1a, 0x1c, 0x0e, 0x9c, 0x1f, 0x84, 0x47, 0xf0, 0x81, 0x7e, 0x76, 0x79, 0x42, 0x6e, 0x74, 0xc8,
-		0xc2, 0xb7, 0x3b, 0x0f, 0x98, 0x0e, 0x5a, 0x68, 0x6b, 0x66, 0xfd, 0x86, 0xb9, 0xcf, 0x97, 0x5b,
-		0xe2, 0xea, 0x9f, 0x8b, 0xdc, 0x7b, 0x17, 0xa6, 0x3a, 0x48, 0x7d, 0xbb, 0xe7, 0xbd, 0xed, 0x8e,
-		0x83, 0x79, 0xab, 0xd3, 0xf7, 0x47, 0xb9, 0x27, 0x04, 0xef, 0x83, 0xfb, 0xd6, 0x62, 0xed, 0x1e,
-		0x94, 0xc9, 0x6f, 0x83, 0x63, 0x6d, 0xa9, 0xb8, 0x6c, 0x39, 0x88, 0x6d, 0x6b, 0xb6, 0xc3, 0xc5,
-		0xba, 0xa7, 0x93, 0x58, 0x21, 0x6a, 0x4a, 0x23, 0x23, 0x48, 0x53, 0xd6, 0xab, 0x86, 0x51, 0xe7,
-		0x62, 0xc8, 0x97, 0x60, 0xdc, 0x07, 0xe3, 0x8d, 0x9c, 0x83, 0x98, 0x69, 0xf0, 0x8f, 0x25, 0x0c,
-		0x9f, 0x39, 0xde, 0x71, 0x63, 0xdf, 0x30, 0xea, 0xbc, 0xdb, 0x14, 0x5f, 0x9e, 0x04, 0xc4, 0x98,
-		0xd1, 0x3d, 0x7e, 0xd1, 0xc4, 0x1a, 0x4c, 0x04, 0xa0, 0xbc, 0x91, 0xd7, 0x75, 0x7e, 0x70, 0xe6,
-		0x07, 0x87, 0x20, 0x4e, 0xb9, 0xa2, 0x8f, 0x4b, 0x81, 0xaf, 0x19, 0xcd, 0x76, 0x62, 0xd3, 0x7e,
-		0x4d, 0x9c, 0x3d, 0xdd, 0x37, 0x3e, 0xcf, 0xd9, 0x4e, 0xbd, 0xf7, 0xdf, 0x7d, 0xef, 0xa3, 0x91,
-		0xbb, 0x90, 0x7c, 0xba, 0xc3, 0x6a, 0xdc, 0xe7, 0x2f, 0x9f, 0x0d, 0xbc, 0xc4, 0x7f, 0xb0, 0xbf,
-		0xa6, 0x84, 0x64, 0xb3, 0xfd, 0xa2, 0x73, 0xc1, 0xce, 0x53, 0xc1, 0xce, 0xa2, 0x47, 0x7a, 0x0b,
-		0x76, 0xfa, 0x9d, 0x41, 0xa7, 0x79, 0x37, 0xfa, 0x3d, 0x09, 0x26, 0xdb, 0x2d, 0xe9, 0xd0, 0xe3,
-		0xfd, 0x49, 0xd1, 0x9a, 0x52, 0x64, 0x9f, 0x38, 0x00, 0x25, 0xef, 0xca, 0x3c, 0xed, 0x4a, 0x1e,
-		0x3d, 0x79, 0x80, 0xae, 0x9c, 0xf6, 0x6f, 0xfd, 0xff, 0x6f, 0x09, 0x4e, 0x74, 0x5d, 0x21, 0xa1,
-		0x7c, 0x7f, 0x52, 0x76, 0xc9, 0x9d, 0xb2, 0x85, 0xd7, 0xc3, 0x82, 0xf7, 0xf8, 0x69, 0xda, 0xe3,
-		0x4b, 0x68, 0xe1, 0x20, 0x3d, 0x6e, 0x7b, 0xbe, 0x82, 0x7e, 0x3b, 0x78, 0xcf, 0xb1, 0xbb, 0x39,
-		0xb5, 0x2c, 0x3c, 0x7a, 0x38, 0x46, 0x6b, 0x52, 0x2b, 0x3f, 0x4b, 0xbb, 0xa0, 0xa0, 0xd5, 0xd7,
-		0x39, 0x68, 0xa7, 0xdf, 0x19, 0x0c, 0xfc, 0xef, 0x46, 0xff, 0x4b, 0x6a, 0x7f, 0x6d, 0xf1, 0xb1,
-		0xae, 0x22, 0x76, 0x5e, 0x54, 0x65, 0x1f, 0xdf, 0x3f, 0x21, 0xef, 0x64, 0x83, 0x76, 0xb2, 0x86,
-		0xf0, 0xad, 0xee, 0x64, 0xdb, 0x41, 0x44, 0xdf, 0x90, 0x60, 0xb2, 0xdd, 0x9a, 0xa4, 0x87, 0x5b,
-		0x76, 0x59, 0x64, 0xf5, 0x70, 0xcb, 0x6e, 0x0b, 0x20, 0xf9, 0x4d, 0xb4, 0xf3, 0xe7, 0xd0, 0xa3,
-		0x9d, 0x3a, 0xdf, 0x75, 0x14, 0x89, 0x2f, 0x76, 0x4d, 0xf2, 0x7b, 0xf8, 0x62, 0x3f, 0xeb, 0x98,
-		0x1e, 0xbe, 0xd8, 0xd7, 0x1a, 0xa3, 0xb7, 0x2f, 0xba, 0x3d, 0xeb, 0x73, 0x18, 0x6d, 0xf4, 0x35,
-		0x09, 0x46, 0x02, 0x19, 0x31, 0x7a, 0xb8, 0xab, 0xa0, 0xed, 0x16, 0x0c, 0xd9, 0x33, 0xfb, 0x21,
-		0xe1, 0x7d, 0x59, 0xa0, 0x7d, 0x99, 0x43, 0xf9, 0x83, 0xf4, 0x25, 0x78, 0x8c, 0xfa, 0x2d, 0x09,
-		0x26, 0xda, 0x64, 0x99, 0x3d, 0xbc, 0xb0, 0x73, 0xd2, 0x9c, 0x7d, 0x7c, 0xff, 0x84, 0xbc, 0x57,
-		0x17, 0x68, 0xaf, 0xde, 0x8a, 0xde, 0x72, 0x90, 0x5e, 0xf9, 0xe6, 0xe7, 0x1b, 0xde, 0x95, 0x2c,
-		0x5f, 0x3b, 0xe8, 0xdc, 0x3e, 0x05, 0x13, 0x1d, 0x7a, 0x6c, 0xdf, 0x74, 0xbc, 0x3f, 0xcf, 0xd0,
-		0xfe, 0x3c, 0x8d, 0x56, 0x5e, 0x5f, 0x7f, 0x5a, 0xa7, 0xf5, 0x2f, 0xb5, 0xbe, 0x47, 0xec, 0x6e,
-		0x45, 0x6d, 0x93, 0xd5, 0xec, 0x23, 0xfb, 0xa2, 0xe1, 0x9d, 0x7a, 0x9c, 0x76, 0xea, 0x0c, 0x7a,
-		0xa8, 0x53, 0xa7, 0x7c, 0x57, 0xfd, 0x34, 0x7d, 0xcb, 0x38, 0xfd, 0x4e, 0x96, 0x02, 0xbf, 0x1b,
-		0xbd, 0x47, 0xdc, 0x79, 0x3a, 0xd9, 0xb5, 0x5d, 0x5f, 0x1e, 0x9b, 0xbd, 0xaf, 0x0f, 0x4c, 0x2e,
-		0xd7, 0x5d, 0x54, 0xae, 0x29, 0x74, 0xbc, 0x93, 0x5c, 0x24, 0x97, 0x45, 0x1f, 0x92, 0xdc, 0x9b,
-		0x99, 0xa7, 0xba, 0xf3, 0xf6, 0x27, 0xbb, 0xd9, 0xfb, 0xfb, 0xc2, 0xe5, 0x92, 0xdc, 0x43, 0x25,
-		0x99, 0x41, 0x53, 0x1d, 0x25, 0x61, 0xa9, 0xef, 0xad, 0xbe, 0x54, 0x70, 0xed, 0x08, 0x4c, 0x77,
-		0x68, 0xd1, 0xd9, 0xed, 0x71, 0xc6, 0xd5, 0xe5, 0x59, 0x6e, 0xcf, 0x67, 0xb7, 0xb7, 0xfa, 0x73,
-		0xb2, 0x7d, 0x1e, 0x88, 0xfd, 0x4e, 0x0c, 0xd0, 0x92, 0x5d, 0x9b, 0xb3, 0x30, 0xfb, 0xd7, 0x96,
-		0xdc, 0xcb, 0x43, 0xef, 0xcd, 0xa4, 0xd7, 0xf5, 0xde, 0x6c, 0x29, 0xf0, 0x82, 0x2b, 0xb2, 0xbf,
-		0x57, 0xa2, 0x7d, 0x3f, 0xe3, 0x8a, 0xfe, 0x54, 0x9e, 0x71, 0xb5, 0xbf, 0xe5, 0x1d, 0xbb, 0x75,
-		0xcf, 0x41, 0xe2, 0x07, 0x7d, 0x12, 0xc3, 0x5f, 0x67, 0x0e, 0x76, 0x79, 0x9d, 0x99, 0xe9, 0xf8,
-		0x04, 0x93, 0x53, 0xa3, 0xb3, 0xe2, 0xe3, 0xaa, 0x43, 0xfd, 0x5d, 0x92, 0xe5, 0x5f, 0x5f, 0xf5,
-		0xb6, 0x10, 0x8e, 0x43, 0xb6, 0xd5, 0x9c, 0x5c, 0xa7, 0xfe, 0x68, 0x14, 0xd2, 0x4b, 0x76, 0xad,
-		0x54, 0xd5, 0x9c, 0xdb, 0x64, 0x6b, 0x4f, 0x76, 0x7e, 0x62, 0x83, 0x6e, 0xde, 0x98, 0x1e, 0x65,
-		0x3a, 0xed, 0xa2, 0xc9, 0x06, 0x8c, 0x85, 0x1e, 0x36, 0x73, 0xcb, 0x2a, 0x1e, 0xe4, 0x7d, 0x75,
-		0x88, 0x95, 0x4c, 0x5f, 0x44, 0xf8, 0xec, 0x1b, 0xed, 0xb6, 0x37, 0x66, 0x66, 0x50, 0x17, 0x6f,
-		0xe7, 0x7b, 0x44, 0x6f, 0xcc, 0xb2, 0x90, 0x09, 0x0f, 0x8a, 0x3b, 0x62, 0xdf, 0x97, 0x60, 0x78,
-		0xc9, 0x16, 0xa9, 0x20, 0xfe, 0x19, 0x7d, 0x0d, 0xf5, 0x98, 0xfb, 0x65, 0xf2, 0x68, 0x7f, 0x76,
-		0x2b, 0xbe, 0x56, 0xee, 0x29, 0xe1, 0x10, 0x4c, 0xf8, 0xfa, 0xe9, 0xf6, 0xff, 0x77, 0x23, 0x34,
-		0x3e, 0x16, 0x70, 0x4d, 0xd3, 0xdd, 0x2c, 0x12, 0xff, 0x45, 0x7d, 0xeb, 0xe1, 0xe9, 0x39, 0x76,
-		0x50, 0x3d, 0xef, 0xd0, 0x00, 0x11, 0xd2, 0xa7, 0xbb, 0xf1, 0xb5, 0xd4, 0xfa, 0x12, 0x49, 0xda,
-		0xc7, 0x47, 0x7e, 0x42, 0xef, 0x8d, 0xe4, 0xd7, 0x24, 0x18, 0x59, 0xb2, 0x6b, 0x1b, 0x7a, 0xf5,
-		0xff, 0x7b, 0xfb, 0xdd, 0x82, 0x43, 0x81, 0x9e, 0xde, 0x26, 0x95, 0x9e, 0x79, 0x39, 0x06, 0xd1,
-		0x25, 0xbb, 0x86, 0x5e, 0x80, 0xb1, 0x70, 0xd2, 0xd0, 0x31, 0x17, 0x6c, 0x9d, 0x11, 0x3a, 0xaf,
-		0xd7, 0x3a, 0xcf, 0x1e, 0x68, 0x07, 0x46, 0x82, 0x33, 0xc7, 0xc9, 0x2e, 0x4c, 0x02, 0x98, 0xd9,
-		0x87, 0xfa, 0xc5, 0x74, 0x1b, 0x7b, 0x07, 0x24, 0xdc, 0xa0, 0x77, 0x67, 0x17, 0x6a, 0x81, 0xd4,
-		0x39, 0xbb, 0x6d, 0x13, 0x56, 0x88, 0xf6, 0xc2, 0x21, 0xa5, 0x9b, 0xf6, 0x42, 0xb8, 0x5d, 0xb5,
-		0xd7, 0xc9, 0xb5, 0x36, 0x01, 0x7c, 0x7e, 0x70, 0x77, 0x17, 0x0e, 0x1e, 0x5a, 0xf6, 0xc1, 0xbe,
-		0xd0, 0xdc, 0x43, 0xa7, 0x5b, 0x9c, 0x8c, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xc7,
-		0xe8, 0xef, 0x50, 0x98, 0x00, 0x00,
+		// 10336 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x5c, 0xe7,
+		0x75, 0x18, 0xee, 0xee, 0x02, 0xd8, 0x3d, 0x78, 0x2d, 0x3e, 0x80, 0xd4, 0x62, 0x45, 0x02, 0xd0,
+		0x95, 0x44, 0x52, 0x94, 0x04, 0x4a, 0x94, 0x48, 0x4a, 0x4b, 0x3d, 0xbc, 0x0b, 0x2c, 0x41, 0x88,
+		0x78, 0xe9, 0x02, 0xa4, 0x64, 0xd9, 0xe9, 0xce, 0xc5, 0xee, 0x87, 0xc5, 0x15, 0x76, 0xef, 0xbd,
+		0xba, 0xf7, 0x2e, 0x49, 0xc8, 0x76, 0x47, 0x7e, 0xd4, 0xb5, 0x95, 0x49, 0x6d, 0xd7, 0x9d, 0xc4,
+		0x2f, 0xba, 0x96, 0x9d, 0xd6, 0xae, 0xe3, 0x36, 0x76, 0xec, 0xb8, 0x4d, 0x9b, 0x99, 0xda, 0x99,
+		0x49, 0x63, 0x3b, 0x4d, 0xc6, 0x6e, 0x3b, 0x6d, 0x9a, 0x49, 0x69, 0x57, 0x76, 0x53, 0xd5, 0x75,
+		0x1b, 0x97, 0x75, 0xa7, 0xe9, 0x78, 0x3a, 0xed, 0x7c, 0xaf, 0xfb, 0xda, 0xc7, 0xdd, 0x85, 0x48,
+		0x3f, 0x92, 0xfc, 0x02, 0xbe, 0xf3, 0x9d, 0x73, 0xbe, 0x73, 0xce, 0x77, 0xbe, 0xf3, 0x9d, 0xef,
+		0x75, 0x17, 0xbe, 0x70, 0x16, 0x66, 0xab, 0x86, 0x51, 0xad, 0xe1, 0x13, 0xa6, 0x65, 0x38, 0xc6,
+		0x56, 0x63, 0xfb, 0x44, 0x05, 0xdb, 0x65, 0x4b, 0x33, 0x1d, 0xc3, 0x9a, 0xa3, 0x30, 0x34, 0xc6,
+		0x30, 0xe6, 0x04, 0x86, 0xbc, 0x02, 0xe3, 0xe7, 0xb4, 0x1a, 0x5e, 0x70, 0x11, 0x37, 0xb0, 0x83,
+		0x1e, 0x81, 0xc4, 0xb6, 0x56, 0xc3, 0x19, 0x69, 0x36, 0x7e, 0x6c, 0xe8, 0xe4, 0x5d, 0x73, 0x21,
+		0xa2, 0xb9, 0x20, 0xc5, 0x3a, 0x01, 0x2b, 0x94, 0x42, 0xfe, 0x5e, 0x02, 0x26, 0x5a, 0xd4, 0x22,
+		0x04, 0x09, 0x5d, 0xad, 0x13, 0x8e, 0xd2, 0xb1, 0x94, 0x42, 0xff, 0x47, 0x19, 0x18, 0x34, 0xd5,
+		0xf2, 0xae, 0x5a, 0xc5, 0x99, 0x18, 0x05, 0x8b, 0x22, 0x9a, 0x06, 0xa8, 0x60, 0x13, 0xeb, 0x15,
+		0xac, 0x97, 0xf7, 0x32, 0xf1, 0xd9, 0xf8, 0xb1, 0x94, 0xe2, 0x83, 0xa0, 0x7b, 0x61, 0xdc, 0x6c,
+		0x6c, 0xd5, 0xb4, 0x72, 0xc9, 0x87, 0x06, 0xb3, 0xf1, 0x63, 0xfd, 0x4a, 0x9a, 0x55, 0x2c, 0x78,
+		0xc8, 0x47, 0x61, 0xec, 0x0a, 0x56, 0x77, 0xfd, 0xa8, 0x43, 0x14, 0x75, 0x94, 0x80, 0x7d, 0x88,
+		0xf3, 0x30, 0x5c, 0xc7, 0xb6, 0xad, 0x56, 0x71, 0xc9, 0xd9, 0x33, 0x71, 0x26, 0x41, 0xb5, 0x9f,
+		0x6d, 0xd2, 0x3e, 0xac, 0xf9, 0x10, 0xa7, 0xda, 0xdc, 0x33, 0x31, 0xca, 0x43, 0x0a, 0xeb, 0x8d,
+		0x3a, 0xe3, 0xd0, 0xdf, 0xc6, 0x7e, 0x45, 0xbd, 0x51, 0x0f, 0x73, 0x49, 0x12, 0x32, 0xce, 0x62,
+		0xd0, 0xc6, 0xd6, 0x65, 0xad, 0x8c, 0x33, 0x03, 0x94, 0xc1, 0xd1, 0x26, 0x06, 0x1b, 0xac, 0x3e,
+		0xcc, 0x43, 0xd0, 0xa1, 0x79, 0x48, 0xe1, 0xab, 0x0e, 0xd6, 0x6d, 0xcd, 0xd0, 0x33, 0x83, 0x94,
+		0xc9, 0xdd, 0x2d, 0x7a, 0x11, 0xd7, 0x2a, 0x61, 0x16, 0x1e, 0x1d, 0x3a, 0x0d, 0x83, 0x86, 0xe9,
+		0x68, 0x86, 0x6e, 0x67, 0x92, 0xb3, 0xd2, 0xb1, 0xa1, 0x93, 0x87, 0x5a, 0x3a, 0xc2, 0x1a, 0xc3,
+		0x51, 0x04, 0x32, 0x5a, 0x82, 0xb4, 0x6d, 0x34, 0xac, 0x32, 0x2e, 0x95, 0x8d, 0x0a, 0x2e, 0x69,
+		0xfa, 0xb6, 0x91, 0x49, 0x51, 0x06, 0x33, 0xcd, 0x8a, 0x50, 0xc4, 0x79, 0xa3, 0x82, 0x97, 0xf4,
+		0x6d, 0x43, 0x19, 0xb5, 0x03, 0x65, 0x74, 0x10, 0x06, 0xec, 0x3d, 0xdd, 0x51, 0xaf, 0x66, 0x86,
+		0xa9, 0x87, 0xf0, 0x92, 0xfc, 0x5b, 0x03, 0x30, 0xd6, 0x8d, 0x8b, 0x9d, 0x85, 0xfe, 0x6d, 0xa2,
+		0x65, 0x26, 0xd6, 0x8b, 0x0d, 0x18, 0x4d, 0xd0, 0x88, 0x03, 0xfb, 0x34, 0x62, 0x1e, 0x86, 0x74,
+		0x6c, 0x3b, 0xb8, 0xc2, 0x3c, 0x22, 0xde, 0xa5, 0x4f, 0x01, 0x23, 0x6a, 0x76, 0xa9, 0xc4, 0xbe,
+		0x5c, 0xea, 0x59, 0x18, 0x73, 0x45, 0x2a, 0x59, 0xaa, 0x5e, 0x15, 0xbe, 0x79, 0x22, 0x4a, 0x92,
+		0xb9, 0xa2, 0xa0, 0x53, 0x08, 0x99, 0x32, 0x8a, 0x03, 0x65, 0xb4, 0x00, 0x60, 0xe8, 0xd8, 0xd8,
+		0x2e, 0x55, 0x70, 0xb9, 0x96, 0x49, 0xb6, 0xb1, 0xd2, 0x1a, 0x41, 0x69, 0xb2, 0x92, 0xc1, 0xa0,
+		0xe5, 0x1a, 0x7a, 0xd4, 0x73, 0xb5, 0xc1, 0x36, 0x9e, 0xb2, 0xc2, 0x06, 0x59, 0x93, 0xb7, 0x5d,
+		0x84, 0x51, 0x0b, 0x13, 0xbf, 0xc7, 0x15, 0xae, 0x59, 0x8a, 0x0a, 0x31, 0x17, 0xa9, 0x99, 0xc2,
+		0xc9, 0x98, 0x62, 0x23, 0x96, 0xbf, 0x88, 0xee, 0x04, 0x17, 0x50, 0xa2, 0x6e, 0x05, 0x34, 0x0a,
+		0x0d, 0x0b, 0xe0, 0xaa, 0x5a, 0xc7, 0xd9, 0x17, 0x61, 0x34, 0x68, 0x1e, 0x34, 0x09, 0xfd, 0xb6,
+		0xa3, 0x5a, 0x0e, 0xf5, 0xc2, 0x7e, 0x85, 0x15, 0x50, 0x1a, 0xe2, 0x58, 0xaf, 0xd0, 0x28, 0xd7,
+		0xaf, 0x90, 0x7f, 0xd1, 0x1b, 0x3c, 0x85, 0xe3, 0x54, 0xe1, 0x23, 0xcd, 0x3d, 0x1a, 0xe0, 0x1c,
+		0xd6, 0x3b, 0x7b, 0x06, 0x46, 0x02, 0x0a, 0x74, 0xdb, 0xb4, 0xfc, 0x56, 0x38, 0xd0, 0x92, 0x35,
+		0x7a, 0x16, 0x26, 0x1b, 0xba, 0xa6, 0x3b, 0xd8, 0x32, 0x2d, 0x4c, 0x3c, 0x96, 0x35, 0x95, 0xf9,
+		0xcf, 0x83, 0x6d, 0x7c, 0xee, 0xa2, 0x1f, 0x9b, 0x71, 0x51, 0x26, 0x1a, 0xcd, 0xc0, 0xe3, 0xa9,
+		0xe4, 0x6b, 0x83, 0xe9, 0x97, 0x5e, 0x7a, 0xe9, 0xa5, 0x98, 0xfc, 0xd5, 0x01, 0x98, 0x6c, 0x35,
+		0x66, 0x5a, 0x0e, 0xdf, 0x83, 0x30, 0xa0, 0x37, 0xea, 0x5b, 0xd8, 0xa2, 0x46, 0xea, 0x57, 0x78,
+		0x09, 0xe5, 0xa1, 0xbf, 0xa6, 0x6e, 0xe1, 0x5a, 0x26, 0x31, 0x2b, 0x1d, 0x1b, 0x3d, 0x79, 0x6f,
+		0x57, 0xa3, 0x72, 0x6e, 0x99, 0x90, 0x28, 0x8c, 0x12, 0x3d, 0x01, 0x09, 0x1e, 0xa2, 0x09, 0x87,
+		0xe3, 0xdd, 0x71, 0x20, 0x63, 0x49, 0xa1, 0x74, 0xe8, 0x76, 0x48, 0x91, 0xbf, 0xcc, 0x37, 0x06,
+		0xa8, 0xcc, 0x49, 0x02, 0x20, 0x7e, 0x81, 0xb2, 0x90, 0xa4, 0xc3, 0xa4, 0x82, 0xc5, 0xd4, 0xe6,
+		0x96, 0x89, 0x63, 0x55, 0xf0, 0xb6, 0xda, 0xa8, 0x39, 0xa5, 0xcb, 0x6a, 0xad, 0x81, 0xa9, 0xc3,
+		0xa7, 0x94, 0x61, 0x0e, 0xbc, 0x44, 0x60, 0x68, 0x06, 0x86, 0xd8, 0xa8, 0xd2, 0xf4, 0x0a, 0xbe,
+		0x4a, 0xa3, 0x67, 0xbf, 0xc2, 0x06, 0xda, 0x12, 0x81, 0x90, 0xe6, 0x9f, 0xb7, 0x0d, 0x5d, 0xb8,
+		0x26, 0x6d, 0x82, 0x00, 0x68, 0xf3, 0x67, 0xc2, 0x81, 0xfb, 0x70, 0x6b, 0xf5, 0x9a, 0xc6, 0xd2,
+		0x51, 0x18, 0xa3, 0x18, 0x0f, 0xf1, 0xae, 0x57, 0x6b, 0x99, 0xf1, 0x59, 0xe9, 0x58, 0x52, 0x19,
+		0x65, 0xe0, 0x35, 0x0e, 0x95, 0xbf, 0x1c, 0x83, 0x04, 0x0d, 0x2c, 0x63, 0x30, 0xb4, 0xf9, 0xc6,
+		0xf5, 0x62, 0x69, 0x61, 0xed, 0x62, 0x61, 0xb9, 0x98, 0x96, 0xd0, 0x28, 0x00, 0x05, 0x9c, 0x5b,
+		0x5e, 0xcb, 0x6f, 0xa6, 0x63, 0x6e, 0x79, 0x69, 0x75, 0xf3, 0xf4, 0xc3, 0xe9, 0xb8, 0x4b, 0x70,
+		0x91, 0x01, 0x12, 0x7e, 0x84, 0x87, 0x4e, 0xa6, 0xfb, 0x51, 0x1a, 0x86, 0x19, 0x83, 0xa5, 0x67,
+		0x8b, 0x0b, 0xa7, 0x1f, 0x4e, 0x0f, 0x04, 0x21, 0x0f, 0x9d, 0x4c, 0x0f, 0xa2, 0x11, 0x48, 0x51,
+		0x48, 0x61, 0x6d, 0x6d, 0x39, 0x9d, 0x74, 0x79, 0x6e, 0x6c, 0x2a, 0x4b, 0xab, 0x8b, 0xe9, 0x94,
+		0xcb, 0x73, 0x51, 0x59, 0xbb, 0xb8, 0x9e, 0x06, 0x97, 0xc3, 0x4a, 0x71, 0x63, 0x23, 0xbf, 0x58,
+		0x4c, 0x0f, 0xb9, 0x18, 0x85, 0x37, 0x6e, 0x16, 0x37, 0xd2, 0xc3, 0x01, 0xb1, 0x1e, 0x3a, 0x99,
+		0x1e, 0x71, 0x9b, 0x28, 0xae, 0x5e, 0x5c, 0x49, 0x8f, 0xa2, 0x71, 0x18, 0x61, 0x4d, 0x08, 0x21,
+		0xc6, 0x42, 0xa0, 0xd3, 0x0f, 0xa7, 0xd3, 0x9e, 0x20, 0x8c, 0xcb, 0x78, 0x00, 0x70, 0xfa, 0xe1,
+		0x34, 0x92, 0xe7, 0xa1, 0x9f, 0xba, 0x21, 0x42, 0x30, 0xba, 0x9c, 0x2f, 0x14, 0x97, 0x4b, 0x6b,
+		0xeb, 0x9b, 0x4b, 0x6b, 0xab, 0xf9, 0xe5, 0xb4, 0xe4, 0xc1, 0x94, 0xe2, 0xd3, 0x17, 0x97, 0x94,
+		0xe2, 0x42, 0x3a, 0xe6, 0x87, 0xad, 0x17, 0xf3, 0x9b, 0xc5, 0x85, 0x74, 0x5c, 0x2e, 0xc3, 0x64,
+		0xab, 0x80, 0xda, 0x72, 0x08, 0xf9, 0x7c, 0x21, 0xd6, 0xc6, 0x17, 0x28, 0xaf, 0xb0, 0x2f, 0xc8,
+		0xdf, 0x8d, 0xc1, 0x44, 0x8b, 0x49, 0xa5, 0x65, 0x23, 0x4f, 0x42, 0x3f, 0xf3, 0x65, 0x36, 0xcd,
+		0xde, 0xd3, 0x72, 0x76, 0xa2, 0x9e, 0xdd, 0x34, 0xd5, 0x52, 0x3a, 0x7f, 0xaa, 0x11, 0x6f, 0x93,
+		0x6a, 0x10, 0x16, 0x4d, 0x0e, 0xfb, 0x0b, 0x4d, 0xc1, 0x9f, 0xcd, 0x8f, 0xa7, 0xbb, 0x99, 0x1f,
+		0x29, 0xac, 0xb7, 0x49, 0xa0, 0xbf, 0xc5, 0x24, 0x70, 0x16, 0xc6, 0x9b, 0x18, 0x75, 0x1d, 0x8c,
+		0xdf, 0x29, 0x41, 0xa6, 0x9d, 0x71, 0x22, 0x42, 0x62, 0x2c, 0x10, 0x12, 0xcf, 0x86, 0x2d, 0x78,
+		0x47, 0xfb, 0x4e, 0x68, 0xea, 0xeb, 0x4f, 0x4b, 0x70, 0xb0, 0x75, 0x4a, 0xd9, 0x52, 0x86, 0x27,
+		0x60, 0xa0, 0x8e, 0x9d, 0x1d, 0x43, 0xa4, 0x55, 0x47, 0x5a, 0x4c, 0xd6, 0xa4, 0x3a, 0xdc, 0xd9,
+		0x9c, 0x0a, 0x3d, 0x1a, 0x96, 0x75, 0xa6, 0x5d, 0x82, 0xdb, 0x24, 0xe9, 0x7b, 0x63, 0x70, 0xa0,
+		0x25, 0xf3, 0x96, 0x82, 0x1e, 0x06, 0xd0, 0x74, 0xb3, 0xe1, 0xb0, 0xd4, 0x89, 0x45, 0xe2, 0x14,
+		0x85, 0xd0, 0xe0, 0x45, 0xa2, 0x6c, 0xc3, 0x71, 0xeb, 0xe3, 0xb4, 0x1e, 0x18, 0x88, 0x22, 0x3c,
+		0xe2, 0x09, 0x9a, 0xa0, 0x82, 0x4e, 0xb7, 0xd1, 0xb4, 0xc9, 0x31, 0x1f, 0x80, 0x74, 0xb9, 0xa6,
+		0x61, 0xdd, 0x29, 0xd9, 0x8e, 0x85, 0xd5, 0xba, 0xa6, 0x57, 0xe9, 0x54, 0x93, 0xcc, 0xf5, 0x6f,
+		0xab, 0x35, 0x1b, 0x2b, 0x63, 0xac, 0x7a, 0x43, 0xd4, 0x12, 0x0a, 0xea, 0x40, 0x96, 0x8f, 0x62,
+		0x20, 0x40, 0xc1, 0xaa, 0x5d, 0x0a, 0xf9, 0x03, 0x29, 0x18, 0xf2, 0x25, 0xe0, 0xe8, 0x0e, 0x18,
+		0x7e, 0x5e, 0xbd, 0xac, 0x96, 0xc4, 0xa2, 0x8a, 0x59, 0x62, 0x88, 0xc0, 0xd6, 0x19, 0x08, 0x3d,
+		0x00, 0x93, 0x14, 0xc5, 0x68, 0x38, 0xd8, 0x2a, 0x95, 0x6b, 0xaa, 0x6d, 0x53, 0xa3, 0x25, 0x29,
+		0x2a, 0x22, 0x75, 0x6b, 0xa4, 0x6a, 0x5e, 0xd4, 0xa0, 0x53, 0x30, 0x41, 0x29, 0xea, 0x8d, 0x9a,
+		0xa3, 0x99, 0x35, 0x5c, 0x22, 0xcb, 0x3c, 0x3b, 0x03, 0x7e, 0xc9, 0xc6, 0x09, 0xc6, 0x0a, 0x47,
+		0x20, 0x12, 0xd9, 0x68, 0x01, 0x0e, 0x53, 0xb2, 0x2a, 0xd6, 0xb1, 0xa5, 0x3a, 0xb8, 0x84, 0x5f,
+		0x68, 0xa8, 0x35, 0xbb, 0xa4, 0xea, 0x95, 0xd2, 0x8e, 0x6a, 0xef, 0x64, 0x26, 0x09, 0x83, 0x42,
+		0x2c, 0x23, 0x29, 0x53, 0x04, 0x71, 0x91, 0xe3, 0x15, 0x29, 0x5a, 0x5e, 0xaf, 0x9c, 0x57, 0xed,
+		0x1d, 0x94, 0x83, 0x83, 0x94, 0x8b, 0xed, 0x58, 0x9a, 0x5e, 0x2d, 0x95, 0x77, 0x70, 0x79, 0xb7,
+		0xd4, 0x70, 0xb6, 0x1f, 0xc9, 0xdc, 0xee, 0x6f, 0x9f, 0x4a, 0xb8, 0x41, 0x71, 0xe6, 0x09, 0xca,
+		0x45, 0x67, 0xfb, 0x11, 0xb4, 0x01, 0xc3, 0xa4, 0x33, 0xea, 0xda, 0x8b, 0xb8, 0xb4, 0x6d, 0x58,
+		0x74, 0x0e, 0x1d, 0x6d, 0x11, 0x9a, 0x7c, 0x16, 0x9c, 0x5b, 0xe3, 0x04, 0x2b, 0x46, 0x05, 0xe7,
+		0xfa, 0x37, 0xd6, 0x8b, 0xc5, 0x05, 0x65, 0x48, 0x70, 0x39, 0x67, 0x58, 0xc4, 0xa1, 0xaa, 0x86,
+		0x6b, 0xe0, 0x21, 0xe6, 0x50, 0x55, 0x43, 0x98, 0xf7, 0x14, 0x4c, 0x94, 0xcb, 0x4c, 0x67, 0xad,
+		0x5c, 0xe2, 0x8b, 0x31, 0x3b, 0x93, 0x0e, 0x18, 0xab, 0x5c, 0x5e, 0x64, 0x08, 0xdc, 0xc7, 0x6d,
+		0xf4, 0x28, 0x1c, 0xf0, 0x8c, 0xe5, 0x27, 0x1c, 0x6f, 0xd2, 0x32, 0x4c, 0x7a, 0x0a, 0x26, 0xcc,
+		0xbd, 0x66, 0x42, 0x14, 0x68, 0xd1, 0xdc, 0x0b, 0x93, 0x9d, 0x81, 0x49, 0x73, 0xc7, 0x6c, 0xa6,
+		0x3b, 0xee, 0xa7, 0x43, 0xe6, 0x8e, 0x19, 0x26, 0xbc, 0x9b, 0xae, 0xcc, 0x2d, 0x5c, 0x56, 0x1d,
+		0x5c, 0xc9, 0xdc, 0xe6, 0x47, 0xf7, 0x55, 0xa0, 0x39, 0x48, 0x97, 0xcb, 0x25, 0xac, 0xab, 0x5b,
+		0x35, 0x5c, 0x52, 0x2d, 0xac, 0xab, 0x76, 0x66, 0x86, 0x22, 0x27, 0x1c, 0xab, 0x81, 0x95, 0xd1,
+		0x72, 0xb9, 0x48, 0x2b, 0xf3, 0xb4, 0x0e, 0x1d, 0x87, 0x71, 0x63, 0xeb, 0xf9, 0x32, 0xf3, 0xc8,
+		0x92, 0x69, 0xe1, 0x6d, 0xed, 0x6a, 0xe6, 0x2e, 0x6a, 0xde, 0x31, 0x52, 0x41, 0xfd, 0x71, 0x9d,
+		0x82, 0xd1, 0x3d, 0x90, 0x2e, 0xdb, 0x3b, 0xaa, 0x65, 0xd2, 0x90, 0x6c, 0x9b, 0x6a, 0x19, 0x67,
+		0xee, 0x66, 0xa8, 0x0c, 0xbe, 0x2a, 0xc0, 0x64, 0x44, 0xd8, 0x57, 0xb4, 0x6d, 0x47, 0x70, 0x3c,
+		0xca, 0x46, 0x04, 0x85, 0x71, 0x6e, 0xc7, 0x20, 0x4d, 0x2c, 0x11, 0x68, 0xf8, 0x18, 0x45, 0x1b,
+		0x35, 0x77, 0x4c, 0x7f, 0xbb, 0x77, 0xc2, 0x88, 0xb9, 0xe3, 0x6f, 0xf4, 0x1e, 0x96, 0xb8, 0x99,
+		0x3b, 0xbe, 0x16, 0x1f, 0x86, 0x83, 0x04, 0xa9, 0x8e, 0x1d, 0xb5, 0xa2, 0x3a, 0xaa, 0x0f, 0xfb,
+		0x3e, 0x8a, 0x4d, 0xcc, 0xbe, 0xc2, 0x2b, 0x03, 0x72, 0x5a, 0x8d, 0xad, 0x3d, 0xd7, 0xb1, 0xee,
+		0x67, 0x72, 0x12, 0x98, 0x70, 0xad, 0x5b, 0x96, 0x9c, 0xcb, 0x39, 0x18, 0xf6, 0xfb, 0x3d, 0x4a,
+		0x01, 0xf3, 0xfc, 0xb4, 0x44, 0x92, 0xa0, 0xf9, 0xb5, 0x05, 0x92, 0xbe, 0x3c, 0x57, 0x4c, 0xc7,
+		0x48, 0x1a, 0xb5, 0xbc, 0xb4, 0x59, 0x2c, 0x29, 0x17, 0x57, 0x37, 0x97, 0x56, 0x8a, 0xe9, 0xb8,
+		0x2f, 0xb1, 0x7f, 0x2a, 0x91, 0x3c, 0x92, 0x3e, 0x2a, 0x7f, 0x2b, 0x06, 0xa3, 0xc1, 0x95, 0x1a,
+		0x7a, 0x0c, 0x6e, 0x13, 0xdb, 0x2a, 0x36, 0x76, 0x4a, 0x57, 0x34, 0x8b, 0x0e, 0xc8, 0xba, 0xca,
+		0x26, 0x47, 0xd7, 0x7f, 0x26, 0x39, 0xd6, 0x06, 0x76, 0x9e, 0xd1, 0x2c, 0x32, 0xdc, 0xea, 0xaa,
+		0x83, 0x96, 0x61, 0x46, 0x37, 0x4a, 0xb6, 0xa3, 0xea, 0x15, 0xd5, 0xaa, 0x94, 0xbc, 0x0d, 0xad,
+		0x92, 0x5a, 0x2e, 0x63, 0xdb, 0x36, 0xd8, 0x44, 0xe8, 0x72, 0x39, 0xa4, 0x1b, 0x1b, 0x1c, 0xd9,
+		0x9b, 0x21, 0xf2, 0x1c, 0x35, 0xe4, 0xbe, 0xf1, 0x76, 0xee, 0x7b, 0x3b, 0xa4, 0xea, 0xaa, 0x59,
+		0xc2, 0xba, 0x63, 0xed, 0xd1, 0xfc, 0x3c, 0xa9, 0x24, 0xeb, 0xaa, 0x59, 0x24, 0xe5, 0x9f, 0xc8,
+		0x32, 0xe9, 0xa9, 0x44, 0x32, 0x99, 0x4e, 0x3d, 0x95, 0x48, 0xa6, 0xd2, 0x20, 0xbf, 0x1a, 0x87,
+		0x61, 0x7f, 0xbe, 0x4e, 0x96, 0x3f, 0x65, 0x3a, 0x63, 0x49, 0x34, 0xa6, 0xdd, 0xd9, 0x31, 0xbb,
+		0x9f, 0x9b, 0x27, 0x53, 0x59, 0x6e, 0x80, 0x25, 0xc7, 0x0a, 0xa3, 0x24, 0x69, 0x04, 0x71, 0x36,
+		0xcc, 0x92, 0x91, 0xa4, 0xc2, 0x4b, 0x68, 0x11, 0x06, 0x9e, 0xb7, 0x29, 0xef, 0x01, 0xca, 0xfb,
+		0xae, 0xce, 0xbc, 0x9f, 0xda, 0xa0, 0xcc, 0x53, 0x4f, 0x6d, 0x94, 0x56, 0xd7, 0x94, 0x95, 0xfc,
+		0xb2, 0xc2, 0xc9, 0xd1, 0x14, 0x24, 0x6a, 0xea, 0x8b, 0x7b, 0xc1, 0x49, 0x8f, 0x82, 0xba, 0xed,
+		0x84, 0x29, 0x48, 0x90, 0x0d, 0xba, 0xe0, 0x54, 0x43, 0x41, 0xb7, 0x70, 0x30, 0x9c, 0x80, 0x7e,
+		0x6a, 0x2f, 0x04, 0xc0, 0x2d, 0x96, 0xee, 0x43, 0x49, 0x48, 0xcc, 0xaf, 0x29, 0x64, 0x40, 0xa4,
+		0x61, 0x98, 0x41, 0x4b, 0xeb, 0x4b, 0xc5, 0xf9, 0x62, 0x3a, 0x26, 0x9f, 0x82, 0x01, 0x66, 0x04,
+		0x32, 0x58, 0x5c, 0x33, 0xa4, 0xfb, 0x78, 0x91, 0xf3, 0x90, 0x44, 0xed, 0xc5, 0x95, 0x42, 0x51,
+		0x49, 0xc7, 0x82, 0x5d, 0x9d, 0x48, 0xf7, 0xcb, 0x36, 0x0c, 0xfb, 0xf3, 0xf0, 0x9f, 0xcc, 0x62,
+		0xfc, 0x2b, 0x12, 0x0c, 0xf9, 0xf2, 0x6a, 0x92, 0x10, 0xa9, 0xb5, 0x9a, 0x71, 0xa5, 0xa4, 0xd6,
+		0x34, 0xd5, 0xe6, 0xae, 0x01, 0x14, 0x94, 0x27, 0x90, 0x6e, 0xbb, 0xee, 0x27, 0x34, 0x44, 0xfa,
+		0xd3, 0x03, 0xf2, 0xc7, 0x25, 0x48, 0x87, 0x13, 0xdb, 0x90, 0x98, 0xd2, 0x4f, 0x53, 0x4c, 0xf9,
+		0x63, 0x12, 0x8c, 0x06, 0xb3, 0xd9, 0x90, 0x78, 0x77, 0xfc, 0x54, 0xc5, 0xfb, 0x4e, 0x0c, 0x46,
+		0x02, 0x39, 0x6c, 0xb7, 0xd2, 0xbd, 0x00, 0xe3, 0x5a, 0x05, 0xd7, 0x4d, 0xc3, 0x21, 0x9b, 0xe7,
+		0xa5, 0x1a, 0xbe, 0x8c, 0x6b, 0x19, 0x99, 0x06, 0x8d, 0x13, 0x9d, 0xb3, 0xe4, 0xb9, 0x25, 0x8f,
+		0x6e, 0x99, 0x90, 0xe5, 0x26, 0x96, 0x16, 0x8a, 0x2b, 0xeb, 0x6b, 0x9b, 0xc5, 0xd5, 0xf9, 0x37,
+		0x96, 0x2e, 0xae, 0x5e, 0x58, 0x5d, 0x7b, 0x66, 0x55, 0x49, 0x6b, 0x21, 0xb4, 0x5b, 0x38, 0xec,
+		0xd7, 0x21, 0x1d, 0x16, 0x0a, 0xdd, 0x06, 0xad, 0xc4, 0x4a, 0xf7, 0xa1, 0x09, 0x18, 0x5b, 0x5d,
+		0x2b, 0x6d, 0x2c, 0x2d, 0x14, 0x4b, 0xc5, 0x73, 0xe7, 0x8a, 0xf3, 0x9b, 0x1b, 0x6c, 0xdf, 0xc3,
+		0xc5, 0xde, 0x0c, 0x0c, 0x70, 0xf9, 0x23, 0x71, 0x98, 0x68, 0x21, 0x09, 0xca, 0xf3, 0x15, 0x0b,
+		0x5b, 0x44, 0xdd, 0xdf, 0x8d, 0xf4, 0x73, 0x24, 0x67, 0x58, 0x57, 0x2d, 0x87, 0x2f, 0x70, 0xee,
+		0x01, 0x62, 0x25, 0xdd, 0xd1, 0xb6, 0x35, 0x6c, 0xf1, 0xfd, 0x24, 0xb6, 0x8c, 0x19, 0xf3, 0xe0,
+		0x6c, 0x4b, 0xe9, 0x3e, 0x40, 0xa6, 0x61, 0x6b, 0x8e, 0x76, 0x99, 0x6c, 0xc9, 0x8b, 0xcd, 0x27,
+		0xb2, 0xac, 0x49, 0x28, 0x69, 0x51, 0xb3, 0xa4, 0x3b, 0x2e, 0xb6, 0x8e, 0xab, 0x6a, 0x08, 0x9b,
+		0x04, 0xf3, 0xb8, 0x92, 0x16, 0x35, 0x2e, 0xf6, 0x1d, 0x30, 0x5c, 0x31, 0x1a, 0x24, 0xd7, 0x63,
+		0x78, 0x64, 0xee, 0x90, 0x94, 0x21, 0x06, 0x73, 0x51, 0x78, 0x16, 0xef, 0xed, 0x7a, 0x0d, 0x2b,
+		0x43, 0x0c, 0xc6, 0x50, 0x8e, 0xc2, 0x98, 0x5a, 0xad, 0x5a, 0x84, 0xb9, 0x60, 0xc4, 0xd6, 0x25,
+		0xa3, 0x2e, 0x98, 0x22, 0x66, 0x9f, 0x82, 0xa4, 0xb0, 0x03, 0x99, 0xaa, 0x89, 0x25, 0x4a, 0x26,
+		0x5b, 0x6c, 0xc7, 0xc8, 0x46, 0x98, 0x2e, 0x2a, 0xef, 0x80, 0x61, 0xcd, 0x2e, 0x79, 0x9b, 0xf8,
+		0xb1, 0xd9, 0xd8, 0xb1, 0xa4, 0x32, 0xa4, 0xd9, 0xee, 0x06, 0xa8, 0xfc, 0xe9, 0x18, 0x8c, 0x06,
+		0x0f, 0x21, 0xd0, 0x02, 0x24, 0x6b, 0x46, 0x59, 0xa5, 0xae, 0xc5, 0x4e, 0xc0, 0x8e, 0x45, 0x9c,
+		0x5b, 0xcc, 0x2d, 0x73, 0x7c, 0xc5, 0xa5, 0xcc, 0xfe, 0xa1, 0x04, 0x49, 0x01, 0x46, 0x07, 0x21,
+		0x61, 0xaa, 0xce, 0x0e, 0x65, 0xd7, 0x5f, 0x88, 0xa5, 0x25, 0x85, 0x96, 0x09, 0xdc, 0x36, 0x55,
+		0x3d, 0x13, 0xf3, 0xe0, 0xa4, 0x4c, 0xfa, 0xb5, 0x86, 0xd5, 0x0a, 0x5d, 0xf4, 0x18, 0xf5, 0x3a,
+		0xd6, 0x1d, 0x5b, 0xf4, 0x2b, 0x87, 0xcf, 0x73, 0x30, 0x39, 0x0b, 0x73, 0x2c, 0x55, 0xab, 0x05,
+		0x70, 0x13, 0x14, 0x37, 0x2d, 0x2a, 0x5c, 0xe4, 0x1c, 0x4c, 0x09, 0xbe, 0x15, 0xec, 0xa8, 0xe5,
+		0x1d, 0x5c, 0xf1, 0x88, 0x06, 0xe8, 0xe6, 0xc6, 0x6d, 0x1c, 0x61, 0x81, 0xd7, 0x0b, 0x5a, 0xf9,
+		0x5b, 0x12, 0x8c, 0x8b, 0x65, 0x5a, 0xc5, 0x35, 0xd6, 0x0a, 0x80, 0xaa, 0xeb, 0x86, 0xe3, 0x37,
+		0x57, 0xb3, 0x2b, 0x37, 0xd1, 0xcd, 0xe5, 0x5d, 0x22, 0xc5, 0xc7, 0x20, 0x5b, 0x07, 0xf0, 0x6a,
+		0xda, 0x9a, 0x6d, 0x06, 0x86, 0xf8, 0x09, 0x13, 0x3d, 0xa6, 0x64, 0x0b, 0x7b, 0x60, 0x20, 0xb2,
+		0x9e, 0x23, 0xdb, 0x2f, 0x5b, 0xb8, 0xaa, 0xe9, 0x7c, 0xdf, 0x98, 0x15, 0xc4, 0xf6, 0x4b, 0xc2,
+		0xdd, 0x7e, 0x29, 0xfc, 0x75, 0x98, 0x28, 0x1b, 0xf5, 0xb0, 0xb8, 0x85, 0x74, 0x68, 0x73, 0xc1,
+		0x3e, 0x2f, 0x3d, 0x77, 0x3f, 0x47, 0xaa, 0x1a, 0x35, 0x55, 0xaf, 0xce, 0x19, 0x56, 0xd5, 0x3b,
+		0x66, 0x25, 0x19, 0x8f, 0xed, 0x3b, 0x6c, 0x35, 0xb7, 0xfe, 0x5c, 0x92, 0x3e, 0x19, 0x8b, 0x2f,
+		0xae, 0x17, 0x3e, 0x1b, 0xcb, 0x2e, 0x32, 0xc2, 0x75, 0x61, 0x0c, 0x05, 0x6f, 0xd7, 0x70, 0x99,
+		0x28, 0x08, 0xdf, 0xbf, 0x17, 0x26, 0xab, 0x46, 0xd5, 0xa0, 0x9c, 0x4e, 0x90, 0xff, 0xf8, 0x39,
+		0x6d, 0xca, 0x85, 0x66, 0x23, 0x0f, 0x75, 0x73, 0xab, 0x30, 0xc1, 0x91, 0x4b, 0xf4, 0xa0, 0x88,
+		0x2d, 0x63, 0x50, 0xc7, 0x3d, 0xb4, 0xcc, 0x17, 0xbe, 0x47, 0xa7, 0x6f, 0x65, 0x9c, 0x93, 0x92,
+		0x3a, 0xb6, 0xd2, 0xc9, 0x29, 0x70, 0x20, 0xc0, 0x8f, 0x0d, 0x52, 0x6c, 0x45, 0x70, 0xfc, 0x5d,
+		0xce, 0x71, 0xc2, 0xc7, 0x71, 0x83, 0x93, 0xe6, 0xe6, 0x61, 0xa4, 0x17, 0x5e, 0xff, 0x82, 0xf3,
+		0x1a, 0xc6, 0x7e, 0x26, 0x8b, 0x30, 0x46, 0x99, 0x94, 0x1b, 0xb6, 0x63, 0xd4, 0x69, 0x04, 0xec,
+		0xcc, 0xe6, 0xf7, 0xbe, 0xc7, 0x46, 0xcd, 0x28, 0x21, 0x9b, 0x77, 0xa9, 0x72, 0x39, 0xa0, 0x67,
+		0x63, 0xe4, 0xcc, 0x2a, 0x82, 0xc3, 0xd7, 0xb8, 0x20, 0x2e, 0x7e, 0xee, 0x12, 0x4c, 0x92, 0xff,
+		0x69, 0x80, 0xf2, 0x4b, 0x12, 0xbd, 0xe1, 0x96, 0xf9, 0xd6, 0x3b, 0xd9, 0xc0, 0x9c, 0x70, 0x19,
+		0xf8, 0x64, 0xf2, 0xf5, 0x62, 0x15, 0x3b, 0x0e, 0xb6, 0xec, 0x92, 0x5a, 0x6b, 0x25, 0x9e, 0x6f,
+		0xc7, 0x22, 0xf3, 0xe1, 0x1f, 0x04, 0x7b, 0x71, 0x91, 0x51, 0xe6, 0x6b, 0xb5, 0xdc, 0x45, 0xb8,
+		0xad, 0x85, 0x57, 0x74, 0xc1, 0xf3, 0x23, 0x9c, 0xe7, 0x64, 0x93, 0x67, 0x10, 0xb6, 0xeb, 0x20,
+		0xe0, 0x6e, 0x5f, 0x76, 0xc1, 0xf3, 0xa3, 0x9c, 0x27, 0xe2, 0xb4, 0xa2, 0x4b, 0x09, 0xc7, 0xa7,
+		0x60, 0xfc, 0x32, 0xb6, 0xb6, 0x0c, 0x9b, 0xef, 0x12, 0x75, 0xc1, 0xee, 0x63, 0x9c, 0xdd, 0x18,
+		0x27, 0xa4, 0xdb, 0x46, 0x84, 0xd7, 0xa3, 0x90, 0xdc, 0x56, 0xcb, 0xb8, 0x0b, 0x16, 0xd7, 0x38,
+		0x8b, 0x41, 0x82, 0x4f, 0x48, 0xf3, 0x30, 0x5c, 0x35, 0xf8, 0x1c, 0x15, 0x4d, 0xfe, 0x71, 0x4e,
+		0x3e, 0x24, 0x68, 0x38, 0x0b, 0xd3, 0x30, 0x1b, 0x35, 0x32, 0x81, 0x45, 0xb3, 0xf8, 0xbb, 0x82,
+		0x85, 0xa0, 0xe1, 0x2c, 0x7a, 0x30, 0xeb, 0x27, 0x04, 0x0b, 0xdb, 0x67, 0xcf, 0x27, 0xc9, 0xe1,
+		0x51, 0x6d, 0xcf, 0xd0, 0xbb, 0x11, 0xe2, 0x15, 0xce, 0x01, 0x38, 0x09, 0x61, 0x70, 0x16, 0x52,
+		0xdd, 0x76, 0xc4, 0xdf, 0xfb, 0x81, 0x18, 0x1e, 0xa2, 0x07, 0x16, 0x61, 0x4c, 0x04, 0x28, 0x72,
+		0xd8, 0x1c, 0xcd, 0xe2, 0xef, 0x73, 0x16, 0xa3, 0x3e, 0x32, 0xae, 0x86, 0x83, 0x6d, 0xa7, 0x8a,
+		0xbb, 0x61, 0xf2, 0x69, 0xa1, 0x06, 0x27, 0xe1, 0xa6, 0xdc, 0xc2, 0x7a, 0x79, 0xa7, 0x3b, 0x0e,
+		0x9f, 0x11, 0xa6, 0x14, 0x34, 0x84, 0xc5, 0x3c, 0x8c, 0xd4, 0x55, 0xcb, 0xde, 0x51, 0x6b, 0x5d,
+		0x75, 0xc7, 0x3f, 0xe0, 0x3c, 0x86, 0x5d, 0x22, 0x6e, 0x91, 0x86, 0xde, 0x0b, 0x9b, 0xcf, 0x0a,
+		0x8b, 0x34, 0xf4, 0x00, 0xa3, 0x75, 0x98, 0xb4, 0x1d, 0xba, 0xa5, 0xd6, 0x0b, 0xb7, 0x5f, 0x13,
+		0x43, 0x8f, 0xd1, 0xae, 0xf8, 0x39, 0x9e, 0x85, 0x94, 0xad, 0xbd, 0xd8, 0x15, 0x9b, 0xcf, 0x89,
+		0x9e, 0xa6, 0x04, 0x84, 0xf8, 0x8d, 0x30, 0xd5, 0x72, 0x9a, 0xe8, 0x82, 0xd9, 0x3f, 0xe4, 0xcc,
+		0x0e, 0xb6, 0x98, 0x2a, 0x78, 0x48, 0xe8, 0x95, 0xe5, 0x3f, 0x12, 0x21, 0x01, 0x87, 0x78, 0xad,
+		0x93, 0x55, 0x83, 0xad, 0x6e, 0xf7, 0x66, 0xb5, 0x5f, 0x17, 0x56, 0x63, 0xb4, 0x01, 0xab, 0x6d,
+		0xc2, 0x41, 0xce, 0xb1, 0xb7, 0x7e, 0xfd, 0xbc, 0x08, 0xac, 0x8c, 0xfa, 0x62, 0xb0, 0x77, 0xdf,
+		0x04, 0x59, 0xd7, 0x9c, 0x22, 0x3d, 0xb5, 0x4b, 0x64, 0x1f, 0x2a, 0x9a, 0xf3, 0x17, 0x38, 0x67,
+		0x11, 0xf1, 0xdd, 0xfc, 0xd6, 0x5e, 0x51, 0x4d, 0xc2, 0xfc, 0x59, 0xc8, 0x08, 0xe6, 0x0d, 0xdd,
+		0xc2, 0x65, 0xa3, 0xaa, 0x6b, 0x2f, 0xe2, 0x4a, 0x17, 0xac, 0x7f, 0x23, 0xd4, 0x55, 0x17, 0x7d,
+		0xe4, 0x84, 0xf3, 0x12, 0xa4, 0xdd, 0x5c, 0xa5, 0xa4, 0xd5, 0x4d, 0xc3, 0x72, 0x22, 0x38, 0x7e,
+		0x51, 0xf4, 0x94, 0x4b, 0xb7, 0x44, 0xc9, 0x72, 0x45, 0x60, 0xe7, 0xcc, 0xdd, 0xba, 0xe4, 0x97,
+		0x38, 0xa3, 0x11, 0x8f, 0x8a, 0x07, 0x8e, 0xb2, 0x51, 0x37, 0x55, 0xab, 0x9b, 0xf8, 0xf7, 0x9b,
+		0x22, 0x70, 0x70, 0x12, 0x1e, 0x38, 0x48, 0x46, 0x47, 0x66, 0xfb, 0x2e, 0x38, 0x7c, 0x59, 0x04,
+		0x0e, 0x41, 0xc3, 0x59, 0x88, 0x84, 0xa1, 0x0b, 0x16, 0xff, 0x58, 0xb0, 0x10, 0x34, 0x84, 0xc5,
+		0xd3, 0xde, 0x44, 0x6b, 0xe1, 0xaa, 0x66, 0x3b, 0x16, 0x4b, 0x8a, 0x3b, 0xb3, 0xfa, 0x27, 0x3f,
+		0x08, 0x26, 0x61, 0x8a, 0x8f, 0x94, 0x44, 0x22, 0xbe, 0xc9, 0x4a, 0xd7, 0x4c, 0xd1, 0x82, 0xfd,
+		0x96, 0x88, 0x44, 0x3e, 0x32, 0x22, 0x9b, 0x2f, 0x43, 0x24, 0x66, 0x2f, 0x93, 0x95, 0x42, 0x17,
+		0xec, 0xfe, 0x69, 0x48, 0xb8, 0x0d, 0x41, 0x4b, 0x78, 0xfa, 0xf2, 0x9f, 0x86, 0xbe, 0x8b, 0xf7,
+		0xba, 0xf2, 0xce, 0x7f, 0x16, 0xca, 0x7f, 0x2e, 0x32, 0x4a, 0x16, 0x43, 0xc6, 0x42, 0xf9, 0x14,
+		0x8a, 0xba, 0x55, 0x94, 0x79, 0xfb, 0x8f, 0xb8, 0xbe, 0xc1, 0x74, 0x2a, 0xb7, 0x0c, 0x69, 0x0e,
+		0xf1, 0x12, 0xd8, 0x48, 0x66, 0xef, 0xfc, 0x91, 0xeb, 0xe7, 0x81, 0x9c, 0x27, 0x77, 0x0e, 0x46,
+		0x02, 0x09, 0x4f, 0x34, 0xab, 0x77, 0x71, 0x56, 0xc3, 0xfe, 0x7c, 0x27, 0x77, 0x0a, 0x12, 0x24,
+		0x79, 0x89, 0x26, 0xff, 0x1b, 0x9c, 0x9c, 0xa2, 0xe7, 0x1e, 0x87, 0xa4, 0x48, 0x5a, 0xa2, 0x49,
+		0xdf, 0xcd, 0x49, 0x5d, 0x12, 0x42, 0x2e, 0x12, 0x96, 0x68, 0xf2, 0xbf, 0x29, 0xc8, 0x05, 0x09,
+		0x21, 0xef, 0xde, 0x84, 0x5f, 0xf9, 0xc5, 0x04, 0x23, 0x17, 0x24, 0x39, 0x72, 0xce, 0xcd, 0x32,
+		0x95, 0x68, 0xea, 0xf7, 0xf2, 0xc6, 0x05, 0x45, 0xee, 0x0c, 0xf4, 0x77, 0x69, 0xf0, 0x5f, 0xe2,
+		0xa4, 0x0c, 0x3f, 0x37, 0x0f, 0x43, 0xbe, 0xec, 0x24, 0x9a, 0xfc, 0x6f, 0x71, 0x72, 0x3f, 0x15,
+		0x11, 0x9d, 0x67, 0x27, 0xd1, 0x0c, 0xde, 0x27, 0x44, 0xe7, 0x14, 0xc4, 0x6c, 0x22, 0x31, 0x89,
+		0xa6, 0x7e, 0xbf, 0xb0, 0xba, 0x20, 0xc9, 0x3d, 0x09, 0x29, 0x77, 0xb2, 0x89, 0xa6, 0xff, 0x00,
+		0xa7, 0xf7, 0x68, 0x88, 0x05, 0x1a, 0x7a, 0x0f, 0x2c, 0xfe, 0xb6, 0xb0, 0x80, 0x8f, 0x8a, 0x0c,
+		0xa3, 0x70, 0x02, 0x13, 0xcd, 0xe9, 0x83, 0x62, 0x18, 0x85, 0xf2, 0x17, 0xd2, 0x9b, 0x34, 0xe6,
+		0x47, 0xb3, 0xf8, 0x3b, 0xa2, 0x37, 0x29, 0x3e, 0x11, 0x23, 0x9c, 0x11, 0x44, 0xf3, 0xf8, 0x15,
+		0x21, 0x46, 0x28, 0x21, 0xc8, 0xad, 0x03, 0x6a, 0xce, 0x06, 0xa2, 0xf9, 0x7d, 0x88, 0xf3, 0x1b,
+		0x6f, 0x4a, 0x06, 0x72, 0xcf, 0xc0, 0xc1, 0xd6, 0x99, 0x40, 0x34, 0xd7, 0x0f, 0xff, 0x28, 0xb4,
+		0x76, 0xf3, 0x27, 0x02, 0xb9, 0x4d, 0x98, 0x6c, 0x95, 0x05, 0x44, 0xb3, 0xfd, 0xc8, 0x8f, 0x82,
+		0x81, 0xdb, 0x9f, 0x04, 0xe4, 0xf2, 0x00, 0xde, 0x04, 0x1c, 0xcd, 0xeb, 0x63, 0x9c, 0x97, 0x8f,
+		0x88, 0x0c, 0x0d, 0x3e, 0xff, 0x46, 0xd3, 0x5f, 0x13, 0x43, 0x83, 0x53, 0x90, 0xa1, 0x21, 0xa6,
+		0xde, 0x68, 0xea, 0x8f, 0x8b, 0xa1, 0x21, 0x48, 0x88, 0x67, 0xfb, 0x66, 0xb7, 0x68, 0x0e, 0xaf,
+		0x08, 0xcf, 0xf6, 0x51, 0xe5, 0x56, 0x61, 0xbc, 0x69, 0x42, 0x8c, 0x66, 0xf5, 0x49, 0xce, 0x2a,
+		0x1d, 0x9e, 0x0f, 0xfd, 0x93, 0x17, 0x9f, 0x0c, 0xa3, 0xb9, 0x7d, 0x2a, 0x34, 0x79, 0xf1, 0xb9,
+		0x30, 0x77, 0x16, 0x92, 0x7a, 0xa3, 0x56, 0x23, 0x83, 0x07, 0x75, 0xbe, 0x09, 0x98, 0xf9, 0x2f,
+		0x3f, 0xe6, 0xd6, 0x11, 0x04, 0xb9, 0x53, 0xd0, 0x8f, 0xeb, 0x5b, 0xb8, 0x12, 0x45, 0xf9, 0xfd,
+		0x1f, 0x8b, 0x80, 0x49, 0xb0, 0x73, 0x4f, 0x02, 0xb0, 0xad, 0x11, 0x7a, 0x18, 0x18, 0x41, 0xfb,
+		0x5f, 0x7f, 0xcc, 0xaf, 0xde, 0x78, 0x24, 0x1e, 0x03, 0x76, 0x91, 0xa7, 0x33, 0x83, 0x1f, 0x04,
+		0x19, 0xd0, 0x1e, 0x79, 0x14, 0x06, 0xc9, 0x85, 0x48, 0x47, 0xad, 0x46, 0x51, 0xff, 0x37, 0x4e,
+		0x2d, 0xf0, 0x89, 0xc1, 0xea, 0x86, 0x85, 0x1d, 0xb5, 0x6a, 0x47, 0xd1, 0xfe, 0x77, 0x4e, 0xeb,
+		0x12, 0x10, 0xe2, 0xb2, 0x6a, 0x3b, 0xdd, 0xe8, 0xfd, 0x67, 0x82, 0x58, 0x10, 0x10, 0xa1, 0xc9,
+		0xff, 0xbb, 0x78, 0x2f, 0x8a, 0xf6, 0x87, 0x42, 0x68, 0x8e, 0x9f, 0x7b, 0x1c, 0x52, 0xe4, 0x5f,
+		0x76, 0x9f, 0x2e, 0x82, 0xf8, 0x7f, 0x70, 0x62, 0x8f, 0x82, 0xb4, 0x6c, 0x3b, 0x15, 0x47, 0x8b,
+		0x36, 0xf6, 0x0d, 0xde, 0xd3, 0x02, 0x3f, 0x97, 0x87, 0x21, 0xdb, 0xa9, 0x54, 0x1a, 0x3c, 0x3f,
+		0x8d, 0x20, 0xff, 0x9f, 0x3f, 0x76, 0xb7, 0x2c, 0x5c, 0x1a, 0xd2, 0xdb, 0x57, 0x76, 0x1d, 0xd3,
+		0xa0, 0x07, 0x1e, 0x51, 0x1c, 0x7e, 0xc4, 0x39, 0xf8, 0x48, 0x72, 0xf3, 0x30, 0x4c, 0x74, 0xb1,
+		0xb0, 0x89, 0xe9, 0xe9, 0x54, 0x04, 0x8b, 0xff, 0xc5, 0x0d, 0x10, 0x20, 0x2a, 0xfc, 0xc2, 0xd7,
+		0x5e, 0x9d, 0x96, 0xbe, 0xf9, 0xea, 0xb4, 0xf4, 0x9d, 0x57, 0xa7, 0xa5, 0xf7, 0x7f, 0x77, 0xba,
+		0xef, 0x9b, 0xdf, 0x9d, 0xee, 0xfb, 0xa3, 0xef, 0x4e, 0xf7, 0xb5, 0xde, 0x25, 0x86, 0x45, 0x63,
+		0xd1, 0x60, 0xfb, 0xc3, 0xcf, 0xc9, 0x55, 0xcd, 0xd9, 0x69, 0x6c, 0xcd, 0x95, 0x8d, 0x3a, 0xdd,
+		0xc6, 0xf5, 0x76, 0x6b, 0xdd, 0x45, 0x0e, 0xbc, 0x23, 0x0e, 0x53, 0x65, 0xc3, 0xae, 0x1b, 0x76,
+		0x89, 0xed, 0xf7, 0xb2, 0x02, 0x63, 0x88, 0x86, 0xfd, 0x55, 0x5d, 0x6c, 0xfa, 0x9e, 0x87, 0x51,
+		0xaa, 0x3a, 0xdd, 0xee, 0xa2, 0xde, 0x16, 0x19, 0x20, 0xbe, 0xfe, 0x6f, 0xfb, 0xa9, 0xd6, 0x23,
+		0x2e, 0x21, 0x3d, 0xbd, 0xdf, 0x84, 0x49, 0xad, 0x6e, 0xd6, 0x30, 0xdd, 0xe6, 0x2f, 0xb9, 0x75,
+		0xd1, 0xfc, 0xbe, 0xc1, 0xf9, 0x4d, 0x78, 0xe4, 0x4b, 0x82, 0x3a, 0xb7, 0x0c, 0xe3, 0xe4, 0x86,
+		0x86, 0x19, 0x60, 0x19, 0xd1, 0x2d, 0x42, 0xc0, 0x34, 0xa7, 0x74, 0xb9, 0x15, 0x9e, 0x6c, 0xd7,
+		0x35, 0xcf, 0xdd, 0xed, 0xb3, 0xbc, 0x85, 0xab, 0x58, 0xbf, 0x5f, 0xc7, 0xce, 0x15, 0xc3, 0xda,
+		0xe5, 0xe6, 0xbd, 0x9f, 0x35, 0x35, 0x40, 0xff, 0x3c, 0x04, 0xef, 0x8a, 0xc3, 0x34, 0xab, 0x38,
+		0xb1, 0xa5, 0xda, 0xf8, 0xc4, 0xe5, 0x07, 0xb7, 0xb0, 0xa3, 0x3e, 0x78, 0xa2, 0x6c, 0x68, 0x3a,
+		0xef, 0x89, 0x09, 0xde, 0x2f, 0xa4, 0x7e, 0x8e, 0xd7, 0x67, 0x5b, 0x6e, 0xd3, 0xcb, 0x8b, 0x90,
+		0x98, 0x37, 0x34, 0x9d, 0x9c, 0x37, 0x54, 0xb0, 0x6e, 0xd4, 0xf9, 0x9d, 0x3b, 0x56, 0x40, 0x77,
+		0xc2, 0x80, 0x5a, 0x37, 0x1a, 0xba, 0xc3, 0x4e, 0x28, 0x0a, 0x43, 0x5f, 0xbb, 0x3e, 0xd3, 0xf7,
+		0xc7, 0xd7, 0x67, 0xe2, 0x4b, 0xba, 0xa3, 0xf0, 0xaa, 0x5c, 0xe2, 0xb5, 0x4f, 0xcc, 0x48, 0xf2,
+		0x53, 0x30, 0xb8, 0x80, 0xcb, 0xfb, 0xe1, 0xb5, 0x80, 0xcb, 0x21, 0x5e, 0xf7, 0x40, 0x72, 0x49,
+		0x77, 0xd8, 0xad, 0xc8, 0xc3, 0x10, 0xd7, 0x74, 0x76, 0xd1, 0x26, 0xd4, 0x3e, 0x81, 0x13, 0xd4,
+		0x05, 0x5c, 0x76, 0x51, 0x2b, 0xb8, 0x9c, 0x91, 0x9a, 0xd9, 0x13, 0x78, 0x61, 0xe1, 0x8f, 0xfe,
+		0xe3, 0x74, 0xdf, 0x4b, 0xaf, 0x4e, 0xf7, 0xb5, 0xed, 0x09, 0xff, 0x18, 0xe0, 0x26, 0xe6, 0x5d,
+		0x60, 0x57, 0x76, 0xd9, 0x19, 0x89, 0xdb, 0x0d, 0x7f, 0x30, 0x00, 0x32, 0xc7, 0xb1, 0x1d, 0x75,
+		0x57, 0xd3, 0xab, 0x6e, 0x4f, 0xa8, 0x0d, 0x67, 0xe7, 0x45, 0xde, 0x15, 0x07, 0x79, 0x57, 0x70,
+		0x9c, 0xce, 0xbd, 0x91, 0x6d, 0x3f, 0xba, 0xb2, 0x11, 0x7d, 0x2e, 0xff, 0xcb, 0x38, 0xa0, 0x0d,
+		0x47, 0xdd, 0xc5, 0xf9, 0x86, 0xb3, 0x63, 0x58, 0xda, 0x8b, 0x2c, 0x96, 0x61, 0x80, 0xba, 0x7a,
+		0xb5, 0xe4, 0x18, 0xbb, 0x58, 0xb7, 0xa9, 0x69, 0x86, 0x4e, 0x4e, 0xcd, 0xb5, 0xf0, 0x8f, 0x39,
+		0xd2, 0x75, 0x85, 0x7b, 0x3f, 0xfb, 0xed, 0x99, 0xa3, 0xd1, 0x56, 0xa0, 0xc8, 0x24, 0xb9, 0xbe,
+		0xba, 0x49, 0x19, 0xa3, 0x4b, 0xc0, 0x2e, 0x59, 0x94, 0x6a, 0x9a, 0xed, 0xf0, 0x7b, 0xda, 0xa7,
+		0xe6, 0x5a, 0xeb, 0x3e, 0xd7, 0x2c, 0xe6, 0xdc, 0x25, 0xb5, 0xa6, 0x55, 0x54, 0xc7, 0xb0, 0xec,
+		0xf3, 0x7d, 0x4a, 0x8a, 0xb2, 0x5a, 0xd6, 0x6c, 0x07, 0x6d, 0x42, 0xaa, 0x82, 0xf5, 0x3d, 0xc6,
+		0x36, 0xfe, 0xfa, 0xd8, 0x26, 0x09, 0x27, 0xca, 0xf5, 0x59, 0x40, 0xaa, 0x1f, 0x4f, 0x3c, 0x4c,
+		0x62, 0xf7, 0x2b, 0xdb, 0xb0, 0x0f, 0x70, 0xa6, 0xef, 0x28, 0xc6, 0xd5, 0x30, 0x28, 0x7b, 0x04,
+		0xc0, 0x6b, 0x93, 0xbc, 0x0f, 0x54, 0x2b, 0x15, 0x0b, 0xdb, 0x36, 0x3d, 0x00, 0x4c, 0x29, 0xa2,
+		0x98, 0x1b, 0xff, 0x57, 0x5f, 0xba, 0x7f, 0x24, 0xc0, 0xb1, 0x30, 0x0c, 0x70, 0xd9, 0x25, 0x3d,
+		0xfe, 0x71, 0x09, 0xc6, 0x9b, 0x5a, 0x44, 0x32, 0x4c, 0xe7, 0x2f, 0x6e, 0x9e, 0x5f, 0x53, 0x96,
+		0x9e, 0xcb, 0x93, 0x4b, 0xf7, 0x25, 0x76, 0xe5, 0x7f, 0x75, 0x63, 0xbd, 0x38, 0xbf, 0x74, 0x6e,
+		0xa9, 0xb8, 0x90, 0xee, 0x43, 0x33, 0x70, 0x7b, 0x0b, 0x9c, 0x85, 0xe2, 0x72, 0x71, 0x31, 0xbf,
+		0x49, 0x1e, 0x38, 0xdc, 0x01, 0x87, 0x5b, 0x32, 0x71, 0x51, 0x62, 0x6d, 0x50, 0x94, 0xa2, 0x8b,
+		0x12, 0x2f, 0x9c, 0x6b, 0x3b, 0x8a, 0xee, 0xeb, 0xe8, 0x3f, 0x57, 0xdd, 0xe1, 0x12, 0x1c, 0x4f,
+		0x6f, 0x8f, 0xc1, 0x14, 0x0b, 0xad, 0xde, 0x94, 0xa1, 0xea, 0x7b, 0x6d, 0x5e, 0x7d, 0xb6, 0x89,
+		0x66, 0xe7, 0x21, 0x9e, 0xd7, 0xf7, 0xd0, 0x14, 0xcb, 0xa7, 0x4b, 0x0d, 0xab, 0xc6, 0x63, 0xd0,
+		0x20, 0x29, 0x5f, 0xb4, 0x6a, 0x24, 0x36, 0x89, 0x8b, 0xfe, 0xe4, 0xf8, 0x9e, 0x15, 0x72, 0xe9,
+		0x0f, 0x7d, 0x62, 0xa6, 0xef, 0xf3, 0x9f, 0x98, 0xe9, 0xfb, 0xe1, 0x2b, 0x33, 0x7d, 0x2f, 0xfd,
+		0xc9, 0x6c, 0x5f, 0x61, 0x37, 0xac, 0xde, 0x57, 0x22, 0x67, 0xd3, 0x64, 0x5e, 0xdf, 0xa3, 0x81,
+		0x68, 0x5d, 0x7a, 0xae, 0x9f, 0x2a, 0x27, 0x0e, 0x50, 0xa7, 0xc3, 0x07, 0xa8, 0xcf, 0xe0, 0x5a,
+		0xed, 0x82, 0x6e, 0x5c, 0xd1, 0x37, 0x03, 0x36, 0xf8, 0x60, 0x0c, 0xa6, 0x9b, 0xa6, 0x4d, 0x9e,
+		0x61, 0xb4, 0x7b, 0xfe, 0x9a, 0x83, 0xe4, 0x02, 0x47, 0x21, 0xfe, 0x66, 0xe3, 0xb2, 0xa1, 0x57,
+		0xd8, 0x48, 0x8f, 0x2b, 0xa2, 0x48, 0xd4, 0xd6, 0x55, 0xdd, 0xb0, 0xf9, 0x9d, 0x7b, 0x56, 0x28,
+		0x7c, 0x54, 0xea, 0x2d, 0x5f, 0x18, 0x11, 0x2d, 0x09, 0x35, 0x1f, 0x8c, 0x3c, 0x52, 0xde, 0x25,
+		0x5a, 0xba, 0x4a, 0x04, 0x8e, 0x95, 0xbb, 0xb5, 0xca, 0xaf, 0xc4, 0x60, 0x26, 0x6c, 0x15, 0x92,
+		0xb6, 0xd9, 0x8e, 0x5a, 0x37, 0xdb, 0x99, 0xe5, 0x2c, 0xa4, 0x36, 0x05, 0x4e, 0xcf, 0x76, 0xb9,
+		0xd6, 0xa3, 0x5d, 0x46, 0xdd, 0xa6, 0x84, 0x61, 0x4e, 0x76, 0x69, 0x18, 0x57, 0x8f, 0x7d, 0x59,
+		0xe6, 0xb3, 0x09, 0x38, 0x4c, 0x1f, 0x65, 0x59, 0x75, 0x4d, 0x77, 0x4e, 0x94, 0xad, 0x3d, 0xd3,
+		0xa1, 0x89, 0x9b, 0xb1, 0xcd, 0xed, 0x32, 0xee, 0x55, 0xcf, 0xb1, 0xea, 0x36, 0x23, 0x67, 0x1b,
+		0xfa, 0xd7, 0x09, 0x1d, 0xb1, 0x88, 0x63, 0x38, 0x6a, 0x8d, 0x5b, 0x8a, 0x15, 0x08, 0x94, 0x3d,
+		0xe4, 0x8a, 0x31, 0xa8, 0x26, 0xde, 0x70, 0xd5, 0xb0, 0xba, 0xcd, 0xee, 0xc3, 0xc7, 0xe9, 0x80,
+		0x4a, 0x12, 0x00, 0xbd, 0xfa, 0x3e, 0x09, 0xfd, 0x6a, 0x83, 0x5d, 0xe5, 0x88, 0x93, 0x91, 0x46,
+		0x0b, 0xf2, 0x05, 0x18, 0xe4, 0x07, 0xca, 0xe4, 0x32, 0xc3, 0x2e, 0xde, 0xa3, 0xed, 0x0c, 0x2b,
+		0xe4, 0x5f, 0x34, 0x07, 0xfd, 0x54, 0x78, 0x3e, 0x81, 0x64, 0xe6, 0x9a, 0xa4, 0x9f, 0xa3, 0x42,
+		0x2a, 0x0c, 0x4d, 0x7e, 0x0a, 0x92, 0x0b, 0x46, 0x5d, 0xd3, 0x8d, 0x20, 0xb7, 0x14, 0xe3, 0x46,
+		0x65, 0x36, 0x1b, 0x3c, 0xdf, 0x50, 0x58, 0x81, 0xdc, 0x1b, 0x65, 0xef, 0x23, 0xf8, 0x75, 0x14,
+		0x5e, 0x92, 0xe7, 0x61, 0x90, 0xf2, 0x5e, 0x33, 0xc9, 0x43, 0x0c, 0xf7, 0x72, 0x6a, 0x8a, 0xbf,
+		0x96, 0xe3, 0xec, 0x63, 0x9e, 0xb0, 0x08, 0x12, 0x15, 0xd5, 0x51, 0xb9, 0xde, 0xf4, 0x7f, 0xf9,
+		0x09, 0x48, 0x72, 0x26, 0x36, 0x3a, 0x09, 0x71, 0xc3, 0xb4, 0xf9, 0x85, 0x92, 0x6c, 0x3b, 0x55,
+		0xd6, 0xcc, 0x42, 0x82, 0x64, 0x2a, 0x0a, 0x41, 0x2e, 0x28, 0x6d, 0x83, 0xea, 0x23, 0xbe, 0xa0,
+		0xea, 0xeb, 0x72, 0xdf, 0xbf, 0xac, 0x4b, 0x9b, 0xdc, 0xc1, 0x75, 0x96, 0x57, 0x62, 0x30, 0xed,
+		0xab, 0xbd, 0x8c, 0x2d, 0xb2, 0xab, 0xc2, 0xe7, 0x73, 0xe6, 0x2d, 0xc8, 0x27, 0x24, 0xaf, 0x6f,
+		0xe3, 0x2e, 0x8f, 0x43, 0x3c, 0x6f, 0x9a, 0xe4, 0x99, 0x20, 0x2d, 0x97, 0x0d, 0xe6, 0x2f, 0x09,
+		0xc5, 0x2d, 0x93, 0x3a, 0xdb, 0xd8, 0x76, 0xae, 0xa8, 0x96, 0xfb, 0x84, 0x50, 0x94, 0xe5, 0x47,
+		0x21, 0x35, 0x6f, 0xe8, 0x36, 0xd6, 0xed, 0x06, 0x1d, 0x83, 0x5b, 0x35, 0xa3, 0xbc, 0xcb, 0x39,
+		0xb0, 0x02, 0x31, 0xb8, 0x6a, 0x9a, 0x94, 0x32, 0xa1, 0x90, 0x7f, 0x59, 0x6e, 0x58, 0xd8, 0x68,
+		0x6b, 0xa2, 0x47, 0x7b, 0x37, 0x11, 0x57, 0xd2, 0xb5, 0xd1, 0xff, 0x95, 0xe0, 0x50, 0xf3, 0x80,
+		0xda, 0xc5, 0x7b, 0x76, 0xaf, 0xe3, 0xe9, 0x59, 0x48, 0xad, 0xd3, 0x77, 0xfc, 0x17, 0xf0, 0x1e,
+		0xca, 0xc2, 0x20, 0xae, 0x9c, 0x3c, 0x75, 0xea, 0xc1, 0x47, 0x99, 0xb7, 0x9f, 0xef, 0x53, 0x04,
+		0x00, 0x4d, 0x43, 0xca, 0xc6, 0x65, 0xf3, 0xe4, 0xa9, 0xd3, 0xbb, 0x0f, 0x32, 0xf7, 0x22, 0x19,
+		0x90, 0x0b, 0xca, 0x25, 0x89, 0xd6, 0xaf, 0xbd, 0x32, 0x23, 0x15, 0xfa, 0x21, 0x6e, 0x37, 0xea,
+		0xb7, 0xd4, 0x47, 0x3e, 0xd2, 0x0f, 0xb3, 0x7e, 0x4a, 0x1a, 0xa9, 0xdc, 0xac, 0x84, 0xdb, 0x20,
+		0xed, 0xb3, 0x01, 0xc5, 0x68, 0x93, 0xcc, 0x76, 0xb4, 0xa4, 0xfc, 0x1b, 0x12, 0x0c, 0xbb, 0xa9,
+		0x12, 0xf9, 0x64, 0xc3, 0x59, 0x7f, 0xfe, 0xc3, 0x87, 0xcd, 0xed, 0x73, 0xe1, 0xb6, 0xbc, 0x94,
+		0x4e, 0xf1, 0xa1, 0xa3, 0x33, 0xd4, 0x11, 0x4d, 0xc3, 0xe6, 0xcf, 0xca, 0x22, 0x48, 0x5d, 0x64,
+		0x72, 0x4d, 0x90, 0x46, 0xb8, 0xd2, 0x65, 0xc3, 0x21, 0xf7, 0x26, 0x4c, 0xe3, 0x0a, 0x7f, 0xac,
+		0x1b, 0x57, 0xd2, 0xb4, 0xe6, 0x12, 0xad, 0x58, 0x27, 0x70, 0x22, 0x74, 0xca, 0xe5, 0x12, 0x4c,
+		0xef, 0x48, 0x10, 0x10, 0x45, 0xf2, 0x96, 0xcd, 0x6c, 0x6c, 0x95, 0x44, 0xc4, 0x20, 0xaf, 0x01,
+		0x5b, 0x8c, 0x7f, 0xe1, 0x1f, 0x3c, 0x02, 0x0c, 0x98, 0x8d, 0x2d, 0xe2, 0x2d, 0x77, 0xc0, 0x70,
+		0x0b, 0x61, 0x86, 0x2e, 0x7b, 0x72, 0xd0, 0xcf, 0x47, 0x70, 0x0d, 0x4a, 0xa6, 0xa5, 0x19, 0x96,
+		0xe6, 0xec, 0xd1, 0xfc, 0x35, 0xae, 0xa4, 0x45, 0xc5, 0x3a, 0x87, 0xcb, 0xbb, 0x30, 0xb6, 0x41,
+		0xd7, 0xb7, 0x9e, 0xe4, 0xa7, 0x3c, 0xf9, 0xa4, 0x68, 0xf9, 0xda, 0x4a, 0x16, 0x6b, 0x92, 0xac,
+		0xf0, 0x74, 0x5b, 0xef, 0x3c, 0xd3, 0xbb, 0x77, 0x06, 0x33, 0xc4, 0x3f, 0x9b, 0x82, 0x43, 0xe1,
+		0xca, 0x40, 0xf8, 0xea, 0xd6, 0x31, 0xa3, 0xb2, 0x89, 0x6c, 0xe7, 0x49, 0x35, 0x1b, 0x11, 0x46,
+		0xb3, 0x91, 0x43, 0x48, 0x7e, 0x14, 0x46, 0xc8, 0xf5, 0xce, 0x0d, 0xec, 0x9c, 0xc7, 0x6a, 0x05,
+		0x5b, 0xc1, 0x59, 0x77, 0x44, 0xcc, 0xba, 0x08, 0x12, 0x74, 0x6a, 0x65, 0xb3, 0x0e, 0xfd, 0x5f,
+		0xde, 0x81, 0x04, 0x21, 0xf5, 0x66, 0x64, 0x4e, 0x41, 0x0b, 0x04, 0xba, 0xb5, 0xe7, 0x60, 0x5b,
+		0xa4, 0xb7, 0xb4, 0x80, 0x1e, 0x16, 0xf3, 0x6a, 0xbc, 0xf3, 0xbc, 0xca, 0x1d, 0x91, 0xcf, 0xae,
+		0x35, 0x18, 0x2c, 0x90, 0x50, 0xbc, 0xb4, 0xe0, 0x0a, 0x22, 0x79, 0x82, 0xa0, 0x15, 0x18, 0x33,
+		0x55, 0xcb, 0xa1, 0x4f, 0x62, 0x76, 0xa8, 0x16, 0xdc, 0xd7, 0x67, 0x9a, 0x47, 0x5e, 0x40, 0x59,
+		0xde, 0xca, 0x88, 0xe9, 0x07, 0xca, 0x7f, 0x9a, 0x80, 0x01, 0x6e, 0x8c, 0xc7, 0x61, 0x90, 0x9b,
+		0x95, 0x7b, 0xe7, 0xe1, 0xb9, 0xe6, 0x89, 0x69, 0xce, 0x9d, 0x40, 0x38, 0x3f, 0x41, 0x83, 0x8e,
+		0x40, 0xb2, 0xbc, 0xa3, 0x6a, 0x7a, 0x49, 0xab, 0x88, 0xad, 0x86, 0x57, 0xaf, 0xcf, 0x0c, 0xce,
+		0x13, 0xd8, 0xd2, 0x82, 0x32, 0x48, 0x2b, 0x97, 0x2a, 0x24, 0x13, 0xd8, 0xc1, 0x5a, 0x75, 0xc7,
+		0xe1, 0x23, 0x8c, 0x97, 0xc8, 0xb7, 0x63, 0x88, 0x43, 0xf0, 0x07, 0x93, 0xd9, 0xa6, 0x0d, 0x1f,
+		0x37, 0xd9, 0x2b, 0x24, 0x49, 0xc3, 0xef, 0xff, 0xf6, 0x8c, 0xa4, 0x50, 0x0a, 0x34, 0x0f, 0x23,
+		0x35, 0xd5, 0x76, 0x4a, 0x74, 0x06, 0x23, 0xcd, 0xf7, 0xf3, 0xf5, 0x76, 0x93, 0x41, 0xb8, 0x61,
+		0xb9, 0xe8, 0x43, 0x84, 0x8a, 0x81, 0x2a, 0xe4, 0x3d, 0x17, 0x65, 0x42, 0x6e, 0xb5, 0x6a, 0x0e,
+		0xcb, 0xad, 0x06, 0xa8, 0xdd, 0x47, 0x09, 0x7c, 0x9e, 0x82, 0x69, 0x86, 0x75, 0x3b, 0xa4, 0xe8,
+		0x13, 0x2d, 0x8a, 0xc2, 0xae, 0x23, 0x27, 0x09, 0x80, 0x56, 0x1e, 0x85, 0x31, 0x2f, 0x3e, 0x32,
+		0x94, 0x24, 0xe3, 0xe2, 0x81, 0x29, 0xe2, 0x03, 0x30, 0xa9, 0xe3, 0xab, 0x4e, 0xc9, 0x03, 0x33,
+		0xec, 0x14, 0xc5, 0x46, 0xa4, 0xee, 0x52, 0x90, 0xe2, 0x6e, 0x18, 0x2d, 0x0b, 0xe3, 0x33, 0x5c,
+		0xa0, 0xb8, 0x23, 0x2e, 0x94, 0xa2, 0x4d, 0x41, 0x52, 0x35, 0x4d, 0x86, 0x30, 0xc4, 0xe3, 0xa3,
+		0x69, 0xd2, 0xaa, 0xe3, 0x30, 0x4e, 0x75, 0xb4, 0xb0, 0xdd, 0xa8, 0x39, 0x9c, 0xc9, 0x30, 0xc5,
+		0x19, 0x23, 0x15, 0x0a, 0x83, 0x53, 0xdc, 0x3b, 0x61, 0x04, 0x5f, 0xd6, 0x2a, 0x58, 0x2f, 0x63,
+		0x86, 0x37, 0x42, 0xf1, 0x86, 0x05, 0x90, 0x22, 0xdd, 0x03, 0x6e, 0xdc, 0x2b, 0x89, 0x98, 0x3c,
+		0xca, 0xf8, 0x09, 0x78, 0x9e, 0x81, 0xe5, 0x0c, 0x24, 0x16, 0x54, 0x47, 0x25, 0x09, 0x86, 0x73,
+		0x95, 0x4d, 0x34, 0xc3, 0x0a, 0xf9, 0x57, 0x7e, 0x2d, 0x06, 0x89, 0x4b, 0x86, 0x83, 0xd1, 0x43,
+		0xbe, 0x04, 0x70, 0xb4, 0x95, 0x3f, 0x6f, 0x68, 0x55, 0x1d, 0x57, 0x56, 0xec, 0xaa, 0xef, 0x7b,
+		0x0a, 0x9e, 0x3b, 0xc5, 0x02, 0xee, 0x34, 0x09, 0xfd, 0x96, 0xd1, 0xd0, 0x2b, 0xe2, 0x26, 0x2f,
+		0x2d, 0xa0, 0x22, 0x24, 0x5d, 0x2f, 0x49, 0x44, 0x79, 0xc9, 0x18, 0xf1, 0x12, 0xe2, 0xc3, 0x1c,
+		0xa0, 0x0c, 0x6e, 0x71, 0x67, 0x29, 0x40, 0xca, 0x0d, 0x5e, 0x99, 0xfe, 0x1e, 0x1c, 0xd6, 0x23,
+		0x23, 0x93, 0x89, 0xdb, 0xf7, 0xae, 0xf1, 0x98, 0xc7, 0xa5, 0xdd, 0x0a, 0x6e, 0xbd, 0x80, 0x5b,
+		0xf1, 0x6f, 0x3b, 0x0c, 0x52, 0xbd, 0x3c, 0xb7, 0x62, 0xdf, 0x77, 0x38, 0x44, 0x2e, 0x66, 0x55,
+		0x75, 0xd5, 0x69, 0x58, 0x98, 0x7b, 0x9e, 0x07, 0x20, 0xef, 0x76, 0x06, 0x98, 0x27, 0xfb, 0xec,
+		0x26, 0xb5, 0xb6, 0x5b, 0xac, 0x9d, 0xdd, 0xe2, 0xfb, 0xb7, 0x5b, 0x1e, 0xc0, 0x15, 0xc6, 0xe6,
+		0x4f, 0xee, 0x5b, 0x64, 0x0c, 0x4c, 0xc4, 0x0d, 0xad, 0xca, 0x07, 0xaa, 0x8f, 0x48, 0xfe, 0x0f,
+		0x12, 0xa4, 0xdc, 0x7a, 0x94, 0x87, 0x11, 0x21, 0x57, 0x69, 0xbb, 0xa6, 0x56, 0xb9, 0xef, 0x1c,
+		0x6e, 0x2b, 0xdc, 0xb9, 0x9a, 0x5a, 0x55, 0x86, 0xb8, 0x3c, 0xa4, 0xd0, 0xba, 0x1f, 0x62, 0x6d,
+		0xfa, 0x21, 0xd0, 0xf1, 0xf1, 0xfd, 0x75, 0x7c, 0xa0, 0x8b, 0x12, 0xe1, 0x2e, 0xfa, 0x62, 0x8c,
+		0x2e, 0x66, 0x4c, 0xc3, 0x56, 0x6b, 0x3f, 0x89, 0x11, 0x71, 0x3b, 0xa4, 0x4c, 0xa3, 0x56, 0x62,
+		0x35, 0xec, 0x86, 0x7b, 0xd2, 0x34, 0x6a, 0x4a, 0x53, 0xb7, 0xf7, 0xdf, 0xa4, 0xe1, 0x32, 0x70,
+		0x13, 0xac, 0x36, 0x18, 0xb6, 0x9a, 0x05, 0xc3, 0xcc, 0x14, 0x7c, 0x2e, 0x7b, 0x80, 0xd8, 0x80,
+		0xfc, 0x97, 0x91, 0x9a, 0xe7, 0x5e, 0x26, 0x36, 0xc3, 0x54, 0x06, 0x76, 0x5c, 0x0a, 0x16, 0xfa,
+		0x33, 0xb1, 0x76, 0x14, 0xcc, 0xed, 0x14, 0x8e, 0x27, 0xff, 0xb2, 0x04, 0xb0, 0x4c, 0x2c, 0x4b,
+		0xf5, 0x25, 0xb3, 0x90, 0x4d, 0x45, 0x28, 0x05, 0x5a, 0x9e, 0x6e, 0xd7, 0x69, 0xbc, 0xfd, 0x61,
+		0xdb, 0x2f, 0xf7, 0x3c, 0x8c, 0x78, 0xce, 0x68, 0x63, 0x21, 0xcc, 0x74, 0x87, 0xac, 0x7a, 0x03,
+		0x3b, 0xca, 0xf0, 0x65, 0x5f, 0x49, 0xfe, 0x1d, 0x09, 0x52, 0x54, 0x26, 0xf2, 0x60, 0x38, 0xd0,
+		0x87, 0xd2, 0xfe, 0xfb, 0xf0, 0x30, 0x00, 0x63, 0x43, 0x8e, 0xa9, 0xb9, 0x67, 0xa5, 0x28, 0x84,
+		0x1c, 0x3e, 0xa3, 0xd3, 0xae, 0xc1, 0xe3, 0x9d, 0x0d, 0x2e, 0xb2, 0x6e, 0x6e, 0xf6, 0xdb, 0x60,
+		0x90, 0x7e, 0xa2, 0xea, 0xaa, 0xcd, 0x13, 0x69, 0xf2, 0x5d, 0x8a, 0xcd, 0xab, 0xb6, 0xfc, 0x3c,
+		0x0c, 0x6e, 0x5e, 0x65, 0x7b, 0x23, 0xb7, 0x43, 0xca, 0x32, 0x0c, 0x3e, 0x27, 0xb3, 0x5c, 0x28,
+		0x49, 0x00, 0x74, 0x0a, 0x12, 0xfb, 0x01, 0x31, 0x6f, 0x3f, 0xc0, 0xdb, 0xd0, 0x88, 0x77, 0xb5,
+		0xa1, 0x71, 0xfc, 0xdf, 0x49, 0x30, 0xe4, 0x8b, 0x0f, 0xe8, 0x41, 0x38, 0x50, 0x58, 0x5e, 0x9b,
+		0xbf, 0x50, 0x5a, 0x5a, 0x28, 0x9d, 0x5b, 0xce, 0x2f, 0x7a, 0x6f, 0xb8, 0xb2, 0x07, 0x5f, 0xbe,
+		0x36, 0x8b, 0x7c, 0xb8, 0x17, 0x75, 0xba, 0xa3, 0x84, 0x4e, 0xc0, 0x64, 0x90, 0x24, 0x5f, 0xd8,
+		0x20, 0x0f, 0xba, 0xa4, 0xec, 0x81, 0x97, 0xaf, 0xcd, 0x8e, 0xfb, 0x28, 0xf2, 0x5b, 0x36, 0xd6,
+		0x9d, 0x66, 0x82, 0xf9, 0xb5, 0x95, 0x95, 0xa5, 0xcd, 0x74, 0xac, 0x89, 0x80, 0x07, 0xec, 0x7b,
+		0x60, 0x3c, 0x48, 0xb0, 0xba, 0xb4, 0x9c, 0x8e, 0x67, 0xd1, 0xcb, 0xd7, 0x66, 0x47, 0x7d, 0xd8,
+		0xab, 0x5a, 0x2d, 0x9b, 0x7c, 0xcf, 0xa7, 0xa6, 0xfb, 0x3e, 0xf3, 0xab, 0xd3, 0x12, 0xd1, 0x6c,
+		0x24, 0x10, 0x23, 0xd0, 0x7d, 0x70, 0xdb, 0xc6, 0xd2, 0xe2, 0x6a, 0x71, 0xa1, 0xb4, 0xb2, 0xb1,
+		0x28, 0xf6, 0xa0, 0x85, 0x76, 0x63, 0x2f, 0x5f, 0x9b, 0x1d, 0xe2, 0x2a, 0xb5, 0xc3, 0x5e, 0x57,
+		0x8a, 0x97, 0xd6, 0xc8, 0x8e, 0x36, 0xc3, 0x5e, 0xb7, 0xf0, 0x65, 0xc3, 0x61, 0xdf, 0xb0, 0x7b,
+		0x00, 0xa6, 0x5a, 0x60, 0xbb, 0x8a, 0x8d, 0xbf, 0x7c, 0x6d, 0x76, 0x64, 0xdd, 0xc2, 0x6c, 0xfc,
+		0x50, 0x8a, 0x39, 0xc8, 0x34, 0x53, 0xac, 0xad, 0xaf, 0x6d, 0xe4, 0x97, 0xd3, 0xb3, 0xd9, 0xf4,
+		0xcb, 0xd7, 0x66, 0x87, 0x45, 0x30, 0xa4, 0x1b, 0xfd, 0xae, 0x66, 0xb7, 0x72, 0xc5, 0xf3, 0xa9,
+		0x07, 0xe1, 0xae, 0x36, 0x67, 0x4c, 0xbc, 0xbc, 0xbf, 0x53, 0xa6, 0xb6, 0xfb, 0xec, 0xd9, 0x88,
+		0xed, 0xe7, 0xe8, 0xa5, 0xd3, 0xfe, 0x4f, 0xb0, 0xb2, 0x1d, 0x17, 0x77, 0xf2, 0x7b, 0x25, 0x18,
+		0x3d, 0xaf, 0xd9, 0x8e, 0x61, 0x69, 0x65, 0xb5, 0x46, 0x5f, 0x6e, 0x9d, 0xee, 0x36, 0xb6, 0x86,
+		0x86, 0xfa, 0x93, 0x30, 0x70, 0x59, 0xad, 0xb1, 0xa0, 0x16, 0xa7, 0x1f, 0x9a, 0x69, 0x73, 0xe4,
+		0xe3, 0x86, 0x36, 0xc1, 0x80, 0x91, 0xc9, 0xbf, 0x1e, 0x83, 0x31, 0x3a, 0x18, 0x6c, 0xf6, 0x09,
+		0x32, 0xb2, 0xc6, 0x2a, 0x40, 0xc2, 0x52, 0x1d, 0xbe, 0x69, 0x58, 0x98, 0xe3, 0xa7, 0x8f, 0x47,
+		0xba, 0x38, 0x4b, 0x23, 0x07, 0x94, 0x94, 0x16, 0xbd, 0x19, 0x92, 0xe4, 0xb0, 0x8e, 0xf2, 0x61,
+		0x2b, 0x97, 0x7c, 0x6f, 0x7c, 0x6e, 0x5c, 0x9f, 0x19, 0xdb, 0x53, 0xeb, 0xb5, 0x9c, 0x2c, 0xf8,
+		0xc8, 0xca, 0x60, 0x5d, 0xbd, 0x4a, 0x44, 0x44, 0x26, 0x8c, 0x11, 0x68, 0x79, 0x47, 0xd5, 0xab,
+		0x98, 0x35, 0x42, 0xb7, 0x40, 0x0b, 0xe7, 0x7b, 0x6e, 0xe4, 0xa0, 0xd7, 0x88, 0x8f, 0x9d, 0xac,
+		0x8c, 0xd4, 0xd5, 0xab, 0xf3, 0x14, 0x40, 0x5a, 0xcc, 0x25, 0xc9, 0xb1, 0x0a, 0x3d, 0xd1, 0xfd,
+		0x96, 0x04, 0xe0, 0x59, 0x0c, 0xbd, 0x19, 0xd2, 0x65, 0xb7, 0x44, 0x69, 0xc5, 0xd9, 0xe4, 0xd1,
+		0x76, 0x7d, 0x11, 0xb2, 0x37, 0x9b, 0x9b, 0xbf, 0x79, 0x7d, 0x46, 0x52, 0xc6, 0xca, 0xa1, 0xae,
+		0x78, 0x13, 0x0c, 0x35, 0xcc, 0x8a, 0xea, 0xe0, 0x12, 0x5d, 0xc7, 0xc5, 0x22, 0xe7, 0xf9, 0x69,
+		0xc2, 0xeb, 0xc6, 0xf5, 0x19, 0xc4, 0xd4, 0xf2, 0x11, 0xcb, 0x74, 0xf6, 0x07, 0x06, 0x21, 0x04,
+		0x3e, 0x9d, 0xbe, 0x2e, 0xc1, 0xd0, 0x82, 0xef, 0x4e, 0x65, 0x06, 0x06, 0xeb, 0x86, 0xae, 0xed,
+		0x72, 0x7f, 0x4c, 0x29, 0xa2, 0x48, 0xb6, 0x42, 0xd9, 0x63, 0x56, 0x67, 0x4f, 0x6c, 0x85, 0x8a,
+		0x32, 0xa1, 0xba, 0x82, 0xb7, 0x6c, 0x4d, 0xf4, 0x86, 0x22, 0x8a, 0xe8, 0x1c, 0xf9, 0x9e, 0x4e,
+		0xb9, 0x41, 0xf6, 0x70, 0x4a, 0x65, 0x43, 0x77, 0xd4, 0xb2, 0xc3, 0x9e, 0x45, 0x16, 0x6e, 0xbf,
+		0x71, 0x7d, 0xe6, 0x36, 0x26, 0x6b, 0x18, 0x43, 0x56, 0xc6, 0x04, 0x68, 0x9e, 0x41, 0x48, 0x0b,
+		0x15, 0xec, 0xa8, 0x5a, 0xcd, 0xce, 0xb0, 0xcb, 0x09, 0xa2, 0xe8, 0xd3, 0xe5, 0x73, 0x83, 0xfe,
+		0x8d, 0xad, 0x73, 0x90, 0x36, 0x4c, 0x6c, 0x05, 0x12, 0x51, 0x29, 0xdc, 0x72, 0x18, 0x43, 0x56,
+		0xc6, 0x04, 0x48, 0x24, 0xa9, 0x0e, 0xa4, 0xdd, 0x25, 0x61, 0xc9, 0x6c, 0x6c, 0x79, 0xfb, 0x61,
+		0x93, 0x4d, 0xbd, 0x91, 0xd7, 0xf7, 0x0a, 0x0f, 0x79, 0xdc, 0xc3, 0x74, 0xf2, 0x37, 0xbe, 0x74,
+		0xff, 0x24, 0x77, 0x0d, 0x6f, 0x7f, 0x8a, 0x6c, 0x4e, 0x8d, 0xb9, 0xa8, 0xeb, 0x14, 0x93, 0xa4,
+		0x9d, 0xcf, 0xab, 0x5a, 0x4d, 0x3c, 0xef, 0x57, 0x78, 0x09, 0xe5, 0x60, 0xc0, 0x76, 0x54, 0xa7,
+		0x61, 0xf3, 0x93, 0x5e, 0xb9, 0x9d, 0xab, 0x15, 0x0c, 0xbd, 0xb2, 0x41, 0x31, 0x15, 0x4e, 0x81,
+		0xce, 0xc1, 0x00, 0x3f, 0x42, 0xef, 0xef, 0x79, 0x7c, 0xd3, 0xbb, 0x12, 0x8c, 0x9a, 0x58, 0xa4,
+		0x82, 0x6b, 0xb8, 0xca, 0xd2, 0xaa, 0x1d, 0x95, 0xac, 0x3e, 0xe8, 0xb7, 0xf7, 0x0a, 0x4b, 0x3d,
+		0x0f, 0x42, 0x6e, 0xa9, 0x30, 0x3f, 0x59, 0x19, 0x73, 0x41, 0x1b, 0x14, 0x82, 0x2e, 0x04, 0x2e,
+		0xff, 0xf2, 0x0f, 0x54, 0xde, 0xd9, 0x4e, 0x7d, 0x9f, 0x4f, 0x8b, 0xfd, 0x09, 0x1f, 0x35, 0x71,
+		0x8e, 0x86, 0xbe, 0x65, 0xe8, 0xf4, 0x0d, 0x2e, 0xcf, 0xef, 0xc9, 0xfa, 0x2e, 0xee, 0x77, 0x8e,
+		0x30, 0x86, 0xac, 0x8c, 0xb9, 0xa0, 0xf3, 0x14, 0x82, 0x2a, 0x30, 0xea, 0x61, 0xd1, 0x81, 0x9a,
+		0x8a, 0x1c, 0xa8, 0x77, 0xf0, 0x81, 0x7a, 0x20, 0xdc, 0x8a, 0x37, 0x56, 0x47, 0x5c, 0x20, 0x21,
+		0x43, 0xe7, 0x01, 0xbc, 0xf0, 0x40, 0xf7, 0x29, 0x86, 0x4e, 0xca, 0xd1, 0x31, 0x46, 0xac, 0xf7,
+		0x3c, 0x5a, 0xf4, 0x56, 0x98, 0xa8, 0x6b, 0x7a, 0xc9, 0xc6, 0xb5, 0xed, 0x12, 0x37, 0x30, 0x61,
+		0x49, 0x3f, 0xa1, 0x54, 0x58, 0xee, 0xcd, 0x1f, 0x6e, 0x5c, 0x9f, 0xc9, 0xf2, 0x10, 0xda, 0xcc,
+		0x52, 0x56, 0xc6, 0xeb, 0x9a, 0xbe, 0x81, 0x6b, 0xdb, 0x0b, 0x2e, 0x2c, 0x37, 0xfc, 0x9e, 0x4f,
+		0xcc, 0xf4, 0xf1, 0xe1, 0xda, 0x27, 0x9f, 0xa6, 0x7b, 0xe7, 0x7c, 0x98, 0x61, 0x9b, 0xac, 0x49,
+		0x54, 0x51, 0xe0, 0x57, 0x0d, 0x3c, 0x00, 0x1b, 0xe6, 0x2f, 0xfd, 0xc9, 0xac, 0x24, 0x7f, 0x4e,
+		0x82, 0x81, 0x85, 0x4b, 0xeb, 0xaa, 0x66, 0xa1, 0x25, 0x18, 0xf7, 0x3c, 0x27, 0x38, 0xc8, 0x0f,
+		0xdd, 0xb8, 0x3e, 0x93, 0x09, 0x3b, 0x97, 0x3b, 0xca, 0x3d, 0x07, 0x16, 0xc3, 0x7c, 0xa9, 0xdd,
+		0xc2, 0x35, 0xc0, 0xaa, 0x09, 0x45, 0x6e, 0x5e, 0xd6, 0x86, 0xd4, 0x2c, 0xc2, 0x20, 0x93, 0x96,
+		0xbc, 0xfb, 0xee, 0x37, 0xc9, 0x3f, 0xfc, 0x60, 0x60, 0xba, 0xad, 0xf3, 0x52, 0x7c, 0x77, 0x23,
+		0x93, 0x90, 0xc8, 0x1f, 0x88, 0x01, 0x2c, 0x5c, 0xba, 0xb4, 0x69, 0x69, 0x66, 0x0d, 0x3b, 0x37,
+		0x53, 0xf3, 0x4d, 0x38, 0xe0, 0xa9, 0x65, 0x5b, 0xe5, 0x90, 0xf6, 0xb3, 0x37, 0xae, 0xcf, 0x1c,
+		0x0a, 0x6b, 0xef, 0x43, 0x93, 0x95, 0x09, 0x6f, 0xbd, 0x64, 0x95, 0x5b, 0x72, 0xad, 0xd8, 0x8e,
+		0xcb, 0x35, 0xde, 0x9e, 0xab, 0x0f, 0xcd, 0xcf, 0x75, 0xc1, 0x76, 0x5a, 0x9b, 0x76, 0x03, 0x86,
+		0x3c, 0x93, 0x90, 0xaf, 0x9d, 0x25, 0x1d, 0xfe, 0x3f, 0xb7, 0xb0, 0xdc, 0xde, 0xc2, 0x82, 0x8c,
+		0x5b, 0xd9, 0xa5, 0x94, 0xff, 0x5c, 0x02, 0xf0, 0x7c, 0xf6, 0x67, 0xd3, 0xc5, 0x48, 0x28, 0xe7,
+		0x81, 0x37, 0xbe, 0xaf, 0x54, 0x8d, 0x53, 0x87, 0xec, 0xf9, 0x8b, 0x31, 0xf2, 0x89, 0x0c, 0x1e,
+		0x79, 0x7e, 0xe6, 0x6d, 0xb0, 0x0e, 0x83, 0x58, 0x77, 0x2c, 0x8d, 0x1a, 0x81, 0xf4, 0xf6, 0x03,
+		0xed, 0x7a, 0xbb, 0x85, 0x4e, 0xf4, 0x23, 0x52, 0x62, 0xd3, 0x9d, 0xb3, 0x09, 0x59, 0xe3, 0x7d,
+		0x71, 0xc8, 0xb4, 0xa3, 0x44, 0xf3, 0x30, 0x56, 0xb6, 0x30, 0x05, 0x94, 0xfc, 0x3b, 0x7f, 0x85,
+		0xac, 0x97, 0x59, 0x86, 0x10, 0x64, 0x65, 0x54, 0x40, 0xf8, 0xec, 0x51, 0x05, 0x92, 0xf6, 0x11,
+		0xb7, 0x23, 0x58, 0x5d, 0xe6, 0x79, 0x32, 0x9f, 0x3e, 0x44, 0x23, 0x41, 0x06, 0x6c, 0xfe, 0x18,
+		0xf5, 0xa0, 0x74, 0x02, 0x79, 0x01, 0xc6, 0x34, 0x5d, 0x73, 0x34, 0xb5, 0x56, 0xda, 0x52, 0x6b,
+		0xaa, 0x5e, 0xde, 0x4f, 0xd6, 0xcc, 0x42, 0x3e, 0x6f, 0x36, 0xc4, 0x4e, 0x56, 0x46, 0x39, 0xa4,
+		0xc0, 0x00, 0xe8, 0x3c, 0x0c, 0x8a, 0xa6, 0x12, 0xfb, 0xca, 0x36, 0x04, 0xb9, 0x2f, 0xc1, 0xfb,
+		0xa5, 0x38, 0x8c, 0x2b, 0xb8, 0xf2, 0x57, 0x5d, 0xd1, 0x5b, 0x57, 0xac, 0x00, 0xb0, 0xe1, 0x4e,
+		0x02, 0x6c, 0x26, 0xb1, 0xaf, 0x80, 0x91, 0x62, 0x1c, 0x16, 0x6c, 0xc7, 0xd7, 0x1f, 0xd7, 0x63,
+		0x30, 0xec, 0xef, 0x8f, 0xbf, 0xa4, 0xb3, 0x12, 0x5a, 0xf2, 0x22, 0x51, 0x82, 0x7f, 0x7a, 0xb7,
+		0x4d, 0x24, 0x6a, 0xf2, 0xde, 0xce, 0x21, 0xe8, 0x1d, 0xfd, 0x30, 0xb0, 0xae, 0x5a, 0x6a, 0xdd,
+		0x46, 0xe5, 0xa6, 0x4c, 0x53, 0x6c, 0x3f, 0x36, 0x7d, 0x60, 0x9d, 0xef, 0x76, 0x44, 0x24, 0x9a,
+		0x1f, 0x6a, 0x91, 0x68, 0xbe, 0x01, 0x46, 0xc9, 0x72, 0xd8, 0x77, 0x85, 0x81, 0x58, 0x7b, 0xa4,
+		0x30, 0xe5, 0x71, 0x09, 0xd6, 0xb3, 0xd5, 0xf2, 0x25, 0xff, 0x1d, 0x86, 0x21, 0x82, 0xe1, 0x05,
+		0x66, 0x42, 0x7e, 0xd0, 0x5b, 0x96, 0xfa, 0x2a, 0x65, 0x85, 0xdc, 0xea, 0x2d, 0xb2, 0x02, 0x5a,
+		0x06, 0xb4, 0xe3, 0xee, 0x8c, 0x94, 0x3c, 0x73, 0x12, 0xfa, 0xc3, 0x37, 0xae, 0xcf, 0x4c, 0x31,
+		0xfa, 0x66, 0x1c, 0x59, 0x19, 0xf7, 0x80, 0x82, 0xdb, 0xc3, 0x00, 0x44, 0xaf, 0x12, 0xbb, 0xc2,
+		0xcd, 0x96, 0x3b, 0x07, 0x6e, 0x5c, 0x9f, 0x19, 0x67, 0x5c, 0xbc, 0x3a, 0x59, 0x49, 0x91, 0xc2,
+		0x02, 0xf9, 0x9f, 0x8c, 0x4d, 0x7a, 0x27, 0xa0, 0x64, 0xe1, 0x4a, 0x83, 0x7e, 0x1a, 0x26, 0x33,
+		0xf0, 0xfa, 0xc6, 0x66, 0x88, 0x9d, 0xac, 0x8c, 0x52, 0x88, 0x22, 0x00, 0xe8, 0x97, 0x25, 0x38,
+		0xe4, 0xb9, 0x5a, 0x4d, 0x7b, 0xa1, 0xa1, 0x55, 0x4a, 0xdc, 0x6b, 0x4a, 0x65, 0xd5, 0x64, 0xdf,
+		0x25, 0x2f, 0x5c, 0xec, 0x79, 0x61, 0x75, 0x67, 0xd8, 0x8d, 0x9b, 0x79, 0xcb, 0xca, 0x94, 0x5b,
+		0xbd, 0x4c, 0x6b, 0x37, 0x58, 0xe5, 0xbc, 0x6a, 0xfa, 0x46, 0xf9, 0xa7, 0x24, 0x40, 0xde, 0xf4,
+		0xa7, 0x60, 0xdb, 0x24, 0x6b, 0x55, 0xb2, 0x28, 0xf1, 0xad, 0x20, 0xa4, 0xce, 0x8b, 0x12, 0x8f,
+		0x5e, 0x2c, 0x4a, 0x3c, 0x5a, 0xf2, 0x21, 0x62, 0x11, 0x0a, 0x63, 0x51, 0x77, 0xbb, 0xf9, 0x70,
+		0x09, 0xcf, 0x0d, 0x7d, 0xf2, 0xef, 0x4b, 0x30, 0xd5, 0x34, 0xba, 0x5c, 0x61, 0xff, 0x1a, 0x20,
+		0xcb, 0x57, 0xc9, 0xbf, 0x29, 0xc9, 0x84, 0xee, 0x79, 0xb0, 0x8e, 0x5b, 0xe1, 0x8a, 0x9b, 0x38,
+		0xdb, 0xb1, 0xc7, 0x03, 0xff, 0x5c, 0x82, 0x49, 0x7f, 0xf3, 0xae, 0x22, 0xab, 0x30, 0xec, 0x6f,
+		0x9d, 0xab, 0x70, 0x57, 0x37, 0x2a, 0x70, 0xe9, 0x03, 0xf4, 0xe8, 0x69, 0x2f, 0x74, 0xb1, 0x7d,
+		0xc4, 0x07, 0xbb, 0xb6, 0x86, 0x90, 0x29, 0x1c, 0xc2, 0x12, 0xb4, 0x3f, 0xfe, 0x9f, 0x04, 0x89,
+		0x75, 0xc3, 0xa8, 0x21, 0x03, 0xc6, 0x75, 0xc3, 0x29, 0x91, 0x51, 0x86, 0x2b, 0xfe, 0x3b, 0xfc,
+		0xa9, 0xc2, 0x7c, 0x6f, 0x46, 0xfa, 0xfe, 0xf5, 0x99, 0x66, 0x56, 0xca, 0x98, 0x6e, 0x38, 0x05,
+		0x0a, 0xe1, 0xd7, 0xf8, 0xdf, 0x0a, 0x23, 0xc1, 0xc6, 0xd8, 0x8c, 0xf1, 0x4c, 0xcf, 0x8d, 0x05,
+		0xd9, 0xdc, 0xb8, 0x3e, 0x33, 0xe9, 0x45, 0x0f, 0x17, 0x2c, 0x2b, 0xc3, 0x5b, 0xbe, 0xd6, 0xd9,
+		0x55, 0xb7, 0x1f, 0x92, 0x3e, 0x7c, 0x45, 0x82, 0x09, 0x0a, 0xd4, 0x5e, 0xc4, 0x74, 0x0f, 0x43,
+		0xc1, 0x65, 0xc3, 0xaa, 0xa0, 0x51, 0x88, 0xf1, 0xc3, 0xa3, 0x84, 0x12, 0xd3, 0x2a, 0xe4, 0x24,
+		0xd1, 0xb8, 0xa2, 0xf3, 0x9b, 0x27, 0x29, 0x85, 0x15, 0x68, 0x28, 0x36, 0x2a, 0x8d, 0x1a, 0x26,
+		0x5f, 0x58, 0xa5, 0x2f, 0x4e, 0xd8, 0x14, 0xe5, 0x0f, 0xc5, 0x81, 0x7a, 0x12, 0x8a, 0x29, 0x20,
+		0xcf, 0xca, 0x64, 0x3d, 0xed, 0x0e, 0x6f, 0xfe, 0x29, 0x32, 0x0f, 0xc0, 0xfc, 0xec, 0xf8, 0x97,
+		0x25, 0x00, 0x6f, 0xa7, 0x88, 0x1c, 0x50, 0x14, 0xd6, 0x56, 0x17, 0x4a, 0x1b, 0x9b, 0xf9, 0xcd,
+		0x8b, 0x1b, 0xc1, 0x3b, 0xf9, 0xe2, 0x38, 0xc3, 0x36, 0x71, 0x99, 0x7c, 0xd0, 0xae, 0x82, 0x8e,
+		0xc0, 0x64, 0x10, 0x9b, 0x94, 0xc8, 0x17, 0x6b, 0xb3, 0xc3, 0x2f, 0x5f, 0x9b, 0x4d, 0xb2, 0xdc,
+		0x19, 0x93, 0xcb, 0x20, 0x07, 0x9a, 0xf1, 0xc8, 0xf7, 0x38, 0x63, 0xd9, 0x91, 0x97, 0xaf, 0xcd,
+		0xa6, 0xdc, 0x24, 0x1b, 0xc9, 0x80, 0xfc, 0x98, 0x9c, 0x5f, 0x3c, 0x0b, 0x2f, 0x5f, 0x9b, 0x1d,
+		0x60, 0x9d, 0x9c, 0x4d, 0x90, 0x43, 0x8b, 0x9b, 0x7e, 0x73, 0xff, 0xb5, 0x54, 0xdb, 0x53, 0x8a,
+		0x2a, 0xd6, 0xb1, 0xad, 0xd9, 0xfb, 0x3a, 0xa5, 0xe8, 0xea, 0xe4, 0x43, 0xfe, 0xfd, 0x41, 0x18,
+		0x5e, 0x64, 0xad, 0x90, 0x8e, 0xc0, 0xe8, 0x31, 0xf2, 0x5d, 0x58, 0x32, 0xed, 0xbb, 0xc7, 0x9e,
+		0x6d, 0x06, 0x25, 0x4b, 0x0e, 0xdc, 0xbb, 0x77, 0xb4, 0x84, 0x6c, 0x7e, 0xf9, 0x86, 0xdd, 0x09,
+		0xf4, 0x6e, 0xb9, 0x0d, 0x17, 0x96, 0x7a, 0x9e, 0xc7, 0xf8, 0x56, 0x58, 0x98, 0x9f, 0xcc, 0xee,
+		0xf1, 0x6c, 0x12, 0x08, 0xbb, 0xcd, 0xf7, 0x2e, 0x09, 0x0e, 0x50, 0x2c, 0x6f, 0xc6, 0xa1, 0x98,
+		0x62, 0x71, 0x76, 0xbc, 0x9d, 0x0a, 0xcb, 0xaa, 0xed, 0xdd, 0xcd, 0xa1, 0xbc, 0x0a, 0x77, 0xf1,
+		0xc4, 0xe5, 0x90, 0xaf, 0xf1, 0x30, 0x5b, 0x59, 0x99, 0xa8, 0x35, 0x51, 0xda, 0x68, 0x31, 0x70,
+		0x01, 0x33, 0xd1, 0xdb, 0xd1, 0x88, 0x8f, 0x14, 0x3d, 0x05, 0x43, 0x5e, 0xbc, 0xb3, 0xf9, 0xef,
+		0xf4, 0x74, 0x3f, 0xbf, 0xf9, 0x89, 0xd1, 0xbb, 0x25, 0x38, 0xe0, 0x65, 0x5f, 0x7e, 0xb6, 0xec,
+		0xf7, 0x8c, 0xee, 0xed, 0x61, 0xe1, 0x1a, 0x36, 0x4e, 0x4b, 0xbe, 0xb2, 0x32, 0xe9, 0xc2, 0x17,
+		0x7c, 0x82, 0xac, 0x93, 0x5f, 0x52, 0xf0, 0xb7, 0x2f, 0x3e, 0xd9, 0xd9, 0xfd, 0xf4, 0x11, 0x64,
+		0xc0, 0x7e, 0x63, 0xc5, 0x34, 0x2c, 0x07, 0x57, 0x32, 0x49, 0xfe, 0x0d, 0x2a, 0x5e, 0x46, 0xef,
+		0x91, 0xe0, 0xa0, 0xc3, 0x03, 0x20, 0xdb, 0xd7, 0x2d, 0x59, 0x34, 0x04, 0xda, 0x99, 0x54, 0x67,
+		0xbd, 0x5b, 0x84, 0xcd, 0xc2, 0xdd, 0x5c, 0xef, 0xc3, 0x4c, 0xef, 0xd6, 0x8c, 0x65, 0x65, 0xd2,
+		0x69, 0xa6, 0xb5, 0xd1, 0xf3, 0x70, 0x98, 0xbb, 0x70, 0x0b, 0x2a, 0x72, 0x96, 0x4f, 0x36, 0x55,
+		0x13, 0x85, 0x63, 0x37, 0xae, 0xcf, 0xdc, 0x15, 0xf0, 0xf8, 0xd6, 0xe8, 0xb2, 0x32, 0xc5, 0xdc,
+		0xbf, 0xa9, 0xa9, 0xa5, 0x8a, 0xbc, 0x0a, 0xa8, 0xd9, 0xa7, 0xc3, 0xf7, 0x6c, 0xbd, 0x67, 0x54,
+		0x24, 0xfe, 0xfb, 0x6f, 0xa2, 0xb2, 0x42, 0x2e, 0xf9, 0x1e, 0x9e, 0xd9, 0xdc, 0xf4, 0x50, 0xf7,
+		0xed, 0x18, 0x1c, 0xf7, 0x9f, 0x62, 0xbe, 0xd0, 0xc0, 0xd6, 0x9e, 0x1b, 0x99, 0x4c, 0xb5, 0xaa,
+		0xe9, 0xfe, 0xc7, 0x3a, 0x53, 0xfe, 0x5c, 0x8c, 0xe2, 0x8a, 0x6e, 0x92, 0xdf, 0x23, 0xc1, 0xd0,
+		0xba, 0x5a, 0xc5, 0x0a, 0x7e, 0xa1, 0x81, 0x6d, 0xa7, 0xc5, 0x63, 0x08, 0xf2, 0x50, 0x61, 0x7b,
+		0x5b, 0x5c, 0xbd, 0x48, 0x28, 0xbc, 0x44, 0x74, 0xae, 0x69, 0x75, 0x8d, 0x4d, 0x6a, 0x09, 0x85,
+		0x15, 0xc8, 0x07, 0x25, 0xe9, 0xd4, 0xc5, 0x22, 0x4d, 0x26, 0x21, 0x3e, 0x09, 0xd4, 0xd0, 0x59,
+		0xa4, 0x21, 0x46, 0xb4, 0x30, 0xb9, 0x22, 0xc9, 0x3e, 0x82, 0x9a, 0x54, 0x44, 0x51, 0x7e, 0x12,
+		0x86, 0x99, 0x24, 0x3c, 0x4f, 0x9a, 0x82, 0x24, 0xbd, 0x10, 0xe8, 0xc9, 0x33, 0x48, 0xca, 0x17,
+		0xd8, 0x93, 0x0a, 0xc6, 0x9f, 0x89, 0xc4, 0x0a, 0x85, 0x42, 0x5b, 0x2b, 0x1f, 0x8b, 0x0e, 0x96,
+		0xcc, 0x86, 0xae, 0x85, 0x7f, 0xb7, 0x1f, 0x0e, 0xb0, 0x55, 0xd9, 0x09, 0xd5, 0xd4, 0x4e, 0xec,
+		0x38, 0x8e, 0x78, 0xe2, 0x03, 0x0c, 0x3c, 0xa7, 0x9a, 0x9a, 0xbc, 0x07, 0x89, 0xf3, 0x8e, 0x63,
+		0xa2, 0xe3, 0xd0, 0x6f, 0x35, 0x6a, 0x58, 0xec, 0x59, 0xba, 0xa7, 0x4a, 0xaa, 0xa9, 0xcd, 0x11,
+		0x04, 0xa5, 0x51, 0xc3, 0x0a, 0x43, 0x41, 0x45, 0x98, 0xd9, 0x6e, 0xd4, 0x6a, 0x7b, 0xe4, 0x77,
+		0xbe, 0x8c, 0x0a, 0xf1, 0x48, 0xfe, 0xbb, 0x28, 0xf8, 0xaa, 0xa9, 0x8a, 0xaf, 0xab, 0x12, 0xc3,
+		0x1c, 0xa2, 0x68, 0x0b, 0x14, 0x4b, 0xfc, 0x26, 0x4a, 0x51, 0xe0, 0xc8, 0x7f, 0x1c, 0x83, 0xa4,
+		0x60, 0x4d, 0xdf, 0x38, 0xe0, 0x1a, 0x2e, 0x93, 0x34, 0x41, 0xe2, 0x6f, 0x1c, 0x78, 0x19, 0x21,
+		0x88, 0x57, 0x79, 0xe7, 0xa5, 0xce, 0xf7, 0x29, 0xa4, 0x40, 0x60, 0xee, 0xcb, 0x13, 0x02, 0x23,
+		0x0f, 0x52, 0x26, 0x21, 0x61, 0x1a, 0x62, 0x73, 0xe1, 0x7c, 0x9f, 0x42, 0x4b, 0x28, 0x03, 0x03,
+		0x24, 0x56, 0x38, 0xac, 0xb7, 0x08, 0x9c, 0x97, 0xd1, 0x41, 0xb2, 0x13, 0xee, 0x94, 0xd9, 0xa5,
+		0x50, 0x52, 0xc1, 0x8a, 0xe8, 0x0c, 0x0c, 0xb0, 0x8f, 0x07, 0x84, 0x7f, 0x32, 0x89, 0x18, 0x83,
+		0x7d, 0xa5, 0x91, 0xc8, 0xbd, 0xae, 0x3a, 0x0e, 0xb6, 0x74, 0xc2, 0x90, 0xa1, 0x93, 0x8b, 0x2b,
+		0x5b, 0x46, 0x65, 0x8f, 0xff, 0x8c, 0x13, 0xfd, 0x9f, 0xff, 0x6e, 0x0c, 0xf5, 0x87, 0x12, 0xad,
+		0x64, 0xbf, 0x5e, 0x37, 0x2c, 0x80, 0x05, 0x82, 0x54, 0x84, 0x09, 0xb5, 0x52, 0xd1, 0xd8, 0x2f,
+		0x2a, 0x95, 0xb6, 0x34, 0x1a, 0x33, 0xed, 0xcc, 0x50, 0x87, 0xbe, 0x40, 0x1e, 0x41, 0x81, 0xe3,
+		0x17, 0x52, 0xe4, 0x57, 0x14, 0xa9, 0x50, 0xf2, 0x59, 0x18, 0x6f, 0x92, 0x94, 0xc8, 0xb7, 0xab,
+		0xe9, 0x15, 0xf1, 0x1c, 0x87, 0xfc, 0x4f, 0x60, 0xf4, 0xbb, 0xaa, 0x2c, 0xef, 0xa3, 0xff, 0x17,
+		0xde, 0xd1, 0xfe, 0xd5, 0xd6, 0xa8, 0xef, 0xd5, 0x96, 0x6a, 0x6a, 0x85, 0x14, 0xe5, 0xcf, 0xdf,
+		0x6a, 0xe5, 0x9b, 0xdf, 0x6a, 0x55, 0xb1, 0x2e, 0xf2, 0x11, 0x52, 0xa5, 0x9a, 0x9a, 0x4d, 0xdd,
+		0xd1, 0xfb, 0xce, 0xab, 0x7d, 0xd6, 0xf7, 0x3f, 0x7d, 0xba, 0x95, 0x58, 0xcc, 0xaf, 0x2f, 0xb9,
+		0x7e, 0xfc, 0xd5, 0x18, 0x1c, 0xf2, 0xf9, 0xb1, 0x0f, 0xb9, 0xd9, 0x9d, 0xb3, 0xad, 0x3d, 0xbe,
+		0x8b, 0x27, 0xf4, 0x17, 0x20, 0x41, 0xf0, 0x51, 0xc4, 0xaf, 0xba, 0x64, 0x3e, 0xff, 0x8d, 0xdf,
+		0x96, 0x67, 0xa5, 0xb6, 0xbd, 0x42, 0x99, 0x14, 0xde, 0xdd, 0xbd, 0xfd, 0xd2, 0xde, 0x27, 0x6e,
+		0xed, 0x9b, 0x67, 0xc6, 0xb0, 0x0d, 0x3f, 0xfa, 0x58, 0xdb, 0x27, 0xd6, 0x2c, 0x98, 0x76, 0x4e,
+		0x2b, 0x7b, 0x88, 0xd4, 0xed, 0x5e, 0xb0, 0x74, 0xea, 0xc1, 0x2e, 0x13, 0xd4, 0xab, 0x70, 0xf0,
+		0x69, 0xd2, 0xb6, 0xb7, 0xd1, 0x23, 0x42, 0xfe, 0x41, 0xf7, 0x3c, 0x5a, 0xe2, 0x3f, 0x0d, 0x29,
+		0xce, 0x9a, 0xc1, 0x93, 0x8f, 0x2f, 0xeb, 0x8f, 0xcc, 0xb5, 0x9d, 0x4a, 0xe6, 0x7c, 0xd3, 0x88,
+		0xe2, 0xa3, 0x94, 0x7f, 0x4d, 0x82, 0xdb, 0x9a, 0x9a, 0xe6, 0x31, 0x7e, 0xb1, 0xc5, 0x63, 0x9b,
+		0x7d, 0xe5, 0x7a, 0x8b, 0x2d, 0x84, 0x3d, 0x1a, 0x29, 0x2c, 0x93, 0x22, 0x20, 0xed, 0x13, 0x70,
+		0x20, 0x28, 0xac, 0x30, 0xd3, 0xdd, 0x30, 0x1a, 0x3c, 0xd3, 0xe0, 0xe6, 0x1a, 0x09, 0x9c, 0x6a,
+		0xc8, 0xa5, 0xb0, 0x9d, 0x5d, 0x5d, 0x8b, 0xfe, 0xc5, 0x9c, 0xc4, 0x7f, 0x5a, 0xaa, 0x4b, 0x55,
+		0x3d, 0x4a, 0xf9, 0x03, 0x12, 0xcc, 0x06, 0x5b, 0xf0, 0xa5, 0x87, 0xbd, 0x09, 0x7b, 0xd3, 0xba,
+		0xf8, 0x35, 0x09, 0xee, 0xe8, 0x20, 0x13, 0x37, 0xc0, 0x8b, 0x30, 0xe9, 0xdb, 0xbf, 0x11, 0x21,
+		0x5c, 0x74, 0xfb, 0xf1, 0xe8, 0xc4, 0xdc, 0xdd, 0xae, 0xb8, 0x9d, 0x18, 0xe5, 0xb3, 0xdf, 0x9e,
+		0x99, 0x68, 0xae, 0xb3, 0x95, 0x89, 0xe6, 0x3d, 0x97, 0x9b, 0xe8, 0x1f, 0x1f, 0x91, 0xe0, 0x9e,
+		0xa0, 0xaa, 0x2d, 0x32, 0xfc, 0x9f, 0x56, 0x3f, 0xfc, 0x7b, 0x09, 0x8e, 0x77, 0x23, 0x1c, 0xef,
+		0x90, 0x2d, 0x98, 0xf0, 0xd6, 0x1e, 0xe1, 0xfe, 0xe8, 0x69, 0x45, 0xc3, 0xbc, 0x14, 0xb9, 0xdc,
+		0x6e, 0x81, 0xe1, 0x4d, 0x3e, 0xb0, 0xfc, 0x5d, 0xee, 0x1a, 0x39, 0x78, 0x1e, 0x21, 0x8c, 0x1c,
+		0x38, 0x91, 0x68, 0xd1, 0x17, 0xb1, 0x16, 0x7d, 0xe1, 0x65, 0xed, 0xf2, 0x65, 0xb8, 0xad, 0xa9,
+		0x45, 0x6e, 0xb9, 0x37, 0xc1, 0x44, 0x0b, 0x57, 0xe6, 0xa3, 0xba, 0x07, 0x4f, 0x56, 0x50, 0xb3,
+		0xb3, 0xca, 0x7b, 0x30, 0x43, 0xdb, 0x6d, 0x61, 0xe8, 0x5b, 0xad, 0x72, 0x1d, 0x66, 0xdb, 0x37,
+		0xcd, 0x75, 0x5f, 0x82, 0x01, 0xd6, 0xcf, 0x5c, 0xdd, 0x7d, 0x38, 0x0a, 0x67, 0x20, 0x7f, 0x54,
+		0xc4, 0xb2, 0x05, 0x21, 0x76, 0xeb, 0x31, 0xd4, 0x8d, 0xae, 0x37, 0x69, 0x0c, 0xf9, 0x8c, 0xf1,
+		0x2d, 0x11, 0xd5, 0x5a, 0x4b, 0xc7, 0xcd, 0x51, 0xbe, 0x69, 0x51, 0x8d, 0xd9, 0xe6, 0xd6, 0x86,
+		0xaf, 0x5f, 0x15, 0xe1, 0xcb, 0xd5, 0x29, 0x22, 0x7c, 0xfd, 0x74, 0x4c, 0xef, 0x06, 0xb2, 0x08,
+		0x31, 0x7f, 0x1e, 0x03, 0xd9, 0x0f, 0x25, 0x98, 0xa2, 0xba, 0xf9, 0xb7, 0x66, 0x7a, 0x35, 0xf9,
+		0x7d, 0x80, 0xc8, 0x51, 0x69, 0xcb, 0xd1, 0x9d, 0xb6, 0xad, 0xf2, 0xa5, 0xc0, 0xfc, 0x72, 0x1f,
+		0xa0, 0x8a, 0xed, 0x84, 0xb1, 0xd9, 0x3d, 0xcf, 0x74, 0xc5, 0x76, 0x82, 0xd8, 0xc1, 0xee, 0x4c,
+		0xdc, 0x84, 0xee, 0xfc, 0xa6, 0x04, 0xd9, 0x56, 0x2a, 0xf3, 0xee, 0xd3, 0xe0, 0x60, 0xe0, 0x68,
+		0x27, 0xdc, 0x83, 0xf7, 0x75, 0xb3, 0xb9, 0x15, 0x1a, 0x46, 0x07, 0x2c, 0x7c, 0xab, 0xf3, 0x80,
+		0x99, 0xa0, 0x87, 0x36, 0x67, 0xd6, 0x3f, 0xb5, 0xe1, 0xf3, 0xa5, 0xa6, 0xb8, 0xfa, 0x73, 0x91,
+		0x7b, 0x5f, 0x85, 0xe9, 0x36, 0x52, 0xdf, 0xea, 0x79, 0x6f, 0xa7, 0x6d, 0x67, 0xde, 0xec, 0xf4,
+		0xfd, 0x61, 0x3e, 0x12, 0x82, 0x6f, 0x08, 0x7c, 0x6b, 0xb1, 0x56, 0x8f, 0x10, 0xe5, 0x37, 0xc2,
+		0xed, 0x2d, 0xa9, 0xb8, 0x6c, 0x39, 0x48, 0x90, 0x03, 0xf4, 0x8c, 0x14, 0xf4, 0x9d, 0xb0, 0x58,
+		0x21, 0x6a, 0x4a, 0x23, 0x23, 0x48, 0x53, 0xd6, 0xe4, 0xa4, 0x8f, 0x8b, 0x21, 0x5f, 0x80, 0x71,
+		0x1f, 0x8c, 0x37, 0x72, 0x9a, 0x6c, 0x10, 0x19, 0x35, 0xf7, 0xa5, 0x7e, 0xbb, 0xf3, 0x0c, 0xc3,
+		0xa8, 0x71, 0xb5, 0x29, 0xbe, 0x3c, 0x09, 0x88, 0x31, 0xa3, 0x47, 0x1b, 0xa2, 0x89, 0x0d, 0x98,
+		0x08, 0x40, 0x79, 0x23, 0xaf, 0xeb, 0xd8, 0x44, 0x7e, 0x02, 0x8e, 0x50, 0xa6, 0xad, 0x36, 0x9f,
+		0xf7, 0xe8, 0x05, 0x01, 0x61, 0xe8, 0x96, 0xdf, 0x86, 0x93, 0x1d, 0x38, 0x1a, 0x49, 0xef, 0x65,
+		0x41, 0x6c, 0x2f, 0x39, 0x2a, 0x0b, 0x6a, 0xc5, 0x8b, 0x4b, 0xcd, 0x18, 0xc8, 0x8f, 0xc3, 0xdd,
+		0xed, 0x5a, 0xb5, 0xd7, 0xae, 0xe8, 0xb8, 0xe2, 0x13, 0x9a, 0x1d, 0x33, 0x4a, 0xbe, 0x63, 0x46,
+		0xb9, 0x01, 0x47, 0xa2, 0xc8, 0xb9, 0xcc, 0x17, 0xc8, 0xde, 0x2b, 0x85, 0x47, 0x4d, 0x8d, 0xed,
+		0x85, 0x16, 0x1c, 0x4e, 0xfe, 0xe6, 0x14, 0xf4, 0xd3, 0x76, 0xd1, 0x87, 0xa5, 0xc0, 0xd7, 0xc6,
+		0xe6, 0xda, 0x31, 0x6d, 0xbd, 0xff, 0x90, 0x3d, 0xd1, 0x35, 0x3e, 0xcf, 0x8f, 0x8f, 0xbf, 0xe3,
+		0x5f, 0x7f, 0xef, 0x83, 0xb1, 0xbb, 0x90, 0x7c, 0xa2, 0xcd, 0xce, 0x87, 0x2f, 0x36, 0x7d, 0x3a,
+		0xf0, 0xa5, 0x8c, 0xfb, 0xbb, 0x6b, 0x4a, 0x48, 0x36, 0xd7, 0x2d, 0x3a, 0x17, 0xec, 0x2c, 0x15,
+		0xec, 0x14, 0x7a, 0x28, 0x5a, 0xb0, 0x13, 0x6f, 0x09, 0x06, 0xa8, 0xb7, 0xa1, 0x7f, 0x23, 0xc1,
+		0x64, 0xab, 0xe5, 0x33, 0x7a, 0xa4, 0x3b, 0x29, 0x9a, 0xd3, 0xb7, 0xec, 0xa3, 0xfb, 0xa0, 0xe4,
+		0xaa, 0x2c, 0x52, 0x55, 0xf2, 0xe8, 0xc9, 0x7d, 0xa8, 0x72, 0xc2, 0x7f, 0xba, 0xf4, 0x7f, 0x24,
+		0x38, 0xdc, 0x71, 0x35, 0x8a, 0xf2, 0xdd, 0x49, 0xd9, 0x21, 0x4f, 0xcd, 0x16, 0x5e, 0x0f, 0x0b,
+		0xae, 0xf1, 0xd3, 0x54, 0xe3, 0x0b, 0x68, 0x69, 0x3f, 0x1a, 0xb7, 0x3c, 0xc2, 0x43, 0xbf, 0x17,
+		0xbc, 0x87, 0xdc, 0xd9, 0x9d, 0x9a, 0x16, 0x79, 0xd9, 0x13, 0x5d, 0xe3, 0x73, 0x15, 0x9e, 0xa5,
+		0x2a, 0x28, 0x68, 0xfd, 0x75, 0x76, 0xda, 0x89, 0xb7, 0x04, 0x27, 0xd9, 0xb7, 0xa1, 0xff, 0x2d,
+		0xb5, 0xbe, 0x56, 0x7c, 0xa6, 0xa3, 0x88, 0xed, 0x17, 0xb0, 0xd9, 0x47, 0x7a, 0x27, 0xe4, 0x4a,
+		0xd6, 0xa9, 0x92, 0x55, 0x84, 0x6f, 0xb6, 0x92, 0x2d, 0x3b, 0x11, 0x7d, 0x5d, 0x82, 0xc9, 0x56,
+		0xeb, 0xbf, 0x88, 0x61, 0xd9, 0x61, 0x41, 0x1b, 0x31, 0x2c, 0x3b, 0x2d, 0x36, 0xe5, 0xc7, 0xa8,
+		0xf2, 0xa7, 0xd1, 0xc3, 0xed, 0x94, 0xef, 0xd8, 0x8b, 0x64, 0x2c, 0x76, 0x5c, 0x50, 0x45, 0x8c,
+		0xc5, 0x6e, 0xd6, 0x8c, 0x11, 0x63, 0xb1, 0xab, 0xf5, 0x5c, 0xf4, 0x58, 0x74, 0x35, 0xeb, 0xb2,
+		0x1b, 0x6d, 0xf4, 0x55, 0x09, 0x46, 0x02, 0xab, 0x0f, 0xf4, 0x60, 0x47, 0x41, 0x5b, 0x2d, 0xce,
+		0xb2, 0x27, 0x7b, 0x21, 0xe1, 0xba, 0x2c, 0x51, 0x5d, 0xe6, 0x51, 0x7e, 0x3f, 0xba, 0x04, 0x4f,
+		0xea, 0xbf, 0x29, 0xc1, 0x44, 0x8b, 0x8c, 0x3e, 0x62, 0x14, 0xb6, 0x5f, 0xa0, 0x64, 0x1f, 0xe9,
+		0x9d, 0x90, 0x6b, 0x75, 0x8e, 0x6a, 0xf5, 0x06, 0xf4, 0xc4, 0x7e, 0xb4, 0xf2, 0xcd, 0xcf, 0xd7,
+		0xbd, 0x9b, 0x89, 0xbe, 0x76, 0xd0, 0xe9, 0x1e, 0x05, 0x13, 0x0a, 0x9d, 0xe9, 0x99, 0x8e, 0xeb,
+		0xf3, 0x0c, 0xd5, 0xe7, 0x69, 0xb4, 0xf6, 0xfa, 0xf4, 0x69, 0x9e, 0xd6, 0xbf, 0xd8, 0xfc, 0x5e,
+		0xb8, 0xb3, 0x17, 0xb5, 0x5c, 0x18, 0x64, 0x1f, 0xea, 0x89, 0x86, 0x2b, 0xf5, 0x08, 0x55, 0xea,
+		0x24, 0x7a, 0xa0, 0x9d, 0x52, 0xbe, 0xab, 0xb8, 0x9a, 0xbe, 0x6d, 0x9c, 0x78, 0x0b, 0x5b, 0x6e,
+		0xbc, 0x0d, 0xbd, 0x5d, 0x5c, 0xfd, 0x3b, 0xd6, 0xb1, 0x5d, 0xdf, 0x9a, 0x21, 0x7b, 0x4f, 0x17,
+		0x98, 0x5c, 0xae, 0xbb, 0xa8, 0x5c, 0xd3, 0xe8, 0x50, 0x3b, 0xb9, 0xc8, 0xba, 0x01, 0xbd, 0x57,
+		0x72, 0x6f, 0x4e, 0x1f, 0xef, 0xcc, 0xdb, 0xbf, 0xb0, 0xc8, 0xde, 0xdb, 0x15, 0x2e, 0x97, 0xe4,
+		0x08, 0x95, 0x64, 0x16, 0x4d, 0xb7, 0x95, 0x84, 0x09, 0xf0, 0x9f, 0x24, 0xc8, 0xb6, 0x5f, 0x14,
+		0xa0, 0x27, 0x3a, 0xb6, 0x19, 0xb9, 0x1a, 0xc9, 0x3e, 0xb9, 0x6f, 0xfa, 0x6e, 0x87, 0x63, 0xeb,
+		0x6b, 0x30, 0x5b, 0x7b, 0xec, 0x0a, 0x35, 0x71, 0x69, 0xdd, 0xa8, 0xbf, 0x0d, 0x7d, 0x47, 0x82,
+		0xa9, 0xb6, 0xeb, 0x08, 0xf4, 0x78, 0xaf, 0x62, 0x06, 0x96, 0x2f, 0xd9, 0x27, 0xf6, 0x4b, 0xce,
+		0x95, 0x5c, 0xa0, 0x4a, 0x3e, 0x81, 0x1e, 0xeb, 0x49, 0x49, 0xbb, 0x44, 0x96, 0x49, 0x95, 0x13,
+		0x6f, 0x21, 0x7f, 0xac, 0xb7, 0xdd, 0xf4, 0xbb, 0x38, 0x1f, 0x93, 0x61, 0xa6, 0x9d, 0x3c, 0x57,
+		0x23, 0x8e, 0x86, 0x3b, 0x7c, 0x01, 0x21, 0xf2, 0x0b, 0x07, 0x37, 0xfb, 0xcb, 0xdd, 0x5d, 0x9e,
+		0x23, 0xff, 0x41, 0x02, 0xd0, 0x8a, 0x5d, 0x9d, 0xb7, 0x30, 0xfb, 0x15, 0x61, 0x1e, 0xb0, 0x43,
+		0x4f, 0x7b, 0xa5, 0xd7, 0xf5, 0xb4, 0x77, 0x25, 0xf0, 0x58, 0x36, 0xd6, 0xdb, 0x83, 0xfc, 0xae,
+		0x5f, 0xcc, 0xc6, 0x7f, 0x22, 0x2f, 0x66, 0x5b, 0x3f, 0xa8, 0x49, 0xdc, 0xbc, 0x97, 0x77, 0xfd,
+		0xfb, 0x7d, 0x7d, 0xc8, 0x1f, 0xc2, 0x0f, 0x74, 0x78, 0x08, 0x9f, 0x69, 0xfb, 0xda, 0x9d, 0x53,
+		0xa3, 0x53, 0xe2, 0x3b, 0xd6, 0x83, 0xdd, 0x5d, 0xfb, 0x67, 0xd8, 0xbe, 0x9d, 0xb7, 0x43, 0x90,
+		0x6d, 0x76, 0x27, 0x77, 0xc8, 0x7f, 0x30, 0x0e, 0xe9, 0x15, 0xbb, 0x5a, 0xac, 0x68, 0xce, 0x2d,
+		0xf2, 0xb5, 0x27, 0xdb, 0xbf, 0x66, 0x44, 0x37, 0xae, 0xcf, 0x8c, 0x32, 0x9b, 0x76, 0xb0, 0x64,
+		0x1d, 0xc6, 0x42, 0xdf, 0x90, 0xe0, 0x9e, 0xb5, 0xb0, 0x9f, 0x4f, 0x59, 0x84, 0x58, 0xc9, 0xca,
+		0xa8, 0x07, 0xa1, 0x5f, 0xcf, 0xb8, 0xda, 0xda, 0x99, 0x99, 0x43, 0x9d, 0xbf, 0x95, 0x4f, 0xbf,
+		0xbd, 0x3e, 0xcb, 0x42, 0x26, 0xdc, 0x29, 0x6e, 0x8f, 0xfd, 0xa9, 0x04, 0x43, 0x2b, 0xb6, 0xc8,
+		0xea, 0xf1, 0xcf, 0xe8, 0xc3, 0xd3, 0x33, 0xee, 0x8f, 0x40, 0xc4, 0xbb, 0xf3, 0x5b, 0x8e, 0xee,
+		0x33, 0xc2, 0x01, 0x98, 0xf0, 0xe9, 0xe9, 0xea, 0xff, 0x87, 0x31, 0x1a, 0x1f, 0x0b, 0xb8, 0xaa,
+		0xe9, 0xee, 0x82, 0x00, 0xff, 0x65, 0x7d, 0x56, 0xe7, 0xd9, 0x39, 0xb1, 0x5f, 0x3b, 0xef, 0x42,
+		0xb6, 0xd9, 0x9e, 0xee, 0x96, 0xe6, 0x4a, 0xf3, 0xa3, 0x4f, 0xa9, 0x87, 0xef, 0xa9, 0x85, 0x9e,
+		0x76, 0x92, 0x8b, 0x2c, 0x23, 0x2b, 0x76, 0xf5, 0xa2, 0x5e, 0xf9, 0x0b, 0xef, 0xbf, 0xdb, 0x70,
+		0x20, 0xa0, 0xe9, 0xad, 0x32, 0xe9, 0x6f, 0xc7, 0xe0, 0x10, 0x89, 0xf0, 0xe4, 0x69, 0x54, 0xed,
+		0xe7, 0xe7, 0x69, 0xfa, 0x7e, 0x2d, 0xdc, 0xea, 0x2d, 0x73, 0xa2, 0xd7, 0xb7, 0xcc, 0xbe, 0x6e,
+		0x3a, 0x02, 0x77, 0x75, 0xb2, 0x9e, 0x1b, 0x77, 0x7e, 0x27, 0x06, 0xe3, 0xe4, 0x93, 0x66, 0xfe,
+		0x2c, 0xda, 0xfe, 0x8b, 0x66, 0xdb, 0x4d, 0x38, 0x20, 0x72, 0xfc, 0x0a, 0x4f, 0xf2, 0xd9, 0x81,
+		0x48, 0x22, 0x1c, 0xa4, 0x5a, 0xa2, 0xc9, 0xca, 0x84, 0x0b, 0xa7, 0x06, 0x22, 0xeb, 0x0b, 0xff,
+		0x31, 0xe0, 0x26, 0x4c, 0x35, 0xd9, 0xd0, 0x1d, 0x17, 0x9e, 0xd4, 0x52, 0x4f, 0x52, 0xcb, 0x9f,
+		0x91, 0xe8, 0x7c, 0x49, 0xa2, 0x17, 0xae, 0x53, 0xe6, 0xf6, 0x39, 0xc3, 0xba, 0xf9, 0x3d, 0x74,
+		0x26, 0xf0, 0xcb, 0x46, 0xfb, 0x0a, 0x0a, 0x6f, 0x82, 0xd9, 0x76, 0x92, 0xbe, 0x6e, 0x3b, 0x9c,
+		0xfc, 0xe4, 0x20, 0xc4, 0x57, 0xec, 0x2a, 0x79, 0xa3, 0x1b, 0x5e, 0x3e, 0xb4, 0x5d, 0xe0, 0x37,
+		0xe7, 0x86, 0xd9, 0x93, 0xdd, 0xe3, 0xba, 0x32, 0xef, 0xc2, 0x48, 0x30, 0x87, 0x3c, 0xd6, 0x81,
+		0x49, 0x00, 0x33, 0xfb, 0x40, 0xb7, 0x98, 0x6e, 0x63, 0x6f, 0x26, 0xbf, 0x05, 0xc5, 0xa7, 0x8f,
+		0x3b, 0x3b, 0x50, 0x0b, 0xa4, 0xec, 0xbd, 0x5d, 0x20, 0xb9, 0xdc, 0x5f, 0x80, 0xb1, 0x70, 0x72,
+		0xd1, 0xc9, 0x7a, 0x21, 0xdc, 0xec, 0xc9, 0xee, 0x71, 0x7d, 0xd7, 0x6b, 0xc0, 0x37, 0x23, 0xde,
+		0xdd, 0x81, 0x83, 0x87, 0x96, 0xbd, 0xbf, 0x2b, 0x34, 0xb7, 0x8d, 0xf7, 0x49, 0x30, 0xd5, 0x7e,
+		0x8e, 0x78, 0xb8, 0x53, 0x9f, 0xb7, 0xa3, 0xca, 0x3e, 0xb6, 0x1f, 0x2a, 0x57, 0x22, 0x1d, 0x46,
+		0x43, 0xd1, 0xf4, 0x9e, 0x0e, 0xfc, 0x82, 0xa8, 0xd9, 0x07, 0xbb, 0x46, 0x75, 0xdb, 0x7b, 0xa7,
+		0x04, 0x07, 0x5a, 0xc7, 0x88, 0x4e, 0x2e, 0xd8, 0x92, 0x22, 0xfb, 0x48, 0xaf, 0x14, 0xee, 0xed,
+		0x99, 0x9b, 0xbc, 0x3d, 0xf2, 0xff, 0x07, 0x00, 0xc6, 0x36, 0x4e, 0x84, 0x4d, 0xa3, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2092,6 +2208,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.PowerReduction.Equal(that1.PowerReduction) {
 		return false
 	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2148,6 +2267,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2942,6 +3094,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.PowerReduction.Size()
 		i -= size
@@ -3161,6 +3323,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3495,6 +3706,8 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.PowerReduction.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3554,6 +3767,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6061,6 +6298,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6550,6 +6821,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0